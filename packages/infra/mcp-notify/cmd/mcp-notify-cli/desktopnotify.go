@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// sendDesktopNotification raises a native desktop notification: macOS
+// Notification Center via osascript, libnotify via notify-send on Linux,
+// and a Windows toast via PowerShell's BurntToast-free balloon API. It
+// shells out rather than depending on a notification library so the CLI
+// stays free of platform-specific build tags and cgo.
+func sendDesktopNotification(title, message string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", quoteAppleScript(message), quoteAppleScript(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux":
+		cmd = exec.Command("notify-send", title, message)
+	case "windows":
+		script := fmt.Sprintf(`
+$obj = New-Object System.Windows.Forms.NotifyIcon
+$obj.Icon = [System.Drawing.SystemIcons]::Information
+$obj.BalloonTipTitle = %s
+$obj.BalloonTipText = %s
+$obj.Visible = $true
+$obj.ShowBalloonTip(5000)
+`, quotePowerShell(title), quotePowerShell(message))
+		cmd = exec.Command("powershell", "-NoProfile", "-Command",
+			"Add-Type -AssemblyName System.Windows.Forms,System.Drawing; "+script)
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to raise desktop notification: %w", err)
+	}
+	return nil
+}
+
+// quoteAppleScript wraps s in double quotes for use as an AppleScript
+// string literal, escaping any embedded quotes or backslashes.
+func quoteAppleScript(s string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+	return `"` + escaped + `"`
+}
+
+// quotePowerShell wraps s in single quotes for use as a PowerShell string
+// literal, escaping any embedded single quotes.
+func quotePowerShell(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -9,20 +9,27 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
 	"os/signal"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
 	"github.com/nirholas/mcp-notify/cmd/mcp-notify-cli/output"
+	"github.com/nirholas/mcp-notify/cmd/mcp-notify-cli/tui"
 	"github.com/nirholas/mcp-notify/internal/config"
 	"github.com/nirholas/mcp-notify/internal/diff"
+	"github.com/nirholas/mcp-notify/internal/observability"
+	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -33,10 +40,11 @@ var (
 )
 
 var (
-	cfgFile   string
-	outputFmt string
-	noColor   bool
-	cliConfig *config.CLIConfig
+	cfgFile     string
+	outputFmt   string
+	noColor     bool
+	profileFlag string
+	cliConfig   *config.CLIConfig
 )
 
 func main() {
@@ -77,19 +85,29 @@ Examples:
 
 func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.mcp-notify/config.yaml)")
-	rootCmd.PersistentFlags().StringVarP(&outputFmt, "output", "o", "table", "output format (table, json, yaml)")
+	rootCmd.PersistentFlags().StringVarP(&outputFmt, "output", "o", "table", "output format (table, json, yaml, csv, jsonl)")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "use a named profile from config (see 'config use-profile')")
 
 	// Add subcommands
 	rootCmd.AddCommand(changesCmd)
 	rootCmd.AddCommand(watchCmd)
 	rootCmd.AddCommand(subscribeCmd)
 	rootCmd.AddCommand(subscriptionsCmd)
+	rootCmd.AddCommand(applyCmd)
 	rootCmd.AddCommand(serversCmd)
+	rootCmd.AddCommand(watchlistCmd)
 	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(reportCmd)
+	rootCmd.AddCommand(tuiCmd)
 	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(adminCmd)
 	rootCmd.AddCommand(completionCmd)
 	rootCmd.AddCommand(versionCmd)
+
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "bundle file describing the desired subscriptions (required)")
+	applyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "print the plan without applying it")
+	applyCmd.MarkFlagRequired("file")
 }
 
 // versionCmd shows version information
@@ -114,6 +132,21 @@ func getFormatter() output.Formatter {
 	return f
 }
 
+// writeOutput prints content to stdout, or to path if it's non-empty, so
+// commands with an --out flag can be piped into files without an extra
+// shell redirect.
+func writeOutput(content, path string) error {
+	if path == "" {
+		fmt.Println(content)
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(content+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote output to %s\n", path)
+	return nil
+}
+
 // getAPIClient returns an HTTP client configured for API requests.
 func getAPIClient() *http.Client {
 	return &http.Client{
@@ -121,25 +154,51 @@ func getAPIClient() *http.Client {
 	}
 }
 
-// getAPIEndpoint returns the API endpoint URL.
+// getAPIEndpoint returns the API endpoint URL, preferring the active
+// profile (--profile, or config's active_profile) over the top-level
+// config field.
 func getAPIEndpoint() string {
-	if cliConfig != nil && cliConfig.APIEndpoint != "" {
-		return cliConfig.APIEndpoint
+	if cliConfig != nil {
+		if p, ok := cliConfig.ResolveProfile(profileFlag); ok && p.APIEndpoint != "" {
+			return p.APIEndpoint
+		}
+		if cliConfig.APIEndpoint != "" {
+			return cliConfig.APIEndpoint
+		}
 	}
 	return "http://localhost:8080"
 }
 
-// getRegistryURL returns the registry URL.
+// getRegistryURL returns the registry URL, preferring the active profile
+// (--profile, or config's active_profile) over the top-level config field.
 func getRegistryURL() string {
-	if cliConfig != nil && cliConfig.RegistryURL != "" {
-		return cliConfig.RegistryURL
+	if cliConfig != nil {
+		if p, ok := cliConfig.ResolveProfile(profileFlag); ok && p.RegistryURL != "" {
+			return p.RegistryURL
+		}
+		if cliConfig.RegistryURL != "" {
+			return cliConfig.RegistryURL
+		}
 	}
 	return "https://registry.modelcontextprotocol.io"
 }
 
-// getAPIKey returns the API key from config.
+// getAPIKey returns the API key. It checks the OS keychain first (see
+// `config login`), falling back to the active profile's or top-level
+// config's plaintext api_key field for keys that predate keychain storage
+// or on platforms without keychain support.
 func getAPIKey() string {
+	profile := profileFlag
+	if profile == "" && cliConfig != nil {
+		profile = cliConfig.ActiveProfile
+	}
+	if key, _ := config.LoadAPIKeyFromKeyring(profile); key != "" {
+		return key
+	}
 	if cliConfig != nil {
+		if p, ok := cliConfig.ResolveProfile(profileFlag); ok && p.APIKey != "" {
+			return p.APIKey
+		}
 		return cliConfig.APIKey
 	}
 	return ""
@@ -200,13 +259,25 @@ var (
 	changesNamespace string
 	changesKeywords  []string
 	changesLimit     int
+	changesCursor    string
+	changesAll       bool
+	changesStore     string
+	changesOut       string
+	changesColumns   []string
+	changesSort      string
 )
 
 func init() {
 	changesCmd.Flags().StringVar(&changesSince, "since", "24h", "show changes since duration (e.g., 1h, 24h, 7d, 1w)")
 	changesCmd.Flags().StringVar(&changesNamespace, "namespace", "", "filter by namespace pattern")
 	changesCmd.Flags().StringSliceVar(&changesKeywords, "keywords", nil, "filter by keywords")
-	changesCmd.Flags().IntVar(&changesLimit, "limit", 50, "maximum number of changes to show")
+	changesCmd.Flags().IntVar(&changesLimit, "limit", 50, "maximum number of changes to show per page")
+	changesCmd.Flags().StringVar(&changesCursor, "cursor", "", "resume from a cursor returned by a previous page")
+	changesCmd.Flags().BoolVar(&changesAll, "all", false, "fetch every page, following next_cursor until exhausted")
+	changesCmd.Flags().StringVar(&changesStore, "store", defaultSnapshotStoreDir(), "local directory to persist snapshots for offline diffing (empty disables)")
+	changesCmd.Flags().StringVar(&changesOut, "out", "", "write output to a file instead of stdout")
+	changesCmd.Flags().StringSliceVar(&changesColumns, "columns", nil, "comma-separated table columns to show, e.g. type,server,detected_at (table output only)")
+	changesCmd.Flags().StringVar(&changesSort, "sort", "", "table column to sort by (table output only)")
 }
 
 func runChanges(cmd *cobra.Command, args []string) error {
@@ -218,25 +289,37 @@ func runChanges(cmd *cobra.Command, args []string) error {
 
 	since := time.Now().Add(-duration)
 
-	// Try to fetch from API first
-	changes, err := fetchChangesFromAPI(since, changesNamespace, changesKeywords, changesLimit)
+	changes, nextCursor, err := fetchChangesPageFromAPI(since, changesNamespace, changesKeywords, changesCursor, changesLimit)
 	if err != nil {
-		// Fallback: direct registry polling
+		// Fallback: direct registry polling (no cursor support in this mode)
 		fmt.Fprintf(os.Stderr, "Note: Could not connect to API (%v), using direct registry access.\n", err)
-		changes, err = fetchChangesFromRegistry(since, changesNamespace, changesKeywords, changesLimit)
+		changes, err = fetchChangesFromRegistry(since, changesNamespace, changesKeywords, changesLimit, changesStore)
 		if err != nil {
 			return fmt.Errorf("failed to fetch changes: %w", err)
 		}
+	} else if changesAll {
+		for nextCursor != "" {
+			page, cursor, err := fetchChangesPageFromAPI(since, changesNamespace, changesKeywords, nextCursor, changesLimit)
+			if err != nil {
+				return fmt.Errorf("failed to fetch changes: %w", err)
+			}
+			changes = append(changes, page...)
+			nextCursor = cursor
+		}
+	} else if nextCursor != "" {
+		fmt.Fprintf(os.Stderr, "Note: more changes are available, re-run with --cursor %s (or pass --all).\n", nextCursor)
 	}
 
 	// Format and output
 	f := getFormatter()
-	fmt.Println(f.FormatChanges(changes))
-
-	return nil
+	if table, ok := f.(*output.TableFormatter); ok {
+		table.SetColumns(changesColumns)
+		table.SetSort(changesSort)
+	}
+	return writeOutput(f.FormatChanges(changes), changesOut)
 }
 
-func fetchChangesFromAPI(since time.Time, namespace string, keywords []string, limit int) ([]types.Change, error) {
+func fetchChangesPageFromAPI(since time.Time, namespace string, keywords []string, cursor string, limit int) ([]types.Change, string, error) {
 	client := getAPIClient()
 	endpoint := fmt.Sprintf("%s/api/v1/changes?since=%s&limit=%d",
 		getAPIEndpoint(),
@@ -249,10 +332,13 @@ func fetchChangesFromAPI(since time.Time, namespace string, keywords []string, l
 	if len(keywords) > 0 {
 		endpoint += "&keywords=" + strings.Join(keywords, ",")
 	}
+	if cursor != "" {
+		endpoint += "&cursor=" + cursor
+	}
 
 	req, err := http.NewRequest("GET", endpoint, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if apiKey := getAPIKey(); apiKey != "" {
@@ -261,24 +347,24 @@ func fetchChangesFromAPI(since time.Time, namespace string, keywords []string, l
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+		return nil, "", fmt.Errorf("API error: %s - %s", resp.Status, string(body))
 	}
 
 	var result types.ChangesResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return result.Changes, nil
+	return result.Changes, result.NextCursor, nil
 }
 
-func fetchChangesFromRegistry(since time.Time, namespace string, keywords []string, limit int) ([]types.Change, error) {
+func fetchChangesFromRegistry(since time.Time, namespace string, keywords []string, limit int, store string) ([]types.Change, error) {
 	// Fetch current registry state
 	servers, err := fetchServersFromRegistry()
 	if err != nil {
@@ -286,9 +372,36 @@ func fetchChangesFromRegistry(since time.Time, namespace string, keywords []stri
 	}
 
 	// Create diff engine
-	engine := diff.NewEngine()
+	engine := diff.NewEngine(getRegistryURL())
 	snapshot := engine.CreateSnapshot(servers)
 
+	if err := saveLocalSnapshot(store, snapshot); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save local snapshot: %v\n", err)
+	}
+
+	// A locally stored snapshot from at or before `since` lets us compute an
+	// accurate diff even without the API's historical data. Fall back to the
+	// cruder created/updated-timestamp heuristic below if none is found yet
+	// (e.g. the very first run against a fresh --store directory).
+	if previous, err := loadLocalSnapshotBefore(store, since); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load local snapshot history: %v\n", err)
+	} else if previous != nil {
+		result := diff.FilterChanges(engine.Compare(previous, snapshot), types.SubscriptionFilter{
+			Namespaces: nonEmptyStrings(namespace),
+			Keywords:   keywords,
+		})
+		changes := result.NewServers
+		changes = append(changes, result.UpdatedServers...)
+		changes = append(changes, result.RemovedServers...)
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].DetectedAt.After(changes[j].DetectedAt)
+		})
+		if len(changes) > limit {
+			changes = changes[:limit]
+		}
+		return changes, nil
+	}
+
 	// Since we don't have historical data in direct mode, return an empty list
 	// with a message that historical changes require the API
 	var changes []types.Change
@@ -348,6 +461,15 @@ func fetchChangesFromRegistry(since time.Time, namespace string, keywords []stri
 	return changes, nil
 }
 
+// nonEmptyStrings wraps s in a single-element slice, or returns nil if s
+// is empty, for building a SubscriptionFilter from an optional CLI flag.
+func nonEmptyStrings(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
 func fetchServersFromRegistry() ([]types.Server, error) {
 	client := getAPIClient()
 	registryURL := getRegistryURL()
@@ -429,12 +551,18 @@ var (
 	watchFilter    []string
 	watchNamespace string
 	watchInterval  string
+	watchStore     string
+	watchExec      string
+	watchDesktop   bool
 )
 
 func init() {
 	watchCmd.Flags().StringSliceVar(&watchFilter, "filter", nil, "keywords to filter")
 	watchCmd.Flags().StringVar(&watchNamespace, "namespace", "", "namespace pattern to watch")
 	watchCmd.Flags().StringVar(&watchInterval, "interval", "1m", "polling interval")
+	watchCmd.Flags().StringVar(&watchStore, "store", defaultSnapshotStoreDir(), "local directory to persist snapshots for offline diffing (empty disables)")
+	watchCmd.Flags().StringVar(&watchExec, "exec", "", `command to run on each detected change, e.g. "notify-send {server} {type}"`)
+	watchCmd.Flags().BoolVar(&watchDesktop, "desktop", false, "raise a native desktop notification for detected changes")
 }
 
 func runWatch(cmd *cobra.Command, args []string) error {
@@ -465,7 +593,7 @@ func runWatch(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Press Ctrl+C to stop\n\n")
 
 	// Initial fetch
-	engine := diff.NewEngine()
+	engine := diff.NewEngine(getRegistryURL())
 	servers, err := fetchServersFromRegistry()
 	if err != nil {
 		return fmt.Errorf("failed to fetch initial registry state: %w", err)
@@ -473,6 +601,9 @@ func runWatch(cmd *cobra.Command, args []string) error {
 
 	lastSnapshot := engine.CreateSnapshot(servers)
 	fmt.Printf("Initial state: %d servers\n\n", lastSnapshot.ServerCount)
+	if err := saveLocalSnapshot(watchStore, lastSnapshot); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save local snapshot: %v\n", err)
+	}
 
 	// Watch loop
 	ticker := time.NewTicker(interval)
@@ -492,6 +623,9 @@ func runWatch(cmd *cobra.Command, args []string) error {
 			}
 
 			currentSnapshot := engine.CreateSnapshot(servers)
+			if err := saveLocalSnapshot(watchStore, currentSnapshot); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to save local snapshot: %v\n", err)
+			}
 
 			if engine.HasChanges(lastSnapshot, currentSnapshot) {
 				result := engine.Compare(lastSnapshot, currentSnapshot)
@@ -503,6 +637,21 @@ func runWatch(cmd *cobra.Command, args []string) error {
 					timestamp := time.Now().Format("15:04:05")
 					fmt.Printf("[%s] Detected %d change(s):\n", timestamp, len(filteredChanges))
 					fmt.Println(f.FormatChanges(filteredChanges))
+
+					if watchExec != "" {
+						for _, change := range filteredChanges {
+							if err := runExecHook(watchExec, change); err != nil {
+								fmt.Fprintf(os.Stderr, "Warning: --exec hook failed for %s: %v\n", change.ServerName, err)
+							}
+						}
+					}
+
+					if watchDesktop {
+						title, message := summarizeChangesForNotification(filteredChanges)
+						if err := sendDesktopNotification(title, message); err != nil {
+							fmt.Fprintf(os.Stderr, "Warning: desktop notification failed: %v\n", err)
+						}
+					}
 				}
 
 				lastSnapshot = currentSnapshot
@@ -552,6 +701,73 @@ func filterChanges(result *types.DiffResult, namespace string, keywords []string
 	return filtered
 }
 
+// summarizeChangesForNotification builds a short title and body for a
+// desktop notification covering one or more detected changes, since a
+// polling cycle can surface several changes at once.
+func summarizeChangesForNotification(changes []types.Change) (title, message string) {
+	if len(changes) == 1 {
+		c := changes[0]
+		return "MCP Registry change detected", fmt.Sprintf("%s: %s", c.ServerName, string(c.ChangeType))
+	}
+
+	const maxNames = 3
+	names := make([]string, 0, maxNames)
+	for i, c := range changes {
+		if i >= maxNames {
+			break
+		}
+		names = append(names, c.ServerName)
+	}
+	body := strings.Join(names, ", ")
+	if len(changes) > maxNames {
+		body += fmt.Sprintf(" and %d more", len(changes)-maxNames)
+	}
+	return fmt.Sprintf("%d MCP Registry changes detected", len(changes)), body
+}
+
+// runExecHook runs the user-supplied --exec command template for a single
+// detected change. "{server}" and "{type}" are substituted into the
+// command's arguments; the full change is also available to the command
+// as JSON via the MCP_NOTIFY_CHANGE_JSON environment variable, for hooks
+// that need more than the server name and change type.
+//
+// The template is split into argv directly (not passed through a shell),
+// so a change with unusual characters in its server name can't be used to
+// inject additional shell commands.
+func runExecHook(execTemplate string, change types.Change) error {
+	replacer := strings.NewReplacer(
+		"{server}", change.ServerName,
+		"{type}", string(change.ChangeType),
+	)
+
+	args := strings.Fields(execTemplate)
+	if len(args) == 0 {
+		return fmt.Errorf("empty --exec command")
+	}
+	for i, arg := range args {
+		args[i] = replacer.Replace(arg)
+	}
+
+	changeJSON, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal change: %w", err)
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"MCP_NOTIFY_SERVER_NAME="+change.ServerName,
+		"MCP_NOTIFY_CHANGE_TYPE="+string(change.ChangeType),
+		"MCP_NOTIFY_CHANGE_JSON="+string(changeJSON),
+	)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run exec hook: %w", err)
+	}
+	return nil
+}
+
 // --------------------------------------------------------------------------
 // Subscribe Command
 // --------------------------------------------------------------------------
@@ -599,65 +815,74 @@ func init() {
 	subscribeCmd.Flags().BoolVar(&subscribeSave, "save", false, "save subscription to local config")
 }
 
-func runSubscribe(cmd *cobra.Command, args []string) error {
-	// Validate at least one channel
+// buildChannelRequests turns the shared --discord-webhook/--slack-webhook/
+// etc. flags into channel requests, used by both `subscribe` and
+// `subscriptions edit`.
+func buildChannelRequests(discord, slack, webhook, email, telegram, teams string) []types.ChannelRequest {
 	channels := []types.ChannelRequest{}
 
-	if subscribeDiscord != "" {
+	if discord != "" {
 		channels = append(channels, types.ChannelRequest{
 			Type: types.ChannelDiscord,
 			Config: types.ChannelConfig{
-				DiscordWebhookURL: subscribeDiscord,
+				DiscordWebhookURL: discord,
 			},
 		})
 	}
 
-	if subscribeSlack != "" {
+	if slack != "" {
 		channels = append(channels, types.ChannelRequest{
 			Type: types.ChannelSlack,
 			Config: types.ChannelConfig{
-				SlackWebhookURL: subscribeSlack,
+				SlackWebhookURL: slack,
 			},
 		})
 	}
 
-	if subscribeWebhook != "" {
+	if webhook != "" {
 		channels = append(channels, types.ChannelRequest{
 			Type: types.ChannelWebhook,
 			Config: types.ChannelConfig{
-				WebhookURL:    subscribeWebhook,
+				WebhookURL:    webhook,
 				WebhookMethod: "POST",
 			},
 		})
 	}
 
-	if subscribeEmail != "" {
+	if email != "" {
 		channels = append(channels, types.ChannelRequest{
 			Type: types.ChannelEmail,
 			Config: types.ChannelConfig{
-				EmailAddress: subscribeEmail,
+				EmailAddress: email,
 			},
 		})
 	}
 
-	if subscribeTelegram != "" {
+	if telegram != "" {
 		channels = append(channels, types.ChannelRequest{
 			Type: types.ChannelTelegram,
 			Config: types.ChannelConfig{
-				TelegramChatID: subscribeTelegram,
+				TelegramChatID: telegram,
 			},
 		})
 	}
 
-	if subscribeTeams != "" {
+	if teams != "" {
 		channels = append(channels, types.ChannelRequest{
 			Type: types.ChannelTeams,
 			Config: types.ChannelConfig{
-				TeamsWebhookURL: subscribeTeams,
+				TeamsWebhookURL: teams,
 			},
 		})
 	}
 
+	return channels
+}
+
+func runSubscribe(cmd *cobra.Command, args []string) error {
+	// Validate at least one channel
+	channels := buildChannelRequests(subscribeDiscord, subscribeSlack, subscribeWebhook, subscribeEmail, subscribeTelegram, subscribeTeams)
+
 	if len(channels) == 0 {
 		return fmt.Errorf("at least one notification channel is required (--discord-webhook, --slack-webhook, --webhook, --email, --telegram, --teams-webhook)")
 	}
@@ -760,10 +985,16 @@ var subscriptionsCmd = &cobra.Command{
 func init() {
 	subscriptionsCmd.AddCommand(subscriptionsListCmd)
 	subscriptionsCmd.AddCommand(subscriptionsShowCmd)
+	subscriptionsCmd.AddCommand(subscriptionsEditCmd)
 	subscriptionsCmd.AddCommand(subscriptionsPauseCmd)
 	subscriptionsCmd.AddCommand(subscriptionsResumeCmd)
 	subscriptionsCmd.AddCommand(subscriptionsDeleteCmd)
 	subscriptionsCmd.AddCommand(subscriptionsLogsCmd)
+	subscriptionsCmd.AddCommand(subscriptionsExportCmd)
+	subscriptionsCmd.AddCommand(subscriptionsImportCmd)
+
+	subscriptionsExportCmd.Flags().StringVar(&subscriptionsExportOutput, "output-file", "", "file to write the bundle to (defaults to stdout)")
+	subscriptionsExportCmd.Flags().StringVar(&subscriptionsExportFormat, "format", "yaml", "bundle format: yaml or json")
 }
 
 var subscriptionsListCmd = &cobra.Command{
@@ -833,10 +1064,49 @@ var subscriptionsShowCmd = &cobra.Command{
 
 		f := getFormatter()
 		fmt.Println(f.FormatSubscription(sub))
+
+		health, err := fetchSubscriptionHealthFromAPI(subID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to get subscription health: %v\n", err)
+			return nil
+		}
+		fmt.Println(f.FormatSubscriptionHealth(health))
 		return nil
 	},
 }
 
+func fetchSubscriptionHealthFromAPI(id string) (*subscription.HealthReport, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/subscriptions/%s/health", getAPIEndpoint(), id)
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var health subscription.HealthReport
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return nil, err
+	}
+
+	return &health, nil
+}
+
 func fetchSubscriptionFromAPI(id string) (*types.Subscription, error) {
 	client := getAPIClient()
 	endpoint := fmt.Sprintf("%s/api/v1/subscriptions/%s", getAPIEndpoint(), id)
@@ -873,6 +1143,205 @@ func fetchSubscriptionFromAPI(id string) (*types.Subscription, error) {
 	return &sub, nil
 }
 
+func updateSubscriptionViaAPI(id string, req types.UpdateSubscriptionRequest) (*types.Subscription, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/subscriptions/%s", getAPIEndpoint(), id)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	if apiKey := getAPIKey(); apiKey != "" {
+		httpReq.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBody))
+	}
+
+	var sub types.Subscription
+	if err := json.NewDecoder(resp.Body).Decode(&sub); err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+var (
+	editName        string
+	editNamespace   string
+	editFilter      []string
+	editDiscord     string
+	editSlack       string
+	editWebhook     string
+	editEmail       string
+	editTelegram    string
+	editTeams       string
+	editInteractive bool
+)
+
+func init() {
+	subscriptionsEditCmd.Flags().StringVar(&editName, "name", "", "new subscription name")
+	subscriptionsEditCmd.Flags().StringVar(&editNamespace, "namespace", "", "replace the namespace filter")
+	subscriptionsEditCmd.Flags().StringSliceVar(&editFilter, "filter", nil, "replace the keyword filters")
+	subscriptionsEditCmd.Flags().StringVar(&editDiscord, "discord-webhook", "", "replace channels with a Discord webhook")
+	subscriptionsEditCmd.Flags().StringVar(&editSlack, "slack-webhook", "", "replace channels with a Slack webhook")
+	subscriptionsEditCmd.Flags().StringVar(&editWebhook, "webhook", "", "replace channels with a generic webhook")
+	subscriptionsEditCmd.Flags().StringVar(&editEmail, "email", "", "replace channels with an email address")
+	subscriptionsEditCmd.Flags().StringVar(&editTelegram, "telegram", "", "replace channels with a Telegram chat ID")
+	subscriptionsEditCmd.Flags().StringVar(&editTeams, "teams-webhook", "", "replace channels with a Microsoft Teams webhook")
+	subscriptionsEditCmd.Flags().BoolVarP(&editInteractive, "interactive", "i", false, "prompt for each field instead of using flags")
+}
+
+var subscriptionsEditCmd = &cobra.Command{
+	Use:   "edit [subscription-id]",
+	Short: "Edit a subscription's name, filters, or channels",
+	Long: `Update a subscription in place instead of deleting and recreating it.
+
+Pass one or more flags to update just those fields, or --interactive to be
+prompted for each field with its current value shown as the default.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		subID := args[0]
+		if cliConfig != nil {
+			subID = cliConfig.GetSubscriptionID(subID)
+		}
+
+		if editInteractive {
+			return runSubscriptionsEditInteractive(subID)
+		}
+
+		req := types.UpdateSubscriptionRequest{}
+		changed := false
+
+		if cmd.Flags().Changed("name") {
+			req.Name = &editName
+			changed = true
+		}
+
+		if cmd.Flags().Changed("namespace") || cmd.Flags().Changed("filter") {
+			filters := types.SubscriptionFilter{Keywords: editFilter}
+			if editNamespace != "" {
+				filters.Namespaces = []string{editNamespace}
+			}
+			req.Filters = &filters
+			changed = true
+		}
+
+		if channels := buildChannelRequests(editDiscord, editSlack, editWebhook, editEmail, editTelegram, editTeams); len(channels) > 0 {
+			req.Channels = channels
+			changed = true
+		}
+
+		if !changed {
+			return fmt.Errorf("no fields to update; pass --name, --namespace, --filter, a channel flag, or --interactive")
+		}
+
+		updated, err := updateSubscriptionViaAPI(subID, req)
+		if err != nil {
+			return fmt.Errorf("failed to update subscription: %w", err)
+		}
+
+		fmt.Println("✓ Subscription updated")
+		f := getFormatter()
+		fmt.Println(f.FormatSubscription(updated))
+		return nil
+	},
+}
+
+// promptWithDefault prints label with the current value shown in brackets
+// and returns the typed line, or the current value if the user just
+// presses enter.
+func promptWithDefault(reader *bufio.Reader, label, current string) (string, error) {
+	fmt.Printf("%s [%s]: ", label, current)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return current, nil
+	}
+	return line, nil
+}
+
+func runSubscriptionsEditInteractive(id string) error {
+	sub, err := fetchSubscriptionFromAPI(id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch subscription: %w", err)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	req := types.UpdateSubscriptionRequest{}
+
+	name, err := promptWithDefault(reader, "Name", sub.Name)
+	if err != nil {
+		return err
+	}
+	if name != sub.Name {
+		req.Name = &name
+	}
+
+	currentNamespace := ""
+	if len(sub.Filters.Namespaces) > 0 {
+		currentNamespace = sub.Filters.Namespaces[0]
+	}
+	namespace, err := promptWithDefault(reader, "Namespace filter", currentNamespace)
+	if err != nil {
+		return err
+	}
+
+	currentKeywords := strings.Join(sub.Filters.Keywords, ",")
+	keywords, err := promptWithDefault(reader, "Keyword filters (comma-separated)", currentKeywords)
+	if err != nil {
+		return err
+	}
+
+	if namespace != currentNamespace || keywords != currentKeywords {
+		filters := sub.Filters
+		if namespace == "" {
+			filters.Namespaces = nil
+		} else {
+			filters.Namespaces = []string{namespace}
+		}
+		if keywords == "" {
+			filters.Keywords = nil
+		} else {
+			filters.Keywords = strings.Split(keywords, ",")
+		}
+		req.Filters = &filters
+	}
+
+	if req.Name == nil && req.Filters == nil {
+		fmt.Println("No changes made.")
+		return nil
+	}
+
+	updated, err := updateSubscriptionViaAPI(id, req)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	fmt.Println("✓ Subscription updated")
+	f := getFormatter()
+	fmt.Println(f.FormatSubscription(updated))
+	return nil
+}
+
 var subscriptionsPauseCmd = &cobra.Command{
 	Use:   "pause [subscription-id]",
 	Short: "Pause a subscription",
@@ -1088,64 +1557,285 @@ func fetchSubscriptionLogs(id string) ([]types.Notification, error) {
 	return result.Logs, nil
 }
 
-// --------------------------------------------------------------------------
-// Servers Commands
-// --------------------------------------------------------------------------
-
-var serversCmd = &cobra.Command{
-	Use:   "servers",
-	Short: "Query servers from the MCP Registry",
-	Long: `List and search servers in the MCP Registry.
-	
-Examples:
-  # List all servers
-  mcp-notify-cli servers list
-  
-  # Search servers
-  mcp-notify-cli servers search "filesystem"
-  
-  # Show server details
-  mcp-notify-cli servers show "io.github.example/my-server"`,
-}
-
 var (
-	serversLimit int
+	subscriptionsExportOutput string
+	subscriptionsExportFormat string
 )
 
-func init() {
-	serversCmd.AddCommand(serversListCmd)
-	serversCmd.AddCommand(serversSearchCmd)
-	serversCmd.AddCommand(serversShowCmd)
-	serversCmd.AddCommand(serversHistoryCmd)
-
-	serversListCmd.Flags().IntVar(&serversLimit, "limit", 50, "maximum number of servers to show")
-}
-
-var serversListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List all servers",
+var subscriptionsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export every subscription as a bundle",
+	Long: `Export every subscription's configuration as a bundle that can be
+checked into version control and later re-created with "subscriptions
+import". Requires an admin API key, configured the same way as a
+subscription's. Never includes API keys, since those aren't stored in
+plaintext.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		servers, err := fetchServersFromRegistry()
+		data, err := fetchSubscriptionBundleFromAPI(subscriptionsExportFormat)
 		if err != nil {
-			return fmt.Errorf("failed to list servers: %w", err)
+			return fmt.Errorf("failed to export subscriptions: %w", err)
 		}
 
-		// Apply limit
-		if serversLimit > 0 && len(servers) > serversLimit {
-			servers = servers[:serversLimit]
+		if subscriptionsExportOutput == "" {
+			_, err := os.Stdout.Write(data)
+			return err
 		}
-
-		f := getFormatter()
-		fmt.Println(f.FormatServers(servers))
-
-		return nil
+		return os.WriteFile(subscriptionsExportOutput, data, 0644)
 	},
 }
 
-var serversSearchCmd = &cobra.Command{
-	Use:   "search [query]",
-	Short: "Search servers",
-	Args:  cobra.ExactArgs(1),
+func fetchSubscriptionBundleFromAPI(format string) ([]byte, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/subscriptions/export?format=%s", getAPIEndpoint(), url.QueryEscape(format))
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	return body, nil
+}
+
+var subscriptionsImportCmd = &cobra.Command{
+	Use:   "import [bundle-file]",
+	Short: "Create subscriptions from an exported bundle",
+	Long: `Create a subscription for each entry in a bundle previously
+produced by "subscriptions export" (YAML or JSON, detected from the file
+extension). Each entry is created independently: one invalid entry is
+reported but doesn't abort the rest of the bundle. Requires an admin API
+key, configured the same way as a subscription's.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read bundle file: %w", err)
+		}
+
+		contentType := "application/json"
+		if ext := strings.ToLower(filepath.Ext(args[0])); ext == ".yaml" || ext == ".yml" {
+			contentType = "application/yaml"
+		}
+
+		result, err := importSubscriptionBundleToAPI(data, contentType)
+		if err != nil {
+			return fmt.Errorf("failed to import subscriptions: %w", err)
+		}
+
+		fmt.Printf("Imported %d subscription(s).\n", result.Imported)
+		for _, e := range result.Errors {
+			fmt.Printf("  entry %d (%s): %s\n", e.Index, e.Name, e.Error)
+		}
+		return nil
+	},
+}
+
+func importSubscriptionBundleToAPI(data []byte, contentType string) (*types.ImportSubscriptionsResult, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/subscriptions/import", getAPIEndpoint())
+
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result types.ImportSubscriptionsResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// --------------------------------------------------------------------------
+// Apply Command
+// --------------------------------------------------------------------------
+
+var (
+	applyFile   string
+	applyDryRun bool
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Reconcile subscriptions to match a desired bundle",
+	Long: `Converge live subscriptions to match a bundle file, GitOps-style:
+subscriptions in the file but not live are created, subscriptions in both
+that differ are updated, and subscriptions live but not in the file are
+deleted. Subscriptions are matched by name. Requires an admin API key,
+configured the same way as a subscription's.
+
+Examples:
+  # Preview what would change
+  mcp-notify-cli apply -f subscriptions.yaml --dry-run
+
+  # Apply it
+  mcp-notify-cli apply -f subscriptions.yaml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := os.ReadFile(applyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read bundle file: %w", err)
+		}
+
+		contentType := "application/json"
+		if ext := strings.ToLower(filepath.Ext(applyFile)); ext == ".yaml" || ext == ".yml" {
+			contentType = "application/yaml"
+		}
+
+		result, err := applySubscriptionBundleToAPI(data, contentType, applyDryRun)
+		if err != nil {
+			return fmt.Errorf("failed to apply subscriptions: %w", err)
+		}
+
+		verb := "Applied"
+		if applyDryRun {
+			verb = "Planned"
+		}
+		fmt.Printf("%s %d action(s):\n", verb, len(result.Actions))
+		for _, a := range result.Actions {
+			line := fmt.Sprintf("  %-9s %s", a.Action, a.Name)
+			if a.Error != "" {
+				line += fmt.Sprintf(" (failed: %s)", a.Error)
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+func applySubscriptionBundleToAPI(data []byte, contentType string, dryRun bool) (*types.ApplySubscriptionsResult, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/subscriptions/apply?dry_run=%t", getAPIEndpoint(), dryRun)
+
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result types.ApplySubscriptionsResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// --------------------------------------------------------------------------
+// Servers Commands
+// --------------------------------------------------------------------------
+
+var serversCmd = &cobra.Command{
+	Use:   "servers",
+	Short: "Query servers from the MCP Registry",
+	Long: `List and search servers in the MCP Registry.
+	
+Examples:
+  # List all servers
+  mcp-notify-cli servers list
+  
+  # Search servers
+  mcp-notify-cli servers search "filesystem"
+  
+  # Show server details
+  mcp-notify-cli servers show "io.github.example/my-server"`,
+}
+
+var (
+	serversLimit   int
+	serversOut     string
+	serversColumns []string
+	serversSort    string
+)
+
+func init() {
+	serversCmd.AddCommand(serversListCmd)
+	serversCmd.AddCommand(serversSearchCmd)
+	serversCmd.AddCommand(serversShowCmd)
+	serversCmd.AddCommand(serversHistoryCmd)
+
+	serversListCmd.Flags().IntVar(&serversLimit, "limit", 50, "maximum number of servers to show")
+	serversListCmd.Flags().StringVar(&serversOut, "out", "", "write output to a file instead of stdout")
+	serversListCmd.Flags().StringSliceVar(&serversColumns, "columns", nil, "comma-separated table columns to show, e.g. name,version,updated_at (table output only)")
+	serversListCmd.Flags().StringVar(&serversSort, "sort", "", "table column to sort by (table output only)")
+}
+
+var serversListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all servers",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		servers, err := fetchServersFromRegistry()
+		if err != nil {
+			return fmt.Errorf("failed to list servers: %w", err)
+		}
+
+		// Apply limit
+		if serversLimit > 0 && len(servers) > serversLimit {
+			servers = servers[:serversLimit]
+		}
+
+		f := getFormatter()
+		if table, ok := f.(*output.TableFormatter); ok {
+			table.SetColumns(serversColumns)
+			table.SetSort(serversSort)
+		}
+		return writeOutput(f.FormatServers(servers), serversOut)
+	},
+}
+
+var serversSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search servers",
+	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		query := strings.ToLower(args[0])
 
@@ -1261,7 +1951,260 @@ func fetchServerHistory(serverName string) ([]types.Change, error) {
 		return nil, err
 	}
 
-	return result.Changes, nil
+	return result.Changes, nil
+}
+
+// --------------------------------------------------------------------------
+// Watchlist Command
+// --------------------------------------------------------------------------
+
+var watchlistCmd = &cobra.Command{
+	Use:   "watchlist",
+	Short: "Manage the server watchlist",
+	Long: `Add or remove servers from a lightweight watchlist, or check their
+current status. Unlike a subscription, a watchlist has no filters or
+notification channels — it's just a list of server names and their
+latest-known state.`,
+}
+
+func init() {
+	watchlistCmd.AddCommand(watchlistAddCmd)
+	watchlistCmd.AddCommand(watchlistRemoveCmd)
+	watchlistCmd.AddCommand(watchlistStatusCmd)
+}
+
+var watchlistAddCmd = &cobra.Command{
+	Use:   "add [server-name...]",
+	Short: "Add servers to the watchlist",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey := getWatchlistAPIKey()
+		if apiKey == "" {
+			resp, err := createWatchlistViaAPI(args)
+			if err != nil {
+				return fmt.Errorf("failed to create watchlist: %w", err)
+			}
+			if cliConfig != nil {
+				if err := cliConfig.SaveWatchlistAPIKey(resp.APIKey); err != nil {
+					fmt.Fprintf(os.Stderr, "warning: failed to save watchlist API key: %v\n", err)
+				}
+			}
+			fmt.Printf("✓ Watchlist created, watching %d server(s)\n", len(resp.ServerNames))
+			return nil
+		}
+
+		status, err := fetchWatchlistStatusFromAPI(apiKey)
+		if err != nil {
+			return fmt.Errorf("failed to get watchlist: %w", err)
+		}
+
+		names := status.Watchlist.ServerNames
+		for _, name := range args {
+			if !containsString(names, name) {
+				names = append(names, name)
+			}
+		}
+
+		if _, err := updateWatchlistViaAPI(apiKey, names); err != nil {
+			return fmt.Errorf("failed to update watchlist: %w", err)
+		}
+
+		fmt.Printf("✓ Watchlist updated, watching %d server(s)\n", len(names))
+		return nil
+	},
+}
+
+var watchlistRemoveCmd = &cobra.Command{
+	Use:   "remove [server-name...]",
+	Short: "Remove servers from the watchlist",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey := getWatchlistAPIKey()
+		if apiKey == "" {
+			return fmt.Errorf("no watchlist found; use 'watchlist add' first")
+		}
+
+		status, err := fetchWatchlistStatusFromAPI(apiKey)
+		if err != nil {
+			return fmt.Errorf("failed to get watchlist: %w", err)
+		}
+
+		remove := make(map[string]bool, len(args))
+		for _, name := range args {
+			remove[name] = true
+		}
+		names := make([]string, 0, len(status.Watchlist.ServerNames))
+		for _, name := range status.Watchlist.ServerNames {
+			if !remove[name] {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("cannot remove the last server from a watchlist")
+		}
+
+		if _, err := updateWatchlistViaAPI(apiKey, names); err != nil {
+			return fmt.Errorf("failed to update watchlist: %w", err)
+		}
+
+		fmt.Printf("✓ Watchlist updated, watching %d server(s)\n", len(names))
+		return nil
+	},
+}
+
+var watchlistStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the watchlist's servers and their current status",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey := getWatchlistAPIKey()
+		if apiKey == "" {
+			return fmt.Errorf("no watchlist found; use 'watchlist add' first")
+		}
+
+		status, err := fetchWatchlistStatusFromAPI(apiKey)
+		if err != nil {
+			return fmt.Errorf("failed to get watchlist status: %w", err)
+		}
+
+		f := getFormatter()
+		fmt.Println(f.FormatWatchlistStatus(status))
+		return nil
+	},
+}
+
+// getWatchlistAPIKey returns the saved watchlist API key, or "" if no
+// watchlist has been created yet.
+func getWatchlistAPIKey() string {
+	if cliConfig != nil {
+		return cliConfig.WatchlistAPIKey
+	}
+	return ""
+}
+
+func createWatchlistViaAPI(serverNames []string) (*types.WatchlistResponse, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/watchlist", getAPIEndpoint())
+
+	body, err := json.Marshal(types.CreateWatchlistRequest{ServerNames: serverNames})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBody))
+	}
+
+	var result types.WatchlistResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func fetchWatchlistStatusFromAPI(apiKey string) (*types.WatchlistStatusResponse, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/watchlist", getAPIEndpoint())
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-API-Key", apiKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result types.WatchlistStatusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func updateWatchlistViaAPI(apiKey string, serverNames []string) (*types.Watchlist, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/watchlist", getAPIEndpoint())
+
+	body, err := json.Marshal(types.UpdateWatchlistRequest{ServerNames: serverNames})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", apiKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBody))
+	}
+
+	var result types.Watchlist
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// --------------------------------------------------------------------------
+// TUI Command
+// --------------------------------------------------------------------------
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Launch the interactive terminal UI",
+	Long: `Launch an interactive terminal UI with panes for live changes,
+server search, and subscriptions.
+
+Use tab/shift+tab (or h/l) to switch panes, up/down (or j/k) to move the
+cursor, r to refresh the active pane, / to filter servers, and q to quit.`,
+	RunE: runTUI,
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	return tui.Run(tui.Config{
+		APIEndpoint: getAPIEndpoint(),
+		APIKey:      getAPIKey(),
+	})
 }
 
 // --------------------------------------------------------------------------
@@ -1289,16 +2232,20 @@ var (
 	diffFrom         string
 	diffTo           string
 	diffSaveSnapshot string
+	diffOut          string
+	diffDetail       bool
 )
 
 func init() {
 	diffCmd.Flags().StringVar(&diffFrom, "from", "", "start point (timestamp or file)")
 	diffCmd.Flags().StringVar(&diffTo, "to", "", "end point (timestamp or file, default: now)")
 	diffCmd.Flags().StringVar(&diffSaveSnapshot, "save-snapshot", "", "save current snapshot to file")
+	diffCmd.Flags().StringVar(&diffOut, "out", "", "write output to a file instead of stdout")
+	diffCmd.Flags().BoolVar(&diffDetail, "detail", false, "show per-server field changes instead of just counts (use --output table, json, or markdown)")
 }
 
 func runDiff(cmd *cobra.Command, args []string) error {
-	engine := diff.NewEngine()
+	engine := diff.NewEngine(getRegistryURL())
 
 	// If saving snapshot
 	if diffSaveSnapshot != "" {
@@ -1321,6 +2268,37 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// If --from parses as a timestamp (rather than a snapshot file), resolve
+	// both endpoints server-side via the historical diff API instead of
+	// loading local snapshot files.
+	if diffFrom != "" {
+		if fromTime, err := time.Parse(time.RFC3339, diffFrom); err == nil {
+			toTime := time.Now()
+			if diffTo != "" {
+				toTime, err = time.Parse(time.RFC3339, diffTo)
+				if err != nil {
+					return fmt.Errorf("invalid --to timestamp: %w", err)
+				}
+			}
+
+			result, err := fetchDiffFromAPI(fromTime, toTime)
+			if err != nil {
+				return fmt.Errorf("failed to fetch diff: %w", err)
+			}
+
+			if diffDetail {
+				content, err := formatDiffDetail(result, outputFmt)
+				if err != nil {
+					return err
+				}
+				return writeOutput(content, diffOut)
+			}
+
+			f := getFormatter()
+			return writeOutput(f.FormatDiff(result), diffOut)
+		}
+	}
+
 	// Load snapshots
 	var fromSnapshot, toSnapshot *types.Snapshot
 
@@ -1350,10 +2328,169 @@ func runDiff(cmd *cobra.Command, args []string) error {
 	// Compute diff
 	result := engine.Compare(fromSnapshot, toSnapshot)
 
+	if diffDetail {
+		content, err := formatDiffDetail(result, outputFmt)
+		if err != nil {
+			return err
+		}
+		return writeOutput(content, diffOut)
+	}
+
 	f := getFormatter()
-	fmt.Println(f.FormatDiff(result))
+	return writeOutput(f.FormatDiff(result), diffOut)
+}
 
-	return nil
+// diffDetailEntry is one server's worth of field-level changes, the unit
+// --detail renders for table/markdown/JSON output.
+type diffDetailEntry struct {
+	Server          string              `json:"server"`
+	ChangeType      string              `json:"change_type"`
+	PreviousVersion string              `json:"previous_version,omitempty"`
+	NewVersion      string              `json:"new_version,omitempty"`
+	Severity        string              `json:"severity,omitempty"`
+	Fields          []types.FieldChange `json:"fields,omitempty"`
+}
+
+func diffDetailEntries(result *types.DiffResult) []diffDetailEntry {
+	if result == nil {
+		return nil
+	}
+
+	all := make([]types.Change, 0, result.TotalChanges)
+	all = append(all, result.NewServers...)
+	all = append(all, result.UpdatedServers...)
+	all = append(all, result.RemovedServers...)
+
+	entries := make([]diffDetailEntry, len(all))
+	for i, c := range all {
+		entries[i] = diffDetailEntry{
+			Server:          c.ServerName,
+			ChangeType:      string(c.ChangeType),
+			PreviousVersion: c.PreviousVersion,
+			NewVersion:      c.NewVersion,
+			Severity:        string(c.Severity),
+			Fields:          c.FieldChanges,
+		}
+	}
+	return entries
+}
+
+// formatFieldValue renders a FieldChange's old/new value as one line,
+// whether it came from a concrete Go type (a local diff.Compare()) or a
+// generic interface{} decoded from JSON (a server-side historical diff).
+func formatFieldValue(v interface{}) string {
+	if v == nil {
+		return "-"
+	}
+	if s, ok := v.(string); ok {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// formatDiffDetail renders per-server field changes for changelog-style
+// output, in table, markdown, or JSON. Any other --output value falls
+// back to table, since csv/yaml/jsonl have no natural shape for a
+// variable-length list of per-field changes.
+func formatDiffDetail(result *types.DiffResult, format string) (string, error) {
+	entries := diffDetailEntries(result)
+
+	switch strings.ToLower(format) {
+	case "json":
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal diff detail: %w", err)
+		}
+		return string(data), nil
+	case "markdown", "md":
+		return formatDiffDetailMarkdown(entries), nil
+	default:
+		return formatDiffDetailTable(entries), nil
+	}
+}
+
+func formatDiffDetailMarkdown(entries []diffDetailEntry) string {
+	var sb strings.Builder
+	for _, e := range entries {
+		fmt.Fprintf(&sb, "### %s (%s)\n\n", e.Server, e.ChangeType)
+		if e.PreviousVersion != "" || e.NewVersion != "" {
+			fmt.Fprintf(&sb, "- version: `%s` → `%s`\n", e.PreviousVersion, e.NewVersion)
+		}
+		if e.Severity != "" {
+			fmt.Fprintf(&sb, "- severity: %s\n", e.Severity)
+		}
+		for _, field := range e.Fields {
+			fmt.Fprintf(&sb, "- %s: `%s` → `%s`\n", field.Field, formatFieldValue(field.OldValue), formatFieldValue(field.NewValue))
+		}
+		sb.WriteString("\n")
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func formatDiffDetailTable(entries []diffDetailEntry) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SERVER\tTYPE\tFIELD\tOLD\tNEW")
+	for _, e := range entries {
+		if len(e.Fields) == 0 {
+			fmt.Fprintf(w, "%s\t%s\tversion\t%s\t%s\n", e.Server, e.ChangeType, orDash(e.PreviousVersion), orDash(e.NewVersion))
+			continue
+		}
+		for _, field := range e.Fields {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Server, e.ChangeType, field.Field, formatFieldValue(field.OldValue), formatFieldValue(field.NewValue))
+		}
+	}
+	w.Flush()
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func fetchDiffFromAPI(from, to time.Time) (*types.DiffResult, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/diff?from=%s&to=%s",
+		getAPIEndpoint(),
+		from.Format(time.RFC3339),
+		to.Format(time.RFC3339))
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result types.DiffResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
 func loadSnapshot(path string) (*types.Snapshot, error) {
@@ -1376,6 +2513,94 @@ func loadSnapshot(path string) (*types.Snapshot, error) {
 	return nil, fmt.Errorf("file not found: %s (timestamp-based lookups require API support)", path)
 }
 
+// --------------------------------------------------------------------------
+// Report Command
+// --------------------------------------------------------------------------
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate human-readable reports of registry activity",
+	Long:  `Render summaries of registry activity suitable for newsletters or release notes.`,
+}
+
+func init() {
+	reportCmd.AddCommand(reportChangelogCmd)
+
+	reportChangelogCmd.Flags().StringVar(&reportSince, "since", "", "start of the report window (RFC3339, default: 7 days ago)")
+	reportChangelogCmd.Flags().StringVar(&reportFormat, "format", "markdown", "report format: markdown or html")
+	reportChangelogCmd.Flags().StringVar(&reportOut, "out", "", "write output to a file instead of stdout")
+}
+
+var (
+	reportSince  string
+	reportFormat string
+	reportOut    string
+)
+
+var reportChangelogCmd = &cobra.Command{
+	Use:   "changelog",
+	Short: "Render a changelog of registry activity",
+	Long: `Render a grouped, human-readable changelog of new, updated, and removed
+servers since a given time, in Markdown or HTML, ready to paste into a
+newsletter or release notes.
+
+Examples:
+  # Last 7 days as Markdown (default)
+  mcp-notify-cli report changelog
+
+  # Last 30 days as HTML
+  mcp-notify-cli report changelog --since $(date -u -d '30 days ago' +%Y-%m-%dT%H:%M:%SZ) --format html`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		since := time.Now().Add(-7 * 24 * time.Hour)
+		if reportSince != "" {
+			var err error
+			since, err = time.Parse(time.RFC3339, reportSince)
+			if err != nil {
+				return fmt.Errorf("invalid --since timestamp: %w", err)
+			}
+		}
+
+		content, err := fetchChangelogFromAPI(since, reportFormat)
+		if err != nil {
+			return fmt.Errorf("failed to fetch changelog: %w", err)
+		}
+
+		return writeOutput(content, reportOut)
+	},
+}
+
+func fetchChangelogFromAPI(since time.Time, format string) (string, error) {
+	client := getAPIClient()
+	endpoint := fmt.Sprintf("%s/api/v1/reports/changelog?since=%s&format=%s",
+		getAPIEndpoint(), url.QueryEscape(since.Format(time.RFC3339)), url.QueryEscape(format))
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	return strings.TrimRight(string(body), "\n"), nil
+}
+
 // --------------------------------------------------------------------------
 // Config Command
 // --------------------------------------------------------------------------
@@ -1390,6 +2615,11 @@ func init() {
 	configCmd.AddCommand(configShowCmd)
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configUseProfileCmd)
+	configCmd.AddCommand(configLoginCmd)
+	configCmd.AddCommand(configLogoutCmd)
+
+	configLoginCmd.Flags().StringVar(&loginAPIKey, "api-key", "", "API key to store (omit to be prompted)")
 }
 
 var configShowCmd = &cobra.Command{
@@ -1424,6 +2654,16 @@ var configShowCmd = &cobra.Command{
 			fmt.Printf("  Default Output: %s\n", displayCfg.DefaultOutput)
 			fmt.Printf("  No Color:      %v\n", displayCfg.NoColor)
 			fmt.Printf("  Watch Interval: %s\n", displayCfg.DefaultWatchInterval)
+			if displayCfg.ActiveProfile != "" {
+				fmt.Printf("  Active Profile: %s\n", displayCfg.ActiveProfile)
+			}
+			if len(displayCfg.Profiles) > 0 {
+				fmt.Println()
+				fmt.Println("  Profiles:")
+				for name := range displayCfg.Profiles {
+					fmt.Printf("    %s\n", name)
+				}
+			}
 			fmt.Println()
 			if len(displayCfg.Subscriptions) > 0 {
 				fmt.Println("  Saved Subscriptions:")
@@ -1488,6 +2728,89 @@ var configPathCmd = &cobra.Command{
 	},
 }
 
+var configUseProfileCmd = &cobra.Command{
+	Use:   "use-profile [name]",
+	Short: "Set the default active profile",
+	Long: `Set the profile used when --profile isn't passed on the command
+line. The profile must already exist under "profiles" in the config file
+(add one by hand, e.g. with 'config path' to find the file).`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadCLIConfig()
+		if err != nil {
+			return err
+		}
+
+		if err := cfg.UseProfile(args[0]); err != nil {
+			return err
+		}
+
+		fmt.Printf("✓ Active profile set to %s\n", args[0])
+		return nil
+	},
+}
+
+var loginAPIKey string
+
+var configLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Store an API key in the OS keychain",
+	Long: `Store an API key in the OS keychain (macOS Keychain, Secret
+Service on Linux, Windows Credential Manager) instead of plaintext in the
+config file. Use --profile to store it under a named profile instead of
+the default account, and getAPIKey will prefer the keychain over any
+plaintext api_key already in the config.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiKey := loginAPIKey
+		if apiKey == "" {
+			fmt.Print("API Key: ")
+			reader := bufio.NewReader(os.Stdin)
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return fmt.Errorf("failed to read API key: %w", err)
+			}
+			apiKey = strings.TrimSpace(line)
+		}
+		if apiKey == "" {
+			return fmt.Errorf("no API key provided")
+		}
+
+		if err := config.SaveAPIKeyToKeyring(profileFlag, apiKey); err != nil {
+			return fmt.Errorf("failed to store API key in keychain: %w", err)
+		}
+
+		// Clear any plaintext copy now that the keychain holds it.
+		cfg, err := config.LoadCLIConfig()
+		if err != nil {
+			return err
+		}
+		if profileFlag == "" {
+			cfg.APIKey = ""
+		} else if p, ok := cfg.Profiles[profileFlag]; ok {
+			p.APIKey = ""
+			cfg.Profiles[profileFlag] = p
+		}
+		if err := config.SaveCLIConfig(cfg); err != nil {
+			return err
+		}
+
+		fmt.Println("✓ API key stored in OS keychain")
+		return nil
+	},
+}
+
+var configLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the API key from the OS keychain",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.DeleteAPIKeyFromKeyring(profileFlag); err != nil {
+			return fmt.Errorf("failed to remove API key from keychain: %w", err)
+		}
+		fmt.Println("✓ API key removed from OS keychain")
+		return nil
+	},
+}
+
 // --------------------------------------------------------------------------
 // Completion Command
 // --------------------------------------------------------------------------
@@ -1540,3 +2863,143 @@ PowerShell:
 		}
 	},
 }
+
+// --------------------------------------------------------------------------
+// Admin Command
+// --------------------------------------------------------------------------
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Operator utilities",
+	Long:  `Utilities for operators running MCP Notify, such as generating monitoring configuration.`,
+}
+
+var exportObservabilityDir string
+
+func init() {
+	adminCmd.AddCommand(exportObservabilityCmd)
+	exportObservabilityCmd.Flags().StringVar(&exportObservabilityDir, "dir", "./ops", "directory to write the generated files into")
+
+	adminCmd.AddCommand(auditCmd)
+	auditCmd.Flags().StringVar(&auditResourceType, "resource-type", "", "filter by resource type, e.g. subscription")
+	auditCmd.Flags().StringVar(&auditResourceID, "resource-id", "", "filter by resource ID")
+	auditCmd.Flags().StringVar(&auditAction, "action", "", "filter by action, e.g. create, delete, rotate")
+	auditCmd.Flags().StringVar(&auditActorID, "actor-id", "", "filter by actor ID")
+	auditCmd.Flags().StringVar(&auditSince, "since", "", "only entries at or after this RFC3339 timestamp")
+	auditCmd.Flags().StringVar(&auditUntil, "until", "", "only entries at or before this RFC3339 timestamp")
+	auditCmd.Flags().IntVar(&auditLimit, "limit", 100, "maximum number of entries to show (max 500)")
+}
+
+var (
+	auditResourceType string
+	auditResourceID   string
+	auditAction       string
+	auditActorID      string
+	auditSince        string
+	auditUntil        string
+	auditLimit        int
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "List audit log entries",
+	Long: `List the audit log of mutating operations (create/update/delete/
+pause/resume/rotate) across all subscriptions and organizations.
+Requires an admin API key, configured the same way as a subscription's.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := fetchAuditLogFromAPI()
+		if err != nil {
+			return fmt.Errorf("failed to list audit log: %w", err)
+		}
+
+		f := getFormatter()
+		fmt.Println(f.FormatAuditLog(entries))
+		return nil
+	},
+}
+
+func fetchAuditLogFromAPI() ([]types.AuditLogEntry, error) {
+	client := getAPIClient()
+
+	q := url.Values{}
+	if auditResourceType != "" {
+		q.Set("resource_type", auditResourceType)
+	}
+	if auditResourceID != "" {
+		q.Set("resource_id", auditResourceID)
+	}
+	if auditAction != "" {
+		q.Set("action", auditAction)
+	}
+	if auditActorID != "" {
+		q.Set("actor_id", auditActorID)
+	}
+	if auditSince != "" {
+		q.Set("since", auditSince)
+	}
+	if auditUntil != "" {
+		q.Set("until", auditUntil)
+	}
+	if auditLimit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", auditLimit))
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v1/admin/audit?%s", getAPIEndpoint(), q.Encode())
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey := getAPIKey(); apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result struct {
+		Entries []types.AuditLogEntry `json:"entries"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Entries, nil
+}
+
+var exportObservabilityCmd = &cobra.Command{
+	Use:   "export-observability",
+	Short: "Export Prometheus alert rules and a Grafana dashboard",
+	Long: `Write a Prometheus alert rule group (alerts.yml) and a Grafana
+dashboard definition (dashboard.json) built from the exact metric names
+this binary emits, so the generated monitoring config never drifts from
+the code.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := os.MkdirAll(exportObservabilityDir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+
+		rulesPath := filepath.Join(exportObservabilityDir, "alerts.yml")
+		if err := os.WriteFile(rulesPath, observability.AlertRules(), 0644); err != nil {
+			return fmt.Errorf("failed to write alert rules: %w", err)
+		}
+
+		dashboardPath := filepath.Join(exportObservabilityDir, "dashboard.json")
+		if err := os.WriteFile(dashboardPath, observability.Dashboard(), 0644); err != nil {
+			return fmt.Errorf("failed to write dashboard: %w", err)
+		}
+
+		fmt.Printf("Wrote %s\n", rulesPath)
+		fmt.Printf("Wrote %s\n", dashboardPath)
+		return nil
+	},
+}
@@ -0,0 +1,195 @@
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// SetColumns restricts table output to the given column keys, in order,
+// similar to kubectl's -o custom-columns. Unknown keys render as "-" for
+// every row rather than erroring, since the mistyped key is usually
+// obvious from the header. Only TableFormatter honors this; it's a no-op
+// for every other format.
+func (f *TableFormatter) SetColumns(columns []string) {
+	f.columns = columns
+}
+
+// SetSort sorts table rows by the given column key before rendering.
+// Sorting compares each row's plain-text value for that column, so it
+// only makes sense for columns whose text sorts the way you'd expect
+// (name, or an RFC3339 timestamp column such as detected_at).
+func (f *TableFormatter) SetSort(column string) {
+	f.sortBy = column
+}
+
+// serverColumnValue returns the plain-text (uncolored, untruncated) value
+// of the named column for a server, and whether the column is known.
+func serverColumnValue(col string, s types.Server) (string, bool) {
+	switch col {
+	case "name":
+		return s.Name, true
+	case "description":
+		return s.Description, true
+	case "version":
+		return serverVersion(s), true
+	case "created_at":
+		return s.CreatedAt.Format(time.RFC3339), true
+	case "updated_at":
+		return s.UpdatedAt.Format(time.RFC3339), true
+	default:
+		return "", false
+	}
+}
+
+func serverColumnHeader(col string) string {
+	switch col {
+	case "name":
+		return "NAME"
+	case "description":
+		return "DESCRIPTION"
+	case "version":
+		return "VERSION"
+	case "created_at":
+		return "CREATED_AT"
+	case "updated_at":
+		return "UPDATED_AT"
+	default:
+		return strings.ToUpper(col)
+	}
+}
+
+func serverVersion(s types.Server) string {
+	if s.VersionDetail != nil {
+		return s.VersionDetail.Version
+	}
+	return ""
+}
+
+var defaultServerColumnKeys = []string{"name", "version", "description"}
+
+// formatServersCustom renders servers with caller-selected columns and
+// optional sort, for FormatServers when --columns or --sort is set.
+func formatServersCustom(servers []types.Server, columns []string, sortBy string) string {
+	if len(columns) == 0 {
+		columns = defaultServerColumnKeys
+	}
+
+	sorted := make([]types.Server, len(servers))
+	copy(sorted, servers)
+	if sortBy != "" {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			vi, _ := serverColumnValue(sortBy, sorted[i])
+			vj, _ := serverColumnValue(sortBy, sorted[j])
+			return vi < vj
+		})
+	}
+
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = serverColumnHeader(col)
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, s := range sorted {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			v, ok := serverColumnValue(col, s)
+			if !ok {
+				v = "-"
+			}
+			values[i] = v
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	w.Flush()
+	return sb.String()
+}
+
+// changeColumnValue returns the plain-text value of the named column for
+// a change, and whether the column is known.
+func changeColumnValue(col string, c types.Change) (string, bool) {
+	switch col {
+	case "type":
+		return string(c.ChangeType), true
+	case "server":
+		return c.ServerName, true
+	case "previous_version":
+		return c.PreviousVersion, true
+	case "new_version":
+		return c.NewVersion, true
+	case "severity":
+		return string(c.Severity), true
+	case "detected_at":
+		return c.DetectedAt.Format(time.RFC3339), true
+	default:
+		return "", false
+	}
+}
+
+func changeColumnHeader(col string) string {
+	switch col {
+	case "type":
+		return "TYPE"
+	case "server":
+		return "SERVER"
+	case "previous_version":
+		return "PREVIOUS_VERSION"
+	case "new_version":
+		return "NEW_VERSION"
+	case "severity":
+		return "SEVERITY"
+	case "detected_at":
+		return "DETECTED_AT"
+	default:
+		return strings.ToUpper(col)
+	}
+}
+
+var defaultChangeColumnKeys = []string{"type", "server", "new_version", "detected_at"}
+
+// formatChangesCustom renders changes with caller-selected columns and
+// optional sort, for FormatChanges when --columns or --sort is set.
+func formatChangesCustom(changes []types.Change, columns []string, sortBy string) string {
+	if len(columns) == 0 {
+		columns = defaultChangeColumnKeys
+	}
+
+	sorted := make([]types.Change, len(changes))
+	copy(sorted, changes)
+	if sortBy != "" {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			vi, _ := changeColumnValue(sortBy, sorted[i])
+			vj, _ := changeColumnValue(sortBy, sorted[j])
+			return vi < vj
+		})
+	}
+
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = changeColumnHeader(col)
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, c := range sorted {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			v, ok := changeColumnValue(col, c)
+			if !ok {
+				v = "-"
+			}
+			values[i] = v
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	w.Flush()
+	return sb.String()
+}
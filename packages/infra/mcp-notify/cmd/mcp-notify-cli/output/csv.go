@@ -0,0 +1,151 @@
+package output
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// CSVFormatter formats output as CSV, for the tabular types (changes,
+// servers, diffs, audit log entries, watchlist status) it's meant for.
+// Nested types without a natural row shape (subscriptions, subscription
+// health) fall back to compact JSON so no data is silently dropped.
+type CSVFormatter struct {
+	BaseFormatter
+}
+
+// NewCSVFormatter creates a new CSV formatter.
+func NewCSVFormatter() *CSVFormatter {
+	return &CSVFormatter{}
+}
+
+// writeCSV renders rows (including the header) to a CSV string.
+func writeCSV(header []string, rows [][]string) string {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	_ = w.Write(header)
+	for _, row := range rows {
+		_ = w.Write(row)
+	}
+	w.Flush()
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func changeCSVRow(c types.Change) []string {
+	return []string{
+		c.ID.String(),
+		c.ServerName,
+		string(c.ChangeType),
+		c.PreviousVersion,
+		c.NewVersion,
+		string(c.Severity),
+		c.DetectedAt.Format(time.RFC3339),
+	}
+}
+
+var changeCSVHeader = []string{"id", "server_name", "change_type", "previous_version", "new_version", "severity", "detected_at"}
+
+// FormatChanges formats changes as CSV.
+func (f *CSVFormatter) FormatChanges(changes []types.Change) string {
+	rows := make([][]string, len(changes))
+	for i, c := range changes {
+		rows[i] = changeCSVRow(c)
+	}
+	return writeCSV(changeCSVHeader, rows)
+}
+
+// FormatServers formats servers as CSV.
+func (f *CSVFormatter) FormatServers(servers []types.Server) string {
+	header := []string{"name", "description", "latest_version", "created_at", "updated_at"}
+	rows := make([][]string, len(servers))
+	for i, s := range servers {
+		version := ""
+		if s.VersionDetail != nil {
+			version = s.VersionDetail.Version
+		}
+		rows[i] = []string{s.Name, s.Description, version, s.CreatedAt.Format(time.RFC3339), s.UpdatedAt.Format(time.RFC3339)}
+	}
+	return writeCSV(header, rows)
+}
+
+// FormatServer formats a single server as a one-row CSV.
+func (f *CSVFormatter) FormatServer(server *types.Server) string {
+	if server == nil {
+		return ""
+	}
+	return f.FormatServers([]types.Server{*server})
+}
+
+// FormatDiff formats a diff result as CSV: one row per changed server
+// across new, updated, and removed servers.
+func (f *CSVFormatter) FormatDiff(diff *types.DiffResult) string {
+	if diff == nil {
+		return ""
+	}
+	all := make([]types.Change, 0, diff.TotalChanges)
+	all = append(all, diff.NewServers...)
+	all = append(all, diff.UpdatedServers...)
+	all = append(all, diff.RemovedServers...)
+	return f.FormatChanges(all)
+}
+
+// FormatSubscriptions has no natural CSV row shape (filters, channels, and
+// routes are nested), so it falls back to compact JSON per subscription.
+func (f *CSVFormatter) FormatSubscriptions(subscriptions []types.Subscription) string {
+	lines := make([]string, len(subscriptions))
+	for i, s := range subscriptions {
+		lines[i] = jsonLine(s)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FormatSubscription falls back to JSON; see FormatSubscriptions.
+func (f *CSVFormatter) FormatSubscription(subscription *types.Subscription) string {
+	if subscription == nil {
+		return ""
+	}
+	return jsonLine(subscription)
+}
+
+// FormatSubscriptionHealth falls back to JSON; see FormatSubscriptions.
+func (f *CSVFormatter) FormatSubscriptionHealth(health *subscription.HealthReport) string {
+	if health == nil {
+		return ""
+	}
+	return jsonLine(health)
+}
+
+// FormatAuditLog formats audit log entries as CSV.
+func (f *CSVFormatter) FormatAuditLog(entries []types.AuditLogEntry) string {
+	header := []string{"id", "created_at", "action", "actor_type", "actor_id", "resource_type", "resource_id"}
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		rows[i] = []string{
+			e.ID.String(),
+			e.CreatedAt.Format(time.RFC3339),
+			e.Action,
+			e.ActorType,
+			e.ActorID,
+			e.ResourceType,
+			e.ResourceID.String(),
+		}
+	}
+	return writeCSV(header, rows)
+}
+
+// FormatWatchlistStatus formats a watchlist's per-server status as CSV.
+func (f *CSVFormatter) FormatWatchlistStatus(status *types.WatchlistStatusResponse) string {
+	if status == nil {
+		return ""
+	}
+	header := []string{"name", "found", "latest_version"}
+	rows := make([][]string, len(status.Servers))
+	for i, s := range status.Servers {
+		rows[i] = []string{s.Name, strconv.FormatBool(s.Found), s.LatestVersion}
+	}
+	return writeCSV(header, rows)
+}
@@ -11,6 +11,7 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -25,6 +26,9 @@ type Formatter interface {
 	// FormatSubscription formats a single subscription with details.
 	FormatSubscription(subscription *types.Subscription) string
 
+	// FormatSubscriptionHealth formats a subscription's health report.
+	FormatSubscriptionHealth(health *subscription.HealthReport) string
+
 	// FormatServers formats a list of servers.
 	FormatServers(servers []types.Server) string
 
@@ -34,6 +38,12 @@ type Formatter interface {
 	// FormatDiff formats a diff result.
 	FormatDiff(diff *types.DiffResult) string
 
+	// FormatAuditLog formats a list of audit log entries.
+	FormatAuditLog(entries []types.AuditLogEntry) string
+
+	// FormatWatchlistStatus formats a watchlist and its servers' status.
+	FormatWatchlistStatus(status *types.WatchlistStatusResponse) string
+
 	// SetWriter sets the output writer.
 	SetWriter(w io.Writer)
 
@@ -48,6 +58,10 @@ func NewFormatter(format string) Formatter {
 		return NewJSONFormatter()
 	case "yaml", "yml":
 		return NewYAMLFormatter()
+	case "csv":
+		return NewCSVFormatter()
+	case "jsonl":
+		return NewJSONLFormatter()
 	default:
 		return NewTableFormatter()
 	}
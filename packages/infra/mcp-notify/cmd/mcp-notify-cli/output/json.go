@@ -1,6 +1,7 @@
 package output
 
 import (
+	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -38,6 +39,18 @@ func (f *JSONFormatter) FormatSubscriptions(subscriptions []types.Subscription)
 	return out
 }
 
+// FormatAuditLog formats a list of audit log entries as JSON.
+func (f *JSONFormatter) FormatAuditLog(entries []types.AuditLogEntry) string {
+	if entries == nil {
+		entries = []types.AuditLogEntry{}
+	}
+	out, err := prettyJSON(entries)
+	if err != nil {
+		return `{"error": "failed to format audit log"}`
+	}
+	return out
+}
+
 // FormatSubscription formats a single subscription as JSON.
 func (f *JSONFormatter) FormatSubscription(subscription *types.Subscription) string {
 	if subscription == nil {
@@ -50,6 +63,18 @@ func (f *JSONFormatter) FormatSubscription(subscription *types.Subscription) str
 	return out
 }
 
+// FormatSubscriptionHealth formats a subscription health report as JSON.
+func (f *JSONFormatter) FormatSubscriptionHealth(health *subscription.HealthReport) string {
+	if health == nil {
+		return `null`
+	}
+	out, err := prettyJSON(health)
+	if err != nil {
+		return `{"error": "failed to format subscription health"}`
+	}
+	return out
+}
+
 // FormatServers formats servers as JSON.
 func (f *JSONFormatter) FormatServers(servers []types.Server) string {
 	if servers == nil {
@@ -74,6 +99,18 @@ func (f *JSONFormatter) FormatServer(server *types.Server) string {
 	return out
 }
 
+// FormatWatchlistStatus formats a watchlist status as JSON.
+func (f *JSONFormatter) FormatWatchlistStatus(status *types.WatchlistStatusResponse) string {
+	if status == nil {
+		return `null`
+	}
+	out, err := prettyJSON(status)
+	if err != nil {
+		return `{"error": "failed to format watchlist status"}`
+	}
+	return out
+}
+
 // FormatDiff formats a diff result as JSON.
 func (f *JSONFormatter) FormatDiff(diff *types.DiffResult) string {
 	if diff == nil {
@@ -0,0 +1,116 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// JSONLFormatter formats output as JSON Lines: one compact JSON object per
+// row, with no enclosing array, for streaming into tools that read
+// newline-delimited JSON.
+type JSONLFormatter struct {
+	BaseFormatter
+}
+
+// NewJSONLFormatter creates a new JSONL formatter.
+func NewJSONLFormatter() *JSONLFormatter {
+	return &JSONLFormatter{}
+}
+
+// jsonLine marshals v to a single compact JSON line.
+func jsonLine(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return `{"error": "failed to format item"}`
+	}
+	return string(data)
+}
+
+// FormatChanges formats changes as JSONL.
+func (f *JSONLFormatter) FormatChanges(changes []types.Change) string {
+	lines := make([]string, len(changes))
+	for i, c := range changes {
+		lines[i] = jsonLine(c)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FormatSubscriptions formats subscriptions as JSONL.
+func (f *JSONLFormatter) FormatSubscriptions(subscriptions []types.Subscription) string {
+	lines := make([]string, len(subscriptions))
+	for i, s := range subscriptions {
+		lines[i] = jsonLine(s)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FormatAuditLog formats a list of audit log entries as JSONL.
+func (f *JSONLFormatter) FormatAuditLog(entries []types.AuditLogEntry) string {
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = jsonLine(e)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FormatSubscription formats a single subscription as a JSON line.
+func (f *JSONLFormatter) FormatSubscription(subscription *types.Subscription) string {
+	if subscription == nil {
+		return `null`
+	}
+	return jsonLine(subscription)
+}
+
+// FormatSubscriptionHealth formats a subscription health report as a JSON line.
+func (f *JSONLFormatter) FormatSubscriptionHealth(health *subscription.HealthReport) string {
+	if health == nil {
+		return `null`
+	}
+	return jsonLine(health)
+}
+
+// FormatServers formats servers as JSONL.
+func (f *JSONLFormatter) FormatServers(servers []types.Server) string {
+	lines := make([]string, len(servers))
+	for i, s := range servers {
+		lines[i] = jsonLine(s)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FormatServer formats a single server as a JSON line.
+func (f *JSONLFormatter) FormatServer(server *types.Server) string {
+	if server == nil {
+		return `null`
+	}
+	return jsonLine(server)
+}
+
+// FormatWatchlistStatus formats a watchlist status as a JSON line.
+func (f *JSONLFormatter) FormatWatchlistStatus(status *types.WatchlistStatusResponse) string {
+	if status == nil {
+		return `null`
+	}
+	return jsonLine(status)
+}
+
+// FormatDiff formats a diff result as JSONL: one line per changed server,
+// across new, updated, and removed servers, rather than one line for the
+// whole diff (which would defeat the point of JSONL for downstream tools).
+func (f *JSONLFormatter) FormatDiff(diff *types.DiffResult) string {
+	if diff == nil {
+		return ""
+	}
+	all := make([]types.Change, 0, diff.TotalChanges)
+	all = append(all, diff.NewServers...)
+	all = append(all, diff.UpdatedServers...)
+	all = append(all, diff.RemovedServers...)
+	lines := make([]string, len(all))
+	for i, c := range all {
+		lines[i] = jsonLine(c)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -27,6 +28,12 @@ const (
 // TableFormatter formats output as aligned tables.
 type TableFormatter struct {
 	BaseFormatter
+
+	// columns and sortBy customize FormatServers/FormatChanges, similar to
+	// kubectl's -o custom-columns. Set via SetColumns/SetSort; empty means
+	// use each formatter's default columns and detection order.
+	columns []string
+	sortBy  string
 }
 
 // NewTableFormatter creates a new table formatter.
@@ -48,6 +55,10 @@ func (f *TableFormatter) FormatChanges(changes []types.Change) string {
 		return f.color(ColorDim, "No changes found.")
 	}
 
+	if len(f.columns) > 0 || f.sortBy != "" {
+		return formatChangesCustom(changes, f.columns, f.sortBy)
+	}
+
 	var sb strings.Builder
 	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
 
@@ -122,6 +133,31 @@ func (f *TableFormatter) FormatSubscriptions(subscriptions []types.Subscription)
 	return sb.String()
 }
 
+// FormatAuditLog formats a list of audit log entries as a table.
+func (f *TableFormatter) FormatAuditLog(entries []types.AuditLogEntry) string {
+	if len(entries) == 0 {
+		return f.color(ColorDim, "No audit log entries found.")
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, f.color(ColorBold, "TIME\tACTION\tRESOURCE\tACTOR\tIP"))
+
+	for _, e := range entries {
+		resource := e.ResourceType + "/" + e.ResourceID.String()[:8] + "..."
+		actor := e.ActorType
+		if e.ActorID != "" {
+			actor += ":" + e.ActorID
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			e.CreatedAt.Format("2006-01-02 15:04:05"), e.Action, resource, actor, e.IP)
+	}
+
+	w.Flush()
+	return sb.String()
+}
+
 func (f *TableFormatter) formatStatus(status types.SubscriptionStatus) string {
 	switch status {
 	case types.SubscriptionStatusActive:
@@ -215,12 +251,84 @@ func (f *TableFormatter) FormatSubscription(sub *types.Subscription) string {
 	return sb.String()
 }
 
+// FormatSubscriptionHealth formats a subscription health report as a table.
+func (f *TableFormatter) FormatSubscriptionHealth(health *subscription.HealthReport) string {
+	if health == nil {
+		return f.color(ColorDim, "Health information not available.")
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("\n" + f.color(ColorBold, "Health\n"))
+	sb.WriteString(strings.Repeat("─", 40) + "\n")
+
+	scoreColor := ColorGreen
+	if health.Score < 50 {
+		scoreColor = ColorRed
+	} else if health.Score < 80 {
+		scoreColor = ColorYellow
+	}
+	fmt.Fprintf(&sb, "%-15s %s\n", "Score:", f.color(scoreColor, fmt.Sprintf("%d/100", health.Score)))
+
+	if health.DeliverySuccessRate >= 0 {
+		fmt.Fprintf(&sb, "%-15s %.0f%%\n", "Delivery:", health.DeliverySuccessRate*100)
+	} else {
+		fmt.Fprintf(&sb, "%-15s %s\n", "Delivery:", "no deliveries yet")
+	}
+	fmt.Fprintf(&sb, "%-15s %d changes matched in the last %d days\n", "Filter matches:", health.FilterMatchCount, health.LookbackDays)
+
+	if len(health.Recommendations) > 0 {
+		sb.WriteString("\n" + f.color(ColorBold, "Recommendations:\n"))
+		for _, r := range health.Recommendations {
+			fmt.Fprintf(&sb, "  - %s\n", r)
+		}
+	}
+
+	return sb.String()
+}
+
+// FormatWatchlistStatus formats a watchlist and its servers' status as a table.
+func (f *TableFormatter) FormatWatchlistStatus(status *types.WatchlistStatusResponse) string {
+	if status == nil {
+		return f.color(ColorDim, "Watchlist not found.")
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(f.color(ColorBold, "Watchlist\n"))
+	sb.WriteString(strings.Repeat("─", 40) + "\n")
+	fmt.Fprintf(&sb, "%-15s %s\n", "ID:", status.Watchlist.ID.String())
+	fmt.Fprintf(&sb, "%-15s %s\n", "Updated:", status.Watchlist.UpdatedAt.Format("2006-01-02 15:04:05"))
+	sb.WriteString("\n")
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, f.color(ColorBold, "SERVER\tSTATUS\tLATEST VERSION"))
+
+	for _, s := range status.Servers {
+		serverStatus := f.color(ColorGreen, "found")
+		version := "-"
+		if !s.Found {
+			serverStatus = f.color(ColorRed, "not found")
+		} else if s.LatestVersion != "" {
+			version = s.LatestVersion
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", TruncateString(s.Name, 40), serverStatus, version)
+	}
+
+	w.Flush()
+	return sb.String()
+}
+
 // FormatServers formats servers as a table.
 func (f *TableFormatter) FormatServers(servers []types.Server) string {
 	if len(servers) == 0 {
 		return f.color(ColorDim, "No servers found.")
 	}
 
+	if len(f.columns) > 0 || f.sortBy != "" {
+		return formatServersCustom(servers, f.columns, f.sortBy)
+	}
+
 	var sb strings.Builder
 	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
 
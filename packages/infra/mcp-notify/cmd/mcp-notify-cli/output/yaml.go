@@ -1,6 +1,7 @@
 package output
 
 import (
+	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -38,6 +39,18 @@ func (f *YAMLFormatter) FormatSubscriptions(subscriptions []types.Subscription)
 	return out
 }
 
+// FormatAuditLog formats a list of audit log entries as YAML.
+func (f *YAMLFormatter) FormatAuditLog(entries []types.AuditLogEntry) string {
+	if entries == nil {
+		entries = []types.AuditLogEntry{}
+	}
+	out, err := prettyYAML(entries)
+	if err != nil {
+		return "error: failed to format audit log"
+	}
+	return out
+}
+
 // FormatSubscription formats a single subscription as YAML.
 func (f *YAMLFormatter) FormatSubscription(subscription *types.Subscription) string {
 	if subscription == nil {
@@ -50,6 +63,18 @@ func (f *YAMLFormatter) FormatSubscription(subscription *types.Subscription) str
 	return out
 }
 
+// FormatSubscriptionHealth formats a subscription health report as YAML.
+func (f *YAMLFormatter) FormatSubscriptionHealth(health *subscription.HealthReport) string {
+	if health == nil {
+		return "null"
+	}
+	out, err := prettyYAML(health)
+	if err != nil {
+		return "error: failed to format subscription health"
+	}
+	return out
+}
+
 // FormatServers formats servers as YAML.
 func (f *YAMLFormatter) FormatServers(servers []types.Server) string {
 	if servers == nil {
@@ -74,6 +99,18 @@ func (f *YAMLFormatter) FormatServer(server *types.Server) string {
 	return out
 }
 
+// FormatWatchlistStatus formats a watchlist status as YAML.
+func (f *YAMLFormatter) FormatWatchlistStatus(status *types.WatchlistStatusResponse) string {
+	if status == nil {
+		return "null"
+	}
+	out, err := prettyYAML(status)
+	if err != nil {
+		return "error: failed to format watchlist status"
+	}
+	return out
+}
+
 // FormatDiff formats a diff result as YAML.
 func (f *YAMLFormatter) FormatDiff(diff *types.DiffResult) string {
 	if diff == nil {
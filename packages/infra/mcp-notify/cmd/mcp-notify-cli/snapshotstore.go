@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// snapshotFileTimeFormat names local snapshot files so they sort
+// chronologically by filename alone.
+const snapshotFileTimeFormat = "20060102T150405Z"
+
+// defaultSnapshotStoreDir returns the default local directory for offline
+// snapshot history, ~/.mcp-notify/snapshots.
+func defaultSnapshotStoreDir() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".mcp-notify", "snapshots")
+}
+
+// saveLocalSnapshot writes a snapshot to dir so future runs can diff
+// against it. A blank dir disables local history entirely.
+func saveLocalSnapshot(dir string, snapshot *types.Snapshot) error {
+	if dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create snapshot store directory: %w", err)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	path := filepath.Join(dir, snapshot.Timestamp.UTC().Format(snapshotFileTimeFormat)+".json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// loadLocalSnapshotBefore returns the most recent locally stored snapshot
+// at or before cutoff, or nil if dir is blank, doesn't exist yet, or has
+// no snapshot old enough (e.g. "changes since yesterday" run for the
+// first time).
+func loadLocalSnapshotBefore(dir string, cutoff time.Time) (*types.Snapshot, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read snapshot store directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+
+	for _, name := range names {
+		ts, err := time.Parse(snapshotFileTimeFormat+".json", name)
+		if err != nil {
+			continue // Not one of our snapshot files; ignore.
+		}
+		if !ts.After(cutoff) {
+			data, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read snapshot %s: %w", name, err)
+			}
+			var snapshot types.Snapshot
+			if err := json.Unmarshal(data, &snapshot); err != nil {
+				return nil, fmt.Errorf("failed to parse snapshot %s: %w", name, err)
+			}
+			return &snapshot, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,479 @@
+// Package tui provides an interactive terminal UI for the CLI, with panes
+// for live changes, server search, and subscriptions, for users who prefer
+// to stay in the terminal instead of issuing one-off commands.
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config configures the TUI's connection to the API.
+type Config struct {
+	APIEndpoint string
+	APIKey      string
+}
+
+// Run starts the interactive TUI and blocks until the user quits.
+func Run(cfg Config) error {
+	p := tea.NewProgram(newModel(cfg), tea.WithAltScreen())
+	_, err := p.Run()
+	return err
+}
+
+type pane int
+
+const (
+	paneChanges pane = iota
+	paneServers
+	paneSubscriptions
+	paneCount
+)
+
+func (p pane) String() string {
+	switch p {
+	case paneChanges:
+		return "Changes"
+	case paneServers:
+		return "Servers"
+	case paneSubscriptions:
+		return "Subscriptions"
+	default:
+		return "?"
+	}
+}
+
+var (
+	tabActiveStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("15")).Background(lipgloss.Color("62")).Padding(0, 2)
+	tabInactiveStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Padding(0, 2)
+	cursorStyle      = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212"))
+	dimStyle         = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	errorStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	helpStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+)
+
+// model is the root bubbletea model. Each pane keeps its own loaded data,
+// cursor position, and loading state, so switching tabs never discards
+// what was already fetched.
+type model struct {
+	cfg    Config
+	client *http.Client
+
+	active pane
+	width  int
+	height int
+
+	cursor  [paneCount]int
+	loading [paneCount]bool
+	loaded  [paneCount]bool
+	err     error
+
+	changes       []types.Change
+	servers       []types.Server
+	subscriptions []types.Subscription
+
+	filtering bool
+	filter    string
+}
+
+func newModel(cfg Config) model {
+	return model{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 15 * time.Second},
+		active: paneChanges,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.loadCmd(paneChanges)
+}
+
+// Messages carrying the result of a background fetch.
+type changesLoadedMsg []types.Change
+type serversLoadedMsg []types.Server
+type subscriptionsLoadedMsg []types.Subscription
+type loadErrMsg struct {
+	pane pane
+	err  error
+}
+
+func (m model) loadCmd(p pane) tea.Cmd {
+	cfg := m.cfg
+	client := m.client
+	return func() tea.Msg {
+		switch p {
+		case paneChanges:
+			changes, err := fetchChanges(client, cfg)
+			if err != nil {
+				return loadErrMsg{pane: p, err: err}
+			}
+			return changesLoadedMsg(changes)
+		case paneServers:
+			servers, err := fetchServers(client, cfg)
+			if err != nil {
+				return loadErrMsg{pane: p, err: err}
+			}
+			return serversLoadedMsg(servers)
+		case paneSubscriptions:
+			subs, err := fetchSubscriptions(client, cfg)
+			if err != nil {
+				return loadErrMsg{pane: p, err: err}
+			}
+			return subscriptionsLoadedMsg(subs)
+		default:
+			return nil
+		}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case changesLoadedMsg:
+		m.changes = msg
+		m.loading[paneChanges] = false
+		m.loaded[paneChanges] = true
+		m.err = nil
+		return m, nil
+
+	case serversLoadedMsg:
+		m.servers = msg
+		m.loading[paneServers] = false
+		m.loaded[paneServers] = true
+		m.err = nil
+		return m, nil
+
+	case subscriptionsLoadedMsg:
+		m.subscriptions = msg
+		m.loading[paneSubscriptions] = false
+		m.loaded[paneSubscriptions] = true
+		m.err = nil
+		return m, nil
+
+	case loadErrMsg:
+		m.loading[msg.pane] = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.updateKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m model) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filtering {
+		switch msg.String() {
+		case "esc":
+			m.filtering = false
+			m.filter = ""
+			m.cursor[m.active] = 0
+			return m, nil
+		case "enter":
+			m.filtering = false
+			return m, nil
+		case "backspace":
+			if len(m.filter) > 0 {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+			return m, nil
+		default:
+			if len(msg.Runes) > 0 {
+				m.filter += string(msg.Runes)
+			}
+			return m, nil
+		}
+	}
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "tab", "l", "right":
+		m.active = (m.active + 1) % paneCount
+		return m, m.ensureLoaded()
+
+	case "shift+tab", "h", "left":
+		m.active = (m.active - 1 + paneCount) % paneCount
+		return m, m.ensureLoaded()
+
+	case "up", "k":
+		if m.cursor[m.active] > 0 {
+			m.cursor[m.active]--
+		}
+		return m, nil
+
+	case "down", "j":
+		if m.cursor[m.active] < m.visibleCount()-1 {
+			m.cursor[m.active]++
+		}
+		return m, nil
+
+	case "r":
+		m.loading[m.active] = true
+		m.err = nil
+		return m, m.loadCmd(m.active)
+
+	case "/":
+		if m.active == paneServers {
+			m.filtering = true
+			m.filter = ""
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// ensureLoaded triggers a fetch for the newly active pane the first time
+// it's viewed, rather than eagerly loading every pane up front.
+func (m model) ensureLoaded() tea.Cmd {
+	if m.loaded[m.active] || m.loading[m.active] {
+		return nil
+	}
+	return m.loadCmd(m.active)
+}
+
+// visibleCount returns how many rows the active pane currently has to
+// scroll through, after filtering.
+func (m model) visibleCount() int {
+	switch m.active {
+	case paneChanges:
+		return len(m.changes)
+	case paneServers:
+		return len(m.filteredServers())
+	case paneSubscriptions:
+		return len(m.subscriptions)
+	default:
+		return 0
+	}
+}
+
+func (m model) filteredServers() []types.Server {
+	if m.filter == "" {
+		return m.servers
+	}
+	q := strings.ToLower(m.filter)
+	filtered := make([]types.Server, 0, len(m.servers))
+	for _, s := range m.servers {
+		if strings.Contains(strings.ToLower(s.Name), q) || strings.Contains(strings.ToLower(s.Description), q) {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+func (m model) View() string {
+	var sb strings.Builder
+
+	sb.WriteString(m.renderTabs())
+	sb.WriteString("\n\n")
+
+	switch m.active {
+	case paneChanges:
+		sb.WriteString(m.renderChanges())
+	case paneServers:
+		sb.WriteString(m.renderServers())
+	case paneSubscriptions:
+		sb.WriteString(m.renderSubscriptions())
+	}
+
+	if m.err != nil {
+		sb.WriteString("\n" + errorStyle.Render(fmt.Sprintf("error: %v", m.err)) + "\n")
+	}
+
+	sb.WriteString("\n" + m.renderHelp())
+
+	return sb.String()
+}
+
+func (m model) renderTabs() string {
+	tabs := make([]string, 0, paneCount)
+	for p := pane(0); p < paneCount; p++ {
+		if p == m.active {
+			tabs = append(tabs, tabActiveStyle.Render(p.String()))
+		} else {
+			tabs = append(tabs, tabInactiveStyle.Render(p.String()))
+		}
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, tabs...)
+}
+
+func (m model) renderHelp() string {
+	if m.filtering {
+		return helpStyle.Render("filter: " + m.filter + "▏  (enter: apply · esc: cancel)")
+	}
+	base := "tab: switch pane · ↑/↓: move · r: refresh · q: quit"
+	if m.active == paneServers {
+		base += " · /: filter"
+	}
+	return helpStyle.Render(base)
+}
+
+func (m model) renderChanges() string {
+	if m.loading[paneChanges] {
+		return dimStyle.Render("Loading changes...")
+	}
+	if len(m.changes) == 0 {
+		return dimStyle.Render("No recent changes.")
+	}
+
+	var sb strings.Builder
+	for i, c := range m.changes {
+		line := fmt.Sprintf("%-8s %-40s %s", string(c.ChangeType), truncate(c.ServerName, 40), c.DetectedAt.Format("2006-01-02 15:04"))
+		sb.WriteString(renderRow(i == m.cursor[paneChanges], line))
+	}
+	return sb.String()
+}
+
+func (m model) renderServers() string {
+	if m.loading[paneServers] {
+		return dimStyle.Render("Loading servers...")
+	}
+	servers := m.filteredServers()
+	if len(servers) == 0 {
+		return dimStyle.Render("No matching servers.")
+	}
+
+	var sb strings.Builder
+	for i, s := range servers {
+		version := "-"
+		if s.VersionDetail != nil && s.VersionDetail.Version != "" {
+			version = s.VersionDetail.Version
+		}
+		line := fmt.Sprintf("%-40s %-10s %s", truncate(s.Name, 40), version, truncate(s.Description, 50))
+		sb.WriteString(renderRow(i == m.cursor[paneServers], line))
+	}
+	return sb.String()
+}
+
+func (m model) renderSubscriptions() string {
+	if m.loading[paneSubscriptions] {
+		return dimStyle.Render("Loading subscriptions...")
+	}
+	if len(m.subscriptions) == 0 {
+		return dimStyle.Render("No subscriptions.")
+	}
+
+	var sb strings.Builder
+	for i, s := range m.subscriptions {
+		line := fmt.Sprintf("%-36s %-10s %s", s.ID.String(), string(s.Status), truncate(s.Name, 40))
+		sb.WriteString(renderRow(i == m.cursor[paneSubscriptions], line))
+	}
+	return sb.String()
+}
+
+func renderRow(selected bool, line string) string {
+	if selected {
+		return cursorStyle.Render("> "+line) + "\n"
+	}
+	return "  " + line + "\n"
+}
+
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	if maxLen <= 3 {
+		return s[:maxLen]
+	}
+	return s[:maxLen-3] + "..."
+}
+
+func fetchChanges(client *http.Client, cfg Config) ([]types.Change, error) {
+	req, err := http.NewRequest("GET", cfg.APIEndpoint+"/api/v1/changes?limit=50", nil)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.APIKey != "" {
+		req.Header.Set("X-API-Key", cfg.APIKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result types.ChangesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Changes, nil
+}
+
+func fetchServers(client *http.Client, cfg Config) ([]types.Server, error) {
+	req, err := http.NewRequest("GET", cfg.APIEndpoint+"/api/v1/servers", nil)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.APIKey != "" {
+		req.Header.Set("X-API-Key", cfg.APIKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result struct {
+		Servers []types.Server `json:"servers"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Servers, nil
+}
+
+func fetchSubscriptions(client *http.Client, cfg Config) ([]types.Subscription, error) {
+	req, err := http.NewRequest("GET", cfg.APIEndpoint+"/api/v1/subscriptions", nil)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.APIKey != "" {
+		req.Header.Set("X-API-Key", cfg.APIKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+	}
+
+	var result struct {
+		Subscriptions []types.Subscription `json:"subscriptions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Subscriptions, nil
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	notifyapi "github.com/nirholas/mcp-notify/pkg/client"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+const (
+	// serverCacheTTL is how often the cache does a full re-fetch of the
+	// registry's server list.
+	serverCacheTTL = 5 * time.Minute
+	// serverCacheIncrementalInterval is how often the cache patches itself
+	// against the notify API's change history between full refreshes. Much
+	// cheaper than a full registry listing, so it can run far more often.
+	serverCacheIncrementalInterval = 30 * time.Second
+)
+
+// serverCache holds an in-process copy of the registry's server list, so
+// every tool call doesn't pay for a full registry fetch. It's kept fresh by
+// run, which does a full refresh every serverCacheTTL and, when notify is
+// configured, a cheaper incremental patch via the mcp-notify API's change
+// history in between.
+type serverCache struct {
+	mu         sync.RWMutex
+	servers    []types.Server
+	byName     map[string]int
+	lastIncrAt time.Time
+}
+
+func newServerCache() *serverCache {
+	return &serverCache{}
+}
+
+// list returns a snapshot of the cached server list.
+func (c *serverCache) list() []types.Server {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make([]types.Server, len(c.servers))
+	copy(out, c.servers)
+	return out
+}
+
+// get looks up a server by exact name, case-insensitively.
+func (c *serverCache) get(name string) (types.Server, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	idx, ok := c.byName[strings.ToLower(name)]
+	if !ok {
+		return types.Server{}, false
+	}
+	return c.servers[idx], true
+}
+
+func (c *serverCache) replace(servers []types.Server) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.servers = servers
+	c.lastIncrAt = now
+	c.rebuildIndexLocked()
+}
+
+func (c *serverCache) rebuildIndexLocked() {
+	c.byName = make(map[string]int, len(c.servers))
+	for i, s := range c.servers {
+		c.byName[strings.ToLower(s.Name)] = i
+	}
+}
+
+func (c *serverCache) refreshFull(ctx context.Context) error {
+	servers, err := client.ListServers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to refresh server cache: %w", err)
+	}
+	c.replace(servers)
+	return nil
+}
+
+// applyIncremental merges recent changes from the notify API's change
+// history into the cache, so new and updated servers show up without
+// waiting for the next full serverCacheTTL refresh. Changes only carry a
+// name and diff summary, not the full server record, so each changed
+// server is re-fetched directly from the registry.
+func (c *serverCache) applyIncremental(ctx context.Context) error {
+	c.mu.RLock()
+	since := c.lastIncrAt
+	c.mu.RUnlock()
+	if since.IsZero() {
+		return nil
+	}
+
+	resp, err := notify.ListChanges(ctx, &notifyapi.ListChangesParams{Since: &since, Limit: 200})
+	if err != nil {
+		return fmt.Errorf("failed to fetch incremental changes: %w", err)
+	}
+
+	removed := make(map[string]bool)
+	changed := make(map[string]bool)
+	for _, ch := range resp.Changes {
+		if ch.ChangeType == "removed" {
+			removed[ch.ServerName] = true
+		} else {
+			changed[ch.ServerName] = true
+		}
+	}
+
+	updates := make(map[string]types.Server, len(changed))
+	for name := range changed {
+		s, err := client.GetServer(ctx, name)
+		if err != nil || s == nil {
+			continue
+		}
+		updates[name] = *s
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(removed) > 0 {
+		kept := c.servers[:0:0]
+		for _, s := range c.servers {
+			if !removed[s.Name] {
+				kept = append(kept, s)
+			}
+		}
+		c.servers = kept
+	}
+	for name, s := range updates {
+		if idx, ok := c.byName[strings.ToLower(name)]; ok {
+			c.servers[idx] = s
+		} else {
+			c.servers = append(c.servers, s)
+		}
+	}
+	c.rebuildIndexLocked()
+	c.lastIncrAt = time.Now()
+	return nil
+}
+
+// run does an initial full refresh, then keeps the cache warm on a
+// background loop until ctx is done: a full refresh every serverCacheTTL,
+// and an incremental patch via the notify API every
+// serverCacheIncrementalInterval in between.
+func (c *serverCache) run(ctx context.Context) {
+	if err := c.refreshFull(ctx); err != nil {
+		log.Printf("initial server cache refresh failed: %v", err)
+	}
+
+	fullTicker := time.NewTicker(serverCacheTTL)
+	defer fullTicker.Stop()
+	incrTicker := time.NewTicker(serverCacheIncrementalInterval)
+	defer incrTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-fullTicker.C:
+			if err := c.refreshFull(ctx); err != nil {
+				log.Printf("server cache refresh failed: %v", err)
+			}
+		case <-incrTicker.C:
+			if err := c.applyIncremental(ctx); err != nil {
+				log.Printf("server cache incremental refresh failed: %v", err)
+			}
+		}
+	}
+}
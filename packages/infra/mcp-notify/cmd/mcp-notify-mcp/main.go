@@ -11,8 +11,10 @@ import (
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/nirholas/mcp-notify/internal/category"
 	"github.com/nirholas/mcp-notify/internal/config"
 	"github.com/nirholas/mcp-notify/internal/registry"
+	notifyapi "github.com/nirholas/mcp-notify/pkg/client"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -35,8 +37,40 @@ type ListByTagArgs struct {
 
 type GetStatsArgs struct{}
 
+type GetRecentChangesArgs struct {
+	Since  string `json:"since,omitempty" jsonschema:"description=RFC3339 timestamp to fetch changes since (default: 24 hours ago)"`
+	Server string `json:"server,omitempty" jsonschema:"description=Only include changes for this exact server name"`
+	Limit  int    `json:"limit,omitempty" jsonschema:"description=Maximum number of results (default 50)"`
+}
+
+type GetServerHistoryArgs struct {
+	Name  string `json:"name" jsonschema:"description=The exact name of the MCP server to get change history for"`
+	Limit int    `json:"limit,omitempty" jsonschema:"description=Maximum number of results (default 50)"`
+}
+
+type DiffSnapshotsArgs struct {
+	From string `json:"from" jsonschema:"description=RFC3339 timestamp for the start of the comparison window"`
+	To   string `json:"to" jsonschema:"description=RFC3339 timestamp for the end of the comparison window"`
+}
+
+type SemanticSearchServersArgs struct {
+	Query string `json:"query" jsonschema:"description=Natural-language description of what you're looking for, e.g. \"a tool for querying postgres databases\""`
+	Limit int    `json:"limit,omitempty" jsonschema:"description=Maximum number of results (default 10)"`
+}
+
 var client *registry.Client
 
+// notify talks to the mcp-notify API server's own change history and diff
+// endpoints, as opposed to client, which talks directly to the upstream MCP
+// registry. Configured from the same ~/.mcp-notify/config.yaml as
+// mcp-notify-cli, since both point at the same deployment.
+var notify *notifyapi.Client
+
+// cache holds the in-process, periodically-refreshed copy of the registry's
+// server list that search_servers, get_server, list_servers and get_stats
+// read from, so those tools don't each re-fetch the full registry.
+var cache *serverCache
+
 func main() {
 	// Initialize registry client
 	cfg, err := config.Load()
@@ -51,6 +85,20 @@ func main() {
 	}
 	client = registry.NewClient(cfg.Registry)
 
+	cliConfig, err := config.LoadCLIConfig()
+	if err != nil {
+		log.Printf("Failed to load CLI config, using defaults: %v", err)
+		cliConfig = config.DefaultCLIConfig()
+	}
+	var notifyOpts []notifyapi.Option
+	if cliConfig.APIKey != "" {
+		notifyOpts = append(notifyOpts, notifyapi.WithAPIKey(cliConfig.APIKey))
+	}
+	notify = notifyapi.New(cliConfig.APIEndpoint, notifyOpts...)
+
+	cache = newServerCache()
+	go cache.run(context.Background())
+
 	// Create MCP server
 	server := mcp.NewServer(&mcp.Implementation{
 		Name:    "mcp-notify",
@@ -78,6 +126,46 @@ func main() {
 		Description: "Get statistics about the MCP Registry, including total server count and recent activity.",
 	}, getStats)
 
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_categories",
+		Description: "List every category MCP servers can be tagged with (e.g. database, ai, productivity, devops), along with how many currently-listed servers fall into each.",
+	}, listCategories)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_by_tag",
+		Description: "List MCP servers tagged with a given category (see list_categories for valid values).",
+	}, listByTag)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_recent_changes",
+		Description: "Get recent changes detected in the registry (new, updated, or removed servers), optionally filtered by server name and since a given time. Use this to reason about what changed over a window, not just what exists now.",
+	}, getRecentChanges)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_server_history",
+		Description: "Get the full change history for a specific MCP server by its exact name, in chronological order.",
+	}, getServerHistory)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "diff_snapshots",
+		Description: "Compare two points in time and get the new, updated, and removed servers between them, based on the closest registry snapshot at or before each timestamp.",
+	}, diffSnapshots)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "semantic_search_servers",
+		Description: "Find MCP servers matching a natural-language description of what you're looking for, ranked by relevance. Use this instead of search_servers when the query describes a capability rather than matching the server's name or description text directly (e.g. \"something to read and write files on disk\").",
+	}, semanticSearchServers)
+
+	// Add prompts
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "daily_registry_briefing",
+		Description: "Compose a ready-to-read briefing of what's new in the MCP Registry today: recent changes, trending servers, and servers flagged as higher risk (no verifiable repository, pre-release version). Optionally scoped to a namespace prefix or keyword.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "namespace", Description: "Only include servers whose name starts with this namespace (e.g. \"io.github.acme\")"},
+			{Name: "keyword", Description: "Only include servers whose name or description contains this keyword"},
+		},
+	}, dailyRegistryBriefing)
+
 	// Run the server on stdio
 	if err := server.Run(context.Background(), &mcp.StdioTransport{}); err != nil {
 		log.Fatalf("Server failed: %v", err)
@@ -99,25 +187,14 @@ func searchServers(ctx context.Context, req *mcp.CallToolRequest, args SearchSer
 		limit = 20
 	}
 
-	servers, err := client.ListServers(ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch servers: %w", err)
-	}
+	servers := cache.list()
 
-	// Simple search - match query against name and description
-	query := strings.ToLower(args.Query)
-	var matches []types.Server
-	for _, s := range servers {
-		if strings.Contains(strings.ToLower(s.Name), query) ||
-			strings.Contains(strings.ToLower(s.Description), query) {
-			matches = append(matches, s)
-			if len(matches) >= limit {
-				break
-			}
-		}
+	ranked := rankServers(servers, args.Query)
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
 	}
 
-	if len(matches) == 0 {
+	if len(ranked) == 0 {
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: fmt.Sprintf("No servers found matching '%s'", args.Query)},
@@ -126,9 +203,10 @@ func searchServers(ctx context.Context, req *mcp.CallToolRequest, args SearchSer
 	}
 
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("Found %d servers matching '%s':\n\n", len(matches), args.Query))
-	for _, s := range matches {
-		sb.WriteString(fmt.Sprintf("**%s**\n", s.Name))
+	sb.WriteString(fmt.Sprintf("Found %d servers matching '%s':\n\n", len(ranked), args.Query))
+	for _, m := range ranked {
+		s := m.server
+		sb.WriteString(fmt.Sprintf("**%s** (relevance: %.0f)\n", s.Name, m.score))
 		if s.Description != "" {
 			sb.WriteString(fmt.Sprintf("  %s\n", truncate(s.Description, 100)))
 		}
@@ -155,28 +233,15 @@ func getServer(ctx context.Context, req *mcp.CallToolRequest, args GetServerArgs
 		}, nil, nil
 	}
 
-	servers, err := client.ListServers(ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch servers: %w", err)
-	}
-
-	// Find exact match (case-insensitive)
-	var found *types.Server
-	nameLower := strings.ToLower(args.Name)
-	for _, s := range servers {
-		if strings.ToLower(s.Name) == nameLower {
-			found = &s
-			break
-		}
-	}
-
-	if found == nil {
+	server, ok := cache.get(args.Name)
+	if !ok {
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: fmt.Sprintf("Server '%s' not found in the registry", args.Name)},
 			},
 		}, nil, nil
 	}
+	found := &server
 
 	// Format detailed output
 	var sb strings.Builder
@@ -211,10 +276,7 @@ func listServers(ctx context.Context, req *mcp.CallToolRequest, args ListServers
 		limit = 50
 	}
 
-	servers, err := client.ListServers(ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch servers: %w", err)
-	}
+	servers := cache.list()
 
 	if len(servers) > limit {
 		servers = servers[:limit]
@@ -239,10 +301,7 @@ func listServers(ctx context.Context, req *mcp.CallToolRequest, args ListServers
 }
 
 func getStats(ctx context.Context, req *mcp.CallToolRequest, args GetStatsArgs) (*mcp.CallToolResult, any, error) {
-	servers, err := client.ListServers(ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch servers: %w", err)
-	}
+	servers := cache.list()
 
 	// Count servers with various attributes
 	withDesc := 0
@@ -278,6 +337,421 @@ func getStats(ctx context.Context, req *mcp.CallToolRequest, args GetStatsArgs)
 	}, nil, nil
 }
 
+func listCategories(ctx context.Context, req *mcp.CallToolRequest, args ListCategoriesArgs) (*mcp.CallToolResult, any, error) {
+	servers := cache.list()
+
+	counts := make(map[string]int)
+	for _, s := range servers {
+		for _, tag := range category.Categorize(s) {
+			counts[tag]++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Categories:\n\n")
+	for _, cat := range category.AllCategories() {
+		sb.WriteString(fmt.Sprintf("- **%s**: %d servers\n", cat, counts[cat]))
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: sb.String()},
+		},
+	}, nil, nil
+}
+
+func listByTag(ctx context.Context, req *mcp.CallToolRequest, args ListByTagArgs) (*mcp.CallToolResult, any, error) {
+	if args.Tag == "" {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Please provide a tag"},
+			},
+			IsError: true,
+		}, nil, nil
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	var matches []types.Server
+	for _, s := range cache.list() {
+		if containsTag(category.Categorize(s), args.Tag) {
+			matches = append(matches, s)
+			if len(matches) >= limit {
+				break
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("No servers tagged '%s'", args.Tag)},
+			},
+		}, nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d servers tagged '%s':\n\n", len(matches), args.Tag))
+	for _, s := range matches {
+		if s.Description != "" {
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", s.Name, truncate(s.Description, 80)))
+		} else {
+			sb.WriteString(fmt.Sprintf("- **%s**\n", s.Name))
+		}
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: sb.String()},
+		},
+	}, nil, nil
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func getRecentChanges(ctx context.Context, req *mcp.CallToolRequest, args GetRecentChangesArgs) (*mcp.CallToolResult, any, error) {
+	since := time.Now().Add(-24 * time.Hour)
+	if args.Since != "" {
+		parsed, err := time.Parse(time.RFC3339, args.Since)
+		if err != nil {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: "Invalid 'since' timestamp, expected RFC3339 (e.g. 2024-01-15T00:00:00Z)"},
+				},
+				IsError: true,
+			}, nil, nil
+		}
+		since = parsed
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	resp, err := notify.ListChanges(ctx, &notifyapi.ListChangesParams{
+		Since:  &since,
+		Server: args.Server,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch recent changes: %w", err)
+	}
+
+	if len(resp.Changes) == 0 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("No changes since %s", since.UTC().Format(time.RFC3339))},
+			},
+		}, nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d changes since %s:\n\n", len(resp.Changes), since.UTC().Format(time.RFC3339)))
+	for _, c := range resp.Changes {
+		sb.WriteString(formatChange(c))
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: sb.String()},
+		},
+	}, nil, nil
+}
+
+func getServerHistory(ctx context.Context, req *mcp.CallToolRequest, args GetServerHistoryArgs) (*mcp.CallToolResult, any, error) {
+	if args.Name == "" {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Please provide a server name"},
+			},
+			IsError: true,
+		}, nil, nil
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	resp, err := notify.ListChanges(ctx, &notifyapi.ListChangesParams{
+		Server: args.Name,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch server history: %w", err)
+	}
+
+	if len(resp.Changes) == 0 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("No recorded history for '%s'", args.Name)},
+			},
+		}, nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# History for %s\n\n", args.Name))
+	for _, c := range resp.Changes {
+		sb.WriteString(formatChange(c))
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: sb.String()},
+		},
+	}, nil, nil
+}
+
+func diffSnapshots(ctx context.Context, req *mcp.CallToolRequest, args DiffSnapshotsArgs) (*mcp.CallToolResult, any, error) {
+	from, err := time.Parse(time.RFC3339, args.From)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Invalid 'from' timestamp, expected RFC3339 (e.g. 2024-01-15T00:00:00Z)"},
+			},
+			IsError: true,
+		}, nil, nil
+	}
+	to, err := time.Parse(time.RFC3339, args.To)
+	if err != nil {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Invalid 'to' timestamp, expected RFC3339 (e.g. 2024-01-15T00:00:00Z)"},
+			},
+			IsError: true,
+		}, nil, nil
+	}
+
+	diff, err := notify.GetDiff(ctx, from, to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to diff snapshots: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Diff: %s → %s\n\n", from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339)))
+	sb.WriteString(fmt.Sprintf("%d total changes\n\n", diff.TotalChanges))
+
+	sb.WriteString(fmt.Sprintf("## New servers (%d)\n\n", len(diff.NewServers)))
+	for _, c := range diff.NewServers {
+		sb.WriteString(fmt.Sprintf("- %s\n", c.ServerName))
+	}
+	sb.WriteString(fmt.Sprintf("\n## Updated servers (%d)\n\n", len(diff.UpdatedServers)))
+	for _, c := range diff.UpdatedServers {
+		if c.PreviousVersion != "" && c.NewVersion != "" {
+			sb.WriteString(fmt.Sprintf("- %s (%s → %s)\n", c.ServerName, c.PreviousVersion, c.NewVersion))
+		} else {
+			sb.WriteString(fmt.Sprintf("- %s\n", c.ServerName))
+		}
+	}
+	sb.WriteString(fmt.Sprintf("\n## Removed servers (%d)\n\n", len(diff.RemovedServers)))
+	for _, c := range diff.RemovedServers {
+		sb.WriteString(fmt.Sprintf("- %s\n", c.ServerName))
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: sb.String()},
+		},
+	}, nil, nil
+}
+
+func semanticSearchServers(ctx context.Context, req *mcp.CallToolRequest, args SemanticSearchServersArgs) (*mcp.CallToolResult, any, error) {
+	if args.Query == "" {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: "Please provide a search query"},
+			},
+			IsError: true,
+		}, nil, nil
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	resp, err := notify.SemanticSearchServers(ctx, args.Query, limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to semantically search servers: %w", err)
+	}
+
+	if len(resp.Results) == 0 {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("No servers found matching '%s'", args.Query)},
+			},
+		}, nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Found %d servers matching '%s':\n\n", len(resp.Results), args.Query))
+	for _, res := range resp.Results {
+		s := res.Server
+		sb.WriteString(fmt.Sprintf("**%s** (similarity: %.2f)\n", s.Name, res.Similarity))
+		if s.Description != "" {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncate(s.Description, 100)))
+		}
+		if s.Repository != nil && s.Repository.URL != "" {
+			sb.WriteString(fmt.Sprintf("  Repository: %s\n", s.Repository.URL))
+		}
+		sb.WriteString("\n")
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: sb.String()},
+		},
+	}, nil, nil
+}
+
+// formatChange renders a single change as a Markdown bullet, for use in
+// get_recent_changes and get_server_history output.
+func formatChange(c notifyapi.Change) string {
+	switch c.ChangeType {
+	case "new":
+		return fmt.Sprintf("- **%s**: new server (%s)\n", c.ServerName, c.DetectedAt.UTC().Format(time.RFC3339))
+	case "removed":
+		return fmt.Sprintf("- **%s**: removed (%s)\n", c.ServerName, c.DetectedAt.UTC().Format(time.RFC3339))
+	default:
+		if c.PreviousVersion != "" && c.NewVersion != "" {
+			return fmt.Sprintf("- **%s**: %s → %s (%s)\n", c.ServerName, c.PreviousVersion, c.NewVersion, c.DetectedAt.UTC().Format(time.RFC3339))
+		}
+		return fmt.Sprintf("- **%s**: updated (%s)\n", c.ServerName, c.DetectedAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// dailyRegistryBriefing composes a "what's new today" summary of the
+// registry: servers updated in the last 24h, the new arrivals among them
+// (the closest proxy we have to "trending" without download or star
+// counts), and a lightweight risk flag for servers that publish no
+// verifiable repository or only a pre-release version.
+func dailyRegistryBriefing(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	namespace := strings.ToLower(req.Params.Arguments["namespace"])
+	keyword := strings.ToLower(req.Params.Arguments["keyword"])
+
+	since := time.Now().Add(-24 * time.Hour)
+	recent, err := client.GetServersUpdatedSince(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recent changes: %w", err)
+	}
+
+	var filtered []types.Server
+	for _, s := range recent {
+		if namespace != "" && !strings.HasPrefix(strings.ToLower(s.Name), namespace) {
+			continue
+		}
+		if keyword != "" && !strings.Contains(strings.ToLower(s.Name), keyword) &&
+			!strings.Contains(strings.ToLower(s.Description), keyword) {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# MCP Registry Briefing — %s\n\n", time.Now().UTC().Format("2006-01-02")))
+
+	if len(filtered) == 0 {
+		sb.WriteString("No registry activity in the last 24 hours matching this scope.\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("## Recent changes (%d)\n\n", len(filtered)))
+		for _, s := range filtered {
+			sb.WriteString(fmt.Sprintf("- **%s**", s.Name))
+			if s.VersionDetail != nil && s.VersionDetail.Version != "" {
+				sb.WriteString(fmt.Sprintf(" (%s)", s.VersionDetail.Version))
+			}
+			if s.Description != "" {
+				sb.WriteString(fmt.Sprintf(" — %s", truncate(s.Description, 100)))
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+
+		trending := trendingServers(filtered)
+		sb.WriteString(fmt.Sprintf("## Trending (%d newly published)\n\n", len(trending)))
+		if len(trending) == 0 {
+			sb.WriteString("None of today's changes look like new arrivals.\n")
+		}
+		for _, s := range trending {
+			sb.WriteString(fmt.Sprintf("- **%s**\n", s.Name))
+		}
+		sb.WriteString("\n")
+
+		flagged := riskFlaggedServers(filtered)
+		sb.WriteString(fmt.Sprintf("## Higher-risk flags (%d)\n\n", len(flagged)))
+		if len(flagged) == 0 {
+			sb.WriteString("No flags raised today.\n")
+		}
+		for _, f := range flagged {
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", f.server.Name, f.reason))
+		}
+	}
+
+	return &mcp.GetPromptResult{
+		Description: "Daily MCP Registry briefing",
+		Messages: []*mcp.PromptMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: sb.String()}},
+		},
+	}, nil
+}
+
+// trendingServers approximates "trending" as servers whose created_at falls
+// within the same window as the update, i.e. they're new rather than
+// re-published. The registry has no download or popularity signal to rank
+// on, so this is the best available proxy.
+func trendingServers(servers []types.Server) []types.Server {
+	cutoff := time.Now().Add(-24 * time.Hour)
+	var trending []types.Server
+	for _, s := range servers {
+		if !s.CreatedAt.IsZero() && s.CreatedAt.After(cutoff) {
+			trending = append(trending, s)
+		}
+	}
+	return trending
+}
+
+type riskFlag struct {
+	server types.Server
+	reason string
+}
+
+// riskFlaggedServers surfaces servers worth a second look before an agent
+// trusts them: no verifiable repository, or a version string that looks
+// like a pre-release.
+func riskFlaggedServers(servers []types.Server) []riskFlag {
+	var flags []riskFlag
+	for _, s := range servers {
+		if s.Repository == nil || s.Repository.URL == "" {
+			flags = append(flags, riskFlag{server: s, reason: "no verifiable repository URL"})
+			continue
+		}
+		if s.VersionDetail != nil && looksPreRelease(s.VersionDetail.Version) {
+			flags = append(flags, riskFlag{server: s, reason: fmt.Sprintf("pre-release version %q", s.VersionDetail.Version)})
+		}
+	}
+	return flags
+}
+
+func looksPreRelease(version string) bool {
+	lower := strings.ToLower(version)
+	for _, marker := range []string{"alpha", "beta", "rc", "dev", "0.0."} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return strings.HasPrefix(lower, "0.")
+}
+
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
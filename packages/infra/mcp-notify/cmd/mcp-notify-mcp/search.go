@@ -0,0 +1,156 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Score weights for rankServers. Name hits outrank description hits; exact,
+// whole-word and substring matches outrank fuzzy ones.
+const (
+	scoreNameExact  = 100.0
+	scoreNameWord   = 60.0
+	scoreNameSubstr = 40.0
+	scoreNameFuzzy  = 20.0
+	scoreDescWord   = 15.0
+	scoreDescSubstr = 8.0
+	scoreDescFuzzy  = 3.0
+)
+
+type scoredServer struct {
+	server types.Server
+	score  float64
+}
+
+// rankServers scores each server against query using tokenized, ranked
+// matching: multi-word queries like "file system" match tokens
+// independently (so "filesystem" still scores well), word and substring
+// hits in the name outrank description hits, and a token with no exact or
+// substring hit still scores via Levenshtein-distance typo tolerance.
+// Results are sorted by descending score.
+func rankServers(servers []types.Server, query string) []scoredServer {
+	tokens := tokenize(query)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var scored []scoredServer
+	for _, s := range servers {
+		if score := scoreServer(s, tokens); score > 0 {
+			scored = append(scored, scoredServer{server: s, score: score})
+		}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+	return scored
+}
+
+func scoreServer(s types.Server, tokens []string) float64 {
+	nameLower := strings.ToLower(s.Name)
+	nameWords := tokenize(s.Name)
+	descLower := strings.ToLower(s.Description)
+	descWords := tokenize(s.Description)
+
+	var total float64
+	for _, tok := range tokens {
+		switch {
+		case nameLower == tok:
+			total += scoreNameExact
+		case containsWord(nameWords, tok):
+			total += scoreNameWord
+		case strings.Contains(nameLower, tok):
+			total += scoreNameSubstr
+		case containsFuzzyMatch(nameWords, tok):
+			total += scoreNameFuzzy
+		case containsWord(descWords, tok):
+			total += scoreDescWord
+		case strings.Contains(descLower, tok):
+			total += scoreDescSubstr
+		case containsFuzzyMatch(descWords, tok):
+			total += scoreDescFuzzy
+		}
+	}
+	return total
+}
+
+// tokenize lowercases s and splits it into alphanumeric words.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func containsWord(words []string, tok string) bool {
+	for _, w := range words {
+		if w == tok {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFuzzyMatch(words []string, tok string) bool {
+	for _, w := range words {
+		if isTypoOf(w, tok) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTypoOf reports whether word is within a small edit distance of tok,
+// scaled to tok's length so a couple of typos in a longer word don't
+// disqualify a match while short words still require a near-exact hit.
+func isTypoOf(word, tok string) bool {
+	maxDistance := 1
+	if len(tok) > 5 {
+		maxDistance = 2
+	}
+	return levenshtein(word, tok) <= maxDistance
+}
+
+// levenshtein computes the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+	if la == 0 {
+		return lb
+	}
+	if lb == 0 {
+		return la
+	}
+
+	prev := make([]int, lb+1)
+	curr := make([]int, lb+1)
+	for j := 0; j <= lb; j++ {
+		prev[j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		curr[0] = i
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[lb]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
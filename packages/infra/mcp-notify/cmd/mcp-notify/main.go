@@ -15,14 +15,26 @@ import (
 
 	apispec "github.com/nirholas/mcp-notify/api"
 	"github.com/nirholas/mcp-notify/internal/api"
+	"github.com/nirholas/mcp-notify/internal/audit"
+	"github.com/nirholas/mcp-notify/internal/auth"
 	"github.com/nirholas/mcp-notify/internal/config"
 	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/discordbot"
+	"github.com/nirholas/mcp-notify/internal/eventbus"
+	"github.com/nirholas/mcp-notify/internal/leader"
 	"github.com/nirholas/mcp-notify/internal/notifier"
+	"github.com/nirholas/mcp-notify/internal/organization"
 	"github.com/nirholas/mcp-notify/internal/poller"
+	"github.com/nirholas/mcp-notify/internal/provenance"
 	"github.com/nirholas/mcp-notify/internal/registry"
 	"github.com/nirholas/mcp-notify/internal/scheduler"
+	"github.com/nirholas/mcp-notify/internal/security"
 	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/internal/summarizer"
+	"github.com/nirholas/mcp-notify/internal/telegrambot"
 	"github.com/nirholas/mcp-notify/internal/telemetry"
+	"github.com/nirholas/mcp-notify/internal/transparency"
+	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
 // Version information (set via ldflags)
@@ -36,6 +48,13 @@ func main() {
 	// Initialize logging
 	setupLogging()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrateCommand(os.Args[2:]); err != nil {
+			log.Fatal().Err(err).Msg("Migration command failed")
+		}
+		return
+	}
+
 	log.Info().
 		Str("version", Version).
 		Str("commit", Commit).
@@ -48,13 +67,19 @@ func main() {
 		log.Fatal().Err(err).Msg("Failed to load configuration")
 	}
 
-	// Set log level from config
-	setLogLevel(cfg.LogLevel)
-
 	// Create root context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Resolve any Vault/AWS/GCP secret references in the config into their
+	// underlying plaintext values before anything else reads them.
+	if err := config.ResolveSecretRefs(ctx, cfg); err != nil {
+		log.Fatal().Err(err).Msg("Failed to resolve secret references")
+	}
+
+	// Set log level from config
+	setLogLevel(cfg.LogLevel)
+
 	// Handle shutdown signals
 	go handleShutdown(cancel)
 
@@ -66,6 +91,34 @@ func main() {
 	log.Info().Msg("MCP Notify shutdown complete")
 }
 
+// runMigrateCommand handles `mcp-notify migrate up|down|status`, running
+// goose directly against the configured database instead of the
+// dev_auto_migrate ensureTables() shortcut the server itself may use at
+// startup.
+func runMigrateCommand(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: mcp-notify migrate <up|down|status>")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	setLogLevel(cfg.LogLevel)
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return db.MigrateUp(ctx, cfg.Database.URL)
+	case "down":
+		return db.MigrateDown(ctx, cfg.Database.URL)
+	case "status":
+		return db.MigrateStatus(ctx, cfg.Database.URL)
+	default:
+		return fmt.Errorf("unknown migrate subcommand %q (want up, down, or status)", args[0])
+	}
+}
+
 func run(ctx context.Context, cfg *config.Config) error {
 	// Initialize telemetry
 	telemetryShutdown, err := telemetry.Setup(ctx, cfg.Telemetry)
@@ -103,12 +156,74 @@ func run(ctx context.Context, cfg *config.Config) error {
 	// Initialize subscription manager
 	subscriptionMgr := subscription.NewManager(database, cache)
 
+	// Initialize organization manager
+	organizationMgr := organization.NewManager(database)
+
+	// Initialize audit log manager
+	auditMgr := audit.NewManager(database)
+
 	// Initialize notification dispatcher
-	dispatcher, err := notifier.NewDispatcher(cfg.Notifications, database)
+	dispatcher, err := notifier.NewDispatcher(ctx, cfg.Notifications, database)
 	if err != nil {
 		return fmt.Errorf("failed to create notification dispatcher: %w", err)
 	}
 
+	// Initialize transparency log (optional)
+	var transparencyLog *transparency.Log
+	if cfg.Transparency.Enabled {
+		transparencyLog, err = transparency.NewLog(database, cfg.Transparency.SigningKeySeed)
+		if err != nil {
+			return fmt.Errorf("failed to initialize transparency log: %w", err)
+		}
+	}
+
+	// Initialize event bus publisher (optional)
+	var eventBus *eventbus.Bus
+	if cfg.EventBus.Enabled {
+		publisher, err := eventbus.NewPublisher(ctx, cfg.EventBus)
+		if err != nil {
+			return fmt.Errorf("failed to initialize event bus publisher: %w", err)
+		}
+		defer publisher.Close()
+		eventBus = eventbus.NewBus(database, publisher, cfg.EventBus.Topic)
+	}
+
+	// Initialize security scorer (optional)
+	var securityScorer *security.Scorer
+	if cfg.Security.Enabled {
+		securityScorer = security.NewScorer(security.Config{
+			BaseURL:       cfg.Security.OSVBaseURL,
+			Timeout:       cfg.Security.Timeout,
+			RetryAttempts: cfg.Security.RetryAttempts,
+			RetryDelay:    cfg.Security.RetryDelay,
+		})
+	}
+
+	// Initialize provenance verifier (optional)
+	var provenanceVerifier *provenance.Verifier
+	if cfg.Provenance.Enabled {
+		provenanceVerifier = provenance.NewVerifier(provenance.Config{
+			NPMRegistryURL:  cfg.Provenance.NPMRegistryURL,
+			PyPIRegistryURL: cfg.Provenance.PyPIRegistryURL,
+			Timeout:         cfg.Provenance.Timeout,
+			RetryAttempts:   cfg.Provenance.RetryAttempts,
+			RetryDelay:      cfg.Provenance.RetryDelay,
+		})
+	}
+
+	// Initialize change summarizer (optional)
+	var summarizerClient *summarizer.Client
+	if cfg.Summarizer.Enabled {
+		summarizerClient = summarizer.NewClient(summarizer.Config{
+			BaseURL:       cfg.Summarizer.BaseURL,
+			APIKey:        cfg.Summarizer.APIKey,
+			Model:         cfg.Summarizer.Model,
+			Timeout:       cfg.Summarizer.Timeout,
+			RetryAttempts: cfg.Summarizer.RetryAttempts,
+			RetryDelay:    cfg.Summarizer.RetryDelay,
+		})
+	}
+
 	// Initialize poller
 	registryPoller := poller.New(poller.Config{
 		Client:          registryClient,
@@ -116,43 +231,210 @@ func run(ctx context.Context, cfg *config.Config) error {
 		Cache:           cache,
 		Dispatcher:      dispatcher,
 		SubscriptionMgr: subscriptionMgr,
+		OrganizationMgr: organizationMgr,
 		PollInterval:    cfg.Registry.PollInterval,
+		MaxPollInterval: cfg.Registry.MaxPollInterval,
+		RegistryURL:     cfg.Registry.URL,
+
+		ShardPrefixes:    cfg.Registry.ShardPrefixes,
+		ShardConcurrency: cfg.Registry.ShardConcurrency,
+
+		VerifyChanges:     cfg.Registry.VerifyChanges,
+		VerificationDelay: cfg.Registry.VerificationDelay,
+
+		SecurityScorer:         securityScorer,
+		Summarizer:             summarizerClient,
+		SecurityScoreThreshold: cfg.Security.MinScoreThreshold,
+
+		ProvenanceVerifier: provenanceVerifier,
+
+		TransparencyLog: transparencyLog,
+		EventBus:        eventBus,
+
+		HeartbeatURL:   cfg.Registry.HeartbeatURL,
+		StallThreshold: cfg.Registry.StallThreshold,
+		OpsWebhookURL:  cfg.Notifications.OpsWebhookURL,
+
+		BackfillOnStartup: cfg.Registry.BackfillOnStartup,
+		BackfillNotify:    cfg.Registry.BackfillNotify,
 	})
 
 	// Initialize scheduler for digest emails
 	digestScheduler := scheduler.NewDigestScheduler(scheduler.Config{
-		Database:   database,
-		Dispatcher: dispatcher,
+		Database:        database,
+		Dispatcher:      dispatcher,
+		SubscriptionMgr: subscriptionMgr,
+		PollInterval:    cfg.Registry.PollInterval,
+	})
+
+	// Initialize scheduler for snapshot/notification pruning
+	maintenanceScheduler := scheduler.NewMaintenanceScheduler(scheduler.MaintenanceConfig{
+		Database:              database,
+		Schedule:              cfg.Maintenance.Schedule,
+		SnapshotRetention:     cfg.Maintenance.SnapshotRetention,
+		NotificationRetention: cfg.Maintenance.NotificationRetention,
+		ChangeRetention:       cfg.Maintenance.ChangeRetention,
+		AuditRetention:        cfg.Maintenance.AuditRetention,
+		CompactionPolicy: types.RetentionPolicy{
+			FullWindow:   cfg.Maintenance.CompactionFullWindow,
+			HourlyWindow: cfg.Maintenance.CompactionHourlyWindow,
+		},
+		VacuumEnabled: cfg.Maintenance.VacuumEnabled,
+	})
+
+	// Initialize scheduler for organization usage webhooks
+	usageWebhookScheduler := scheduler.NewUsageWebhookScheduler(scheduler.UsageWebhookConfig{
+		Database:     database,
+		Organization: organizationMgr,
+		Schedule:     cfg.Organizations.UsageWebhookSchedule,
 	})
 
+	// Initialize OIDC login (optional)
+	var oidcProvider *auth.Provider
+	var sessions *auth.SessionManager
+	if cfg.Auth.Enabled {
+		oidcProvider, err = auth.NewProvider(ctx, auth.Config{
+			IssuerURL:    cfg.Auth.IssuerURL,
+			ClientID:     cfg.Auth.ClientID,
+			ClientSecret: cfg.Auth.ClientSecret,
+			RedirectURL:  cfg.Auth.RedirectURL,
+			Scopes:       cfg.Auth.Scopes,
+			Timeout:      cfg.Auth.Timeout,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to initialize OIDC provider")
+		}
+		sessions = auth.NewSessionManager([]byte(cfg.Auth.SessionSigningKey), cfg.Auth.SessionDuration)
+	}
+
+	// Initialize Discord bot slash commands (optional)
+	var discordBot *discordbot.Bot
+	if cfg.Notifications.Discord.Bot.Enabled {
+		discordBot, err = discordbot.NewBot(discordbot.Config{
+			PublicKey: cfg.Notifications.Discord.Bot.PublicKey,
+		}, database, subscriptionMgr)
+		if err != nil {
+			return fmt.Errorf("failed to initialize Discord bot: %w", err)
+		}
+	}
+
+	// Initialize Telegram bot commands (optional)
+	var telegramBot *telegrambot.Bot
+	if cfg.Notifications.Telegram.Bot.Enabled {
+		telegramBot = telegrambot.NewBot(telegrambot.Config{
+			SecretToken: cfg.Notifications.Telegram.Bot.SecretToken,
+		}, database, subscriptionMgr)
+	}
+
 	// Initialize API server
 	apiServer := api.NewServer(api.Config{
-		Host:            cfg.Server.Host,
-		Port:            cfg.Server.Port,
-		Database:        database,
-		Cache:           cache,
-		SubscriptionMgr: subscriptionMgr,
-		RegistryClient:  registryClient,
-		CORS:            cfg.Server.CORS,
-		Version:         Version,
-		OpenAPISpec:     apispec.OpenAPISpec,
+		Host:               cfg.Server.Host,
+		Port:               cfg.Server.Port,
+		Database:           database,
+		Cache:              cache,
+		SubscriptionMgr:    subscriptionMgr,
+		OrganizationMgr:    organizationMgr,
+		AuditMgr:           auditMgr,
+		RegistryClient:     registryClient,
+		RegistryURL:        cfg.Registry.URL,
+		CORS:               cfg.Server.CORS,
+		AdminAPIKey:        cfg.Server.AdminAPIKey,
+		RateLimit:          cfg.RateLimit,
+		Version:            Version,
+		OpenAPISpec:        apispec.OpenAPISpec,
+		PollInterval:       cfg.Registry.PollInterval,
+		Maintenance:        maintenanceScheduler,
+		TransparencyLog:    transparencyLog,
+		OIDCProvider:       oidcProvider,
+		OIDCProviderName:   cfg.Auth.ProviderName,
+		Sessions:           sessions,
+		FeedSigningKey:     cfg.Notifications.RSS.SigningKey,
+		Dispatcher:         dispatcher,
+		SlackSigningSecret: cfg.Notifications.Slack.SigningSecret,
+		DiscordBot:         discordBot,
+		TelegramBot:        telegramBot,
+
+		EmailBounceWebhookSecret: cfg.Notifications.Email.BounceWebhookSecret,
+		MailgunSigningKey:        cfg.Notifications.Email.Mailgun.SigningKey,
+
+		Poller: registryPoller,
 	})
 
+	// Watch the config file (and SIGHUP) for changes, re-initializing the
+	// components that can safely pick up new settings without a restart. A
+	// reload that fails to parse or validate is skipped, leaving everything
+	// running on the previous config.
+	_, stopConfigWatch, err := config.Watch(func(newCfg *config.Config) {
+		if err := config.ResolveSecretRefs(ctx, newCfg); err != nil {
+			log.Error().Err(err).Msg("Failed to resolve secret references on reload, keeping previous config")
+			return
+		}
+		setLogLevel(newCfg.LogLevel)
+		registryPoller.UpdateInterval(newCfg.Registry.PollInterval, newCfg.Registry.MaxPollInterval)
+		if err := dispatcher.ReloadSenders(ctx, newCfg.Notifications); err != nil {
+			log.Error().Err(err).Msg("Failed to reload notification senders")
+		}
+		apiServer.UpdateRateLimit(newCfg.RateLimit)
+		log.Info().Msg("Configuration reloaded")
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start config watch: %w", err)
+	}
+	defer stopConfigWatch()
+
 	// Start all components using errgroup
 	g, gCtx := errgroup.WithContext(ctx)
 
-	// Start poller
+	// The poller and digest scheduler must run on exactly one replica at a
+	// time, or a multi-replica deployment double-polls the registry and
+	// double-sends digests. Leader election gates both behind a single
+	// Postgres advisory lock; every replica still serves the API.
+	pollerLeader := leader.New(database, "mcp-notify:poller-leader", 0)
 	g.Go(func() error {
-		log.Info().Msg("Starting registry poller")
-		return registryPoller.Run(gCtx)
+		return pollerLeader.Run(gCtx, func(leaderCtx context.Context) error {
+			lg, lgCtx := errgroup.WithContext(leaderCtx)
+			lg.Go(func() error {
+				log.Info().Msg("Starting registry poller")
+				return registryPoller.Run(lgCtx)
+			})
+			lg.Go(func() error {
+				log.Info().Msg("Starting digest scheduler")
+				return digestScheduler.Run(lgCtx)
+			})
+			lg.Go(func() error {
+				log.Info().Msg("Starting notification outbox flusher")
+				return registryPoller.RunNotificationOutbox(lgCtx, cfg.Notifications.OutboxFlushInterval)
+			})
+			return lg.Wait()
+		})
 	})
 
-	// Start digest scheduler
+	// Start maintenance scheduler
 	g.Go(func() error {
-		log.Info().Msg("Starting digest scheduler")
-		return digestScheduler.Run(gCtx)
+		log.Info().Msg("Starting maintenance scheduler")
+		return maintenanceScheduler.Run(gCtx)
 	})
 
+	// Start notification retry processor
+	g.Go(func() error {
+		log.Info().Msg("Starting notification retry processor")
+		return dispatcher.RunRetryProcessor(gCtx, cfg.Notifications.RetryQueueInterval)
+	})
+
+	// Start organization usage webhook scheduler
+	g.Go(func() error {
+		log.Info().Msg("Starting usage webhook scheduler")
+		return usageWebhookScheduler.Run(gCtx)
+	})
+
+	// Start event bus outbox flusher (if enabled)
+	if eventBus != nil {
+		g.Go(func() error {
+			log.Info().Msg("Starting event bus outbox flusher")
+			return eventBus.Run(gCtx, cfg.EventBus.FlushInterval)
+		})
+	}
+
 	// Start API server
 	g.Go(func() error {
 		log.Info().
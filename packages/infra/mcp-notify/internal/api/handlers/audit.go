@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// actorType identifies who is making the current request, for the audit
+// log: a logged-in user, a subscription authenticating with one of its
+// scoped API keys, the subscription itself (its original, unrestricted
+// key), or, failing all of those, "system".
+func actorType(ctx context.Context) string {
+	switch {
+	case middleware.GetUserFromContext(ctx) != nil:
+		return "user"
+	case middleware.GetSubscriptionAPIKeyFromContext(ctx) != nil:
+		return "subscription_api_key"
+	case middleware.GetSubscriptionFromContext(ctx) != nil:
+		return "subscription"
+	default:
+		return "system"
+	}
+}
+
+// actorID returns the identifier matching actorType(ctx), or "" if none of
+// the context values it checks are present.
+func actorID(ctx context.Context) string {
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		return user.ID.String()
+	}
+	if key := middleware.GetSubscriptionAPIKeyFromContext(ctx); key != nil {
+		return key.ID.String()
+	}
+	if sub := middleware.GetSubscriptionFromContext(ctx); sub != nil {
+		return sub.ID.String()
+	}
+	return ""
+}
+
+// GetAuditLog returns audit log entries matching the query filters,
+// newest first. Gated by RequireAdminKey, since audited resources span
+// subscriptions and organizations with no single owner to authorize
+// against.
+func (h *Handlers) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+	filter := types.AuditLogFilter{
+		ResourceType: r.URL.Query().Get("resource_type"),
+		Action:       r.URL.Query().Get("action"),
+		ActorID:      r.URL.Query().Get("actor_id"),
+	}
+
+	if resourceIDStr := r.URL.Query().Get("resource_id"); resourceIDStr != "" {
+		resourceID, err := uuid.Parse(resourceIDStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid 'resource_id' parameter", "")
+			return
+		}
+		filter.ResourceID = &resourceID
+	}
+
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid 'since' parameter", "Expected RFC3339 format")
+			return
+		}
+		filter.Since = &since
+	}
+
+	if untilStr := r.URL.Query().Get("until"); untilStr != "" {
+		until, err := time.Parse(time.RFC3339, untilStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid 'until' parameter", "Expected RFC3339 format")
+			return
+		}
+		filter.Until = &until
+	}
+
+	filter.Limit, _ = strconv.Atoi(r.URL.Query().Get("limit"))
+
+	entries, err := h.auditMgr.List(r.Context(), filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list audit log entries")
+		writeError(w, http.StatusInternalServerError, "Failed to list audit log entries", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"entries": entries,
+		"count":   len(entries),
+	})
+}
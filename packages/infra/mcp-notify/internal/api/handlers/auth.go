@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/internal/auth"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// oauthStateCookieName holds the CSRF state value between Login and
+// Callback; it's short-lived and only ever read back by the callback.
+const oauthStateCookieName = "mcpn_oauth_state"
+
+// Login redirects the browser to the OIDC provider to start login.
+func (h *Handlers) Login(w http.ResponseWriter, r *http.Request) {
+	if h.oidcProvider == nil {
+		writeError(w, http.StatusNotFound, "Login is not enabled", "")
+		return
+	}
+
+	state, err := randomState()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to generate OAuth state")
+		writeError(w, http.StatusInternalServerError, "Failed to start login", "")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthStateCookieName,
+		Value:    state,
+		Path:     "/",
+		MaxAge:   int(10 * time.Minute / time.Second),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, h.oidcProvider.AuthCodeURL(state), http.StatusFound)
+}
+
+// Callback completes login: it exchanges the authorization code, fetches the
+// user's identity, upserts their account, and establishes a session.
+func (h *Handlers) Callback(w http.ResponseWriter, r *http.Request) {
+	if h.oidcProvider == nil {
+		writeError(w, http.StatusNotFound, "Login is not enabled", "")
+		return
+	}
+
+	stateCookie, err := r.Cookie(oauthStateCookieName)
+	if err != nil || r.URL.Query().Get("state") != stateCookie.Value {
+		writeError(w, http.StatusBadRequest, "Invalid or expired login state", "")
+		return
+	}
+	http.SetCookie(w, &http.Cookie{Name: oauthStateCookieName, Value: "", Path: "/", MaxAge: -1})
+
+	code := r.URL.Query().Get("code")
+	if code == "" {
+		writeError(w, http.StatusBadRequest, "Missing authorization code", "")
+		return
+	}
+
+	tok, err := h.oidcProvider.Exchange(r.Context(), code)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to exchange authorization code")
+		writeError(w, http.StatusBadGateway, "Failed to complete login", "")
+		return
+	}
+
+	info, err := h.oidcProvider.FetchUserInfo(r.Context(), tok.AccessToken)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch userinfo")
+		writeError(w, http.StatusBadGateway, "Failed to complete login", "")
+		return
+	}
+
+	user, err := h.db.GetUserBySubject(r.Context(), h.oidcProviderName, info.Subject)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to look up user")
+		writeError(w, http.StatusInternalServerError, "Failed to complete login", "")
+		return
+	}
+	if user == nil {
+		now := time.Now().UTC()
+		user = &types.User{
+			ID:        uuid.New(),
+			Email:     info.Email,
+			Provider:  h.oidcProviderName,
+			Subject:   info.Subject,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := h.db.CreateUser(r.Context(), user); err != nil {
+			log.Error().Err(err).Msg("Failed to create user")
+			writeError(w, http.StatusInternalServerError, "Failed to complete login", "")
+			return
+		}
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.SessionCookieName,
+		Value:    h.sessions.Issue(user.ID),
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+// Logout clears the browser session cookie.
+func (h *Handlers) Logout(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: auth.SessionCookieName, Value: "", Path: "/", MaxAge: -1})
+	writeJSON(w, http.StatusOK, map[string]string{"status": "logged out"})
+}
+
+// Me returns the logged-in user's account.
+func (h *Handlers) Me(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+	writeJSON(w, http.StatusOK, user)
+}
+
+// MySubscriptions lists the subscriptions owned by the logged-in user.
+func (h *Handlers) MySubscriptions(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+
+	subs, err := h.db.ListSubscriptionsForUser(r.Context(), user.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list subscriptions for user")
+		writeError(w, http.StatusInternalServerError, "Failed to list subscriptions", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, subs)
+}
+
+// CreatePersonalAccessToken mints a new personal access token for the
+// logged-in user. The token value is returned once, in the response body;
+// only its hash is stored.
+func (h *Handlers) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+
+	var req types.CreatePersonalAccessTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	tokenValue, err := auth.GeneratePersonalAccessToken()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to generate personal access token")
+		writeError(w, http.StatusInternalServerError, "Failed to create token", "")
+		return
+	}
+
+	pat := &types.PersonalAccessToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Name:      req.Name,
+		TokenHash: auth.HashPersonalAccessToken(tokenValue),
+		TokenHint: tokenValue[len(tokenValue)-4:],
+		Scopes:    req.Scopes,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	if err := h.db.CreatePersonalAccessToken(r.Context(), pat); err != nil {
+		log.Error().Err(err).Msg("Failed to create personal access token")
+		writeError(w, http.StatusInternalServerError, "Failed to create token", "")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, types.PersonalAccessTokenResponse{
+		PersonalAccessToken: *pat,
+		Token:               tokenValue,
+	})
+}
+
+// ListPersonalAccessTokens lists the logged-in user's personal access
+// tokens. Token values themselves are never returned, only metadata.
+func (h *Handlers) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+
+	tokens, err := h.db.ListPersonalAccessTokens(r.Context(), user.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list personal access tokens")
+		writeError(w, http.StatusInternalServerError, "Failed to list tokens", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+// RevokePersonalAccessToken revokes one of the logged-in user's personal
+// access tokens.
+func (h *Handlers) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+
+	tokenID, err := uuid.Parse(chi.URLParam(r, "tokenID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid token ID", "")
+		return
+	}
+
+	if err := h.db.RevokePersonalAccessToken(r.Context(), tokenID, user.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to revoke personal access token")
+		writeError(w, http.StatusNotFound, "Token not found", "")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/api/middleware"
+)
+
+// EnableChannel re-enables one of the authenticated subscription's channels,
+// e.g. after it was auto-disabled following too many consecutive delivery
+// failures (see NotificationsConfig.MaxConsecutiveFailures) and the
+// underlying issue has been fixed.
+func (h *Handlers) EnableChannel(w http.ResponseWriter, r *http.Request) {
+	sub := middleware.GetSubscriptionFromContext(r.Context())
+
+	channelID, err := uuid.Parse(chi.URLParam(r, "channelID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid channel ID", "")
+		return
+	}
+
+	if err := h.subscriptionMgr.EnableChannel(r.Context(), sub.ID, channelID); err != nil {
+		log.Error().Err(err).Msg("Failed to enable channel")
+		writeError(w, http.StatusNotFound, "Channel not found", "")
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "enable", "channel", channelID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, nil)
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "enabled"})
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/graphql"
+)
+
+// graphqlRequest is the standard GraphQL-over-HTTP request body.
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+func (h *Handlers) buildGraphQLSchema() *graphql.Schema {
+	return &graphql.Schema{
+		Resolvers: map[string]graphql.Resolver{
+			"changes":       h.resolveChanges,
+			"servers":       h.resolveServers,
+			"subscriptions": h.resolveSubscriptions,
+			"stats":         h.resolveStats,
+		},
+	}
+}
+
+// GraphQL handles POST /graphql, giving dashboard builders filtering and
+// pagination over changes, servers, and subscriptions without stacking up
+// bespoke query parameters on the REST routes.
+func (h *Handlers) GraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "Missing query", "")
+		return
+	}
+
+	result := h.graphqlSchema.Execute(r.Context(), req.Query, req.Variables)
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handlers) resolveChanges(ctx context.Context, args map[string]any) (any, error) {
+	since := time.Now().Add(-24 * time.Hour)
+	if raw, ok := args["since"].(string); ok && raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'since' argument: %w", err)
+		}
+		since = parsed
+	}
+
+	limit := argInt(args, "limit", 100)
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	changes, err := h.db.GetChangesSince(ctx, since, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("GraphQL: failed to get changes")
+		return nil, fmt.Errorf("failed to get changes")
+	}
+	return changes, nil
+}
+
+func (h *Handlers) resolveServers(ctx context.Context, args map[string]any) (any, error) {
+	servers, err := h.registryClient.ListServers(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("GraphQL: failed to list servers")
+		return nil, fmt.Errorf("failed to list servers")
+	}
+
+	limit := argInt(args, "limit", 0)
+	if limit > 0 && limit < len(servers) {
+		servers = servers[:limit]
+	}
+	return servers, nil
+}
+
+func (h *Handlers) resolveSubscriptions(ctx context.Context, args map[string]any) (any, error) {
+	limit := argInt(args, "limit", 20)
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := argInt(args, "offset", 0)
+	if offset < 0 {
+		offset = 0
+	}
+
+	subscriptions, total, err := h.db.ListSubscriptions(ctx, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("GraphQL: failed to list subscriptions")
+		return nil, fmt.Errorf("failed to list subscriptions")
+	}
+
+	return map[string]any{
+		"subscriptions": subscriptions,
+		"total":         total,
+	}, nil
+}
+
+func (h *Handlers) resolveStats(ctx context.Context, _ map[string]any) (any, error) {
+	stats, err := h.db.GetStats(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("GraphQL: failed to get stats")
+		return nil, fmt.Errorf("failed to get stats")
+	}
+	return stats, nil
+}
+
+// argInt reads an integer argument, tolerating both int and float64 (the
+// parser produces float64 for decimal literals).
+func argInt(args map[string]any, name string, fallback int) int {
+	switch v := args[name].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	default:
+		return fallback
+	}
+}
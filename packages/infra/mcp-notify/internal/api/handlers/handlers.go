@@ -3,10 +3,22 @@ package handlers
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
+	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -14,10 +26,29 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/feeds"
 	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
 
+	"github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/internal/audit"
+	"github.com/nirholas/mcp-notify/internal/auth"
+	"github.com/nirholas/mcp-notify/internal/category"
 	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/diff"
+	"github.com/nirholas/mcp-notify/internal/discordbot"
+	"github.com/nirholas/mcp-notify/internal/graphql"
+	"github.com/nirholas/mcp-notify/internal/monitoring"
+	"github.com/nirholas/mcp-notify/internal/notifier"
+	"github.com/nirholas/mcp-notify/internal/notifier/email"
+	"github.com/nirholas/mcp-notify/internal/notifier/slack"
+	"github.com/nirholas/mcp-notify/internal/organization"
+	"github.com/nirholas/mcp-notify/internal/poller"
 	"github.com/nirholas/mcp-notify/internal/registry"
+	"github.com/nirholas/mcp-notify/internal/report"
+	"github.com/nirholas/mcp-notify/internal/scheduler"
+	"github.com/nirholas/mcp-notify/internal/semantic"
 	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/internal/telegrambot"
+	"github.com/nirholas/mcp-notify/internal/transparency"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -26,20 +57,107 @@ type Config struct {
 	Database        db.Database
 	Cache           db.Cache
 	SubscriptionMgr *subscription.Manager
+	OrganizationMgr *organization.Manager
+	AuditMgr        *audit.Manager
 	RegistryClient  *registry.Client
+	RegistryURL     string
 	Version         string
 	OpenAPISpec     []byte
+
+	// PollInterval is the configured registry poll interval, used to flag
+	// monitoring gaps (missed or failed poll windows) in the change feeds.
+	PollInterval time.Duration
+
+	// Maintenance reports the outcome of the last scheduled snapshot/
+	// notification pruning run, if any. May be nil.
+	Maintenance *scheduler.MaintenanceScheduler
+
+	// TransparencyLog serves inclusion proofs for the append-only change
+	// log. May be nil if the feature is disabled.
+	TransparencyLog *transparency.Log
+
+	// OIDCProvider, when non-nil, enables browser login and lets
+	// subscriptions be created under a logged-in user. May be nil if the
+	// feature is disabled, in which case subscriptions continue to stand
+	// alone behind their own API key as before.
+	OIDCProvider *auth.Provider
+
+	// OIDCProviderName identifies OIDCProvider in User.Provider, e.g.
+	// "google" or "okta". Required whenever OIDCProvider is set.
+	OIDCProviderName string
+
+	// Sessions issues and verifies the signed cookie used to keep a user
+	// logged in across requests. Required whenever OIDCProvider is set.
+	Sessions *auth.SessionManager
+
+	// FeedSigningKey, if set, is used to HMAC-SHA256 sign rendered feed
+	// bodies (see X-Feed-Signature on the RSS/Atom/JSON feed endpoints), so
+	// a reader can verify a feed came from this server unmodified. Empty
+	// disables signing.
+	FeedSigningKey string
+
+	// Dispatcher renders and sends channel notifications. Used by
+	// PreviewNotification to render a message without sending it.
+	Dispatcher *notifier.Dispatcher
+
+	// SlackSigningSecret verifies inbound Block Kit button clicks on
+	// SlackInteraction came from Slack. Empty rejects all of them.
+	SlackSigningSecret string
+
+	// DiscordBot handles "/mcp" slash command interactions. May be nil if
+	// the feature is disabled, in which case DiscordInteraction rejects all
+	// requests.
+	DiscordBot *discordbot.Bot
+
+	// TelegramBot handles "/start", "/subscribe", "/mute", and "/recent"
+	// commands. May be nil if the feature is disabled, in which case
+	// TelegramInteraction rejects all requests.
+	TelegramBot *telegrambot.Bot
+
+	// EmailBounceWebhookSecret authenticates EmailBounceSES and
+	// EmailBounceSendGrid via a "secret" query parameter. Empty rejects
+	// both.
+	EmailBounceWebhookSecret string
+
+	// MailgunSigningKey authenticates EmailBounceMailgun via Mailgun's own
+	// HMAC request signature. Empty rejects all requests.
+	MailgunSigningKey string
+
+	// Poller, when set, lets GetStats fall back to the poller's in-memory
+	// snapshot (marking the response degraded: true) when the database is
+	// unreachable, instead of failing the request outright.
+	Poller *poller.Poller
 }
 
 // Handlers contains all HTTP handlers.
 type Handlers struct {
-	db              db.Database
-	cache           db.Cache
-	subscriptionMgr *subscription.Manager
-	registryClient  *registry.Client
-	validate        *validator.Validate
-	version         string
-	openAPISpec     []byte
+	db               db.Database
+	cache            db.Cache
+	subscriptionMgr  *subscription.Manager
+	organizationMgr  *organization.Manager
+	auditMgr         *audit.Manager
+	registryClient   *registry.Client
+	validate         *validator.Validate
+	version          string
+	openAPISpec      []byte
+	graphqlSchema    *graphql.Schema
+	pollInterval     time.Duration
+	maintenance      *scheduler.MaintenanceScheduler
+	transparencyLog  *transparency.Log
+	diffEngine       *diff.Engine
+	oidcProvider     *auth.Provider
+	oidcProviderName string
+	sessions         *auth.SessionManager
+	feedSigningKey   string
+	dispatcher       *notifier.Dispatcher
+	slackSigningKey  string
+	discordBot       *discordbot.Bot
+	telegramBot      *telegrambot.Bot
+
+	emailBounceWebhookSecret string
+	mailgunSigningKey        string
+
+	poller *poller.Poller
 }
 
 // New creates a new Handlers instance.
@@ -48,15 +166,36 @@ func New(cfg Config) *Handlers {
 	if version == "" {
 		version = "dev"
 	}
-	return &Handlers{
-		db:              cfg.Database,
-		cache:           cfg.Cache,
-		subscriptionMgr: cfg.SubscriptionMgr,
-		registryClient:  cfg.RegistryClient,
-		validate:        validator.New(),
-		version:         version,
-		openAPISpec:     cfg.OpenAPISpec,
+	h := &Handlers{
+		db:               cfg.Database,
+		cache:            cfg.Cache,
+		subscriptionMgr:  cfg.SubscriptionMgr,
+		organizationMgr:  cfg.OrganizationMgr,
+		auditMgr:         cfg.AuditMgr,
+		registryClient:   cfg.RegistryClient,
+		validate:         validator.New(),
+		version:          version,
+		openAPISpec:      cfg.OpenAPISpec,
+		pollInterval:     cfg.PollInterval,
+		maintenance:      cfg.Maintenance,
+		transparencyLog:  cfg.TransparencyLog,
+		diffEngine:       diff.NewEngine(cfg.RegistryURL),
+		oidcProvider:     cfg.OIDCProvider,
+		oidcProviderName: cfg.OIDCProviderName,
+		sessions:         cfg.Sessions,
+		feedSigningKey:   cfg.FeedSigningKey,
+		dispatcher:       cfg.Dispatcher,
+		slackSigningKey:  cfg.SlackSigningSecret,
+		discordBot:       cfg.DiscordBot,
+		telegramBot:      cfg.TelegramBot,
+
+		emailBounceWebhookSecret: cfg.EmailBounceWebhookSecret,
+		mailgunSigningKey:        cfg.MailgunSigningKey,
+
+		poller: cfg.Poller,
 	}
+	h.graphqlSchema = h.buildGraphQLSchema()
+	return h
 }
 
 // Health returns the health status.
@@ -76,6 +215,14 @@ func (h *Handlers) Health(w http.ResponseWriter, r *http.Request) {
 		response.Checks["database"] = "healthy"
 	}
 
+	// The poller can outlive a database outage in degraded mode (see
+	// internal/poller), so report that distinctly from "database: unhealthy"
+	// meaning the whole service is down.
+	if h.poller != nil && h.poller.Degraded() {
+		response.Checks["poller"] = "degraded"
+		response.Status = "degraded"
+	}
+
 	// Check registry
 	if err := h.registryClient.HealthCheck(r.Context()); err != nil {
 		response.Checks["registry"] = "unhealthy"
@@ -100,17 +247,54 @@ func (h *Handlers) Ready(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
 }
 
-// GetStats returns service statistics.
+// GetStats returns service statistics. If the database is unreachable and a
+// Poller was configured, it falls back to the poller's in-memory snapshot
+// and marks the response degraded rather than failing the request outright.
 func (h *Handlers) GetStats(w http.ResponseWriter, r *http.Request) {
 	stats, err := h.db.GetStats(r.Context())
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get stats")
-		writeError(w, http.StatusInternalServerError, "Failed to get stats", "")
+		if h.poller == nil {
+			log.Error().Err(err).Msg("Failed to get stats")
+			writeError(w, http.StatusInternalServerError, "Failed to get stats", "")
+			return
+		}
+
+		snapshot := h.poller.GetLastSnapshot()
+		if snapshot == nil {
+			log.Error().Err(err).Msg("Failed to get stats and no in-memory snapshot to fall back to")
+			writeError(w, http.StatusInternalServerError, "Failed to get stats", "")
+			return
+		}
+
+		log.Warn().Err(err).Msg("Failed to get stats from database, serving degraded stats from in-memory snapshot")
+		writeJSON(w, http.StatusOK, &types.StatsResponse{
+			LastPollTime: snapshot.Timestamp,
+			ServerCount:  snapshot.ServerCount,
+			Degraded:     true,
+		})
 		return
 	}
 	writeJSON(w, http.StatusOK, stats)
 }
 
+// MaintenanceStatus reports the outcome of the most recent scheduled
+// maintenance run (snapshot/notification pruning and, if enabled, VACUUM),
+// so operators can confirm cleanup is actually reclaiming space.
+func (h *Handlers) MaintenanceStatus(w http.ResponseWriter, r *http.Request) {
+	if h.maintenance == nil {
+		writeError(w, http.StatusNotFound, "Maintenance scheduler not running", "")
+		return
+	}
+
+	report := h.maintenance.LastReport()
+	if report == nil {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "no maintenance run yet"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, report)
+}
+
 // CreateSubscription creates a new subscription.
 func (h *Handlers) CreateSubscription(w http.ResponseWriter, r *http.Request) {
 	var req types.CreateSubscriptionRequest
@@ -124,20 +308,39 @@ func (h *Handlers) CreateSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create subscription
-	sub, apiKey, err := h.subscriptionMgr.Create(r.Context(), req)
+	if err := h.subscriptionMgr.ValidateFilters(req.Filters); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid filters", err.Error())
+		return
+	}
+
+	if err := h.subscriptionMgr.ValidateChannelTemplates(req.Channels); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid channel configuration", err.Error())
+		return
+	}
+
+	// Create subscription, attributing it to the logged-in user if this
+	// request carries a valid session or personal access token.
+	var userID *uuid.UUID
+	if user := middleware.GetUserFromContext(r.Context()); user != nil {
+		userID = &user.ID
+	}
+
+	sub, apiKey, err := h.subscriptionMgr.Create(r.Context(), req, userID, nil)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to create subscription")
 		writeError(w, http.StatusInternalServerError, "Failed to create subscription", "")
 		return
 	}
 
+	h.auditMgr.RecordAsync(r.Context(), "create", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, sub)
+
 	// Return subscription with API key (only shown once)
 	response := types.SubscriptionResponse{
 		Subscription: *sub,
 		APIKey:       apiKey,
 	}
 
+	w.Header().Set("ETag", subscriptionETag(sub))
 	writeJSON(w, http.StatusCreated, response)
 }
 
@@ -168,16 +371,303 @@ func (h *Handlers) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// exportSubscriptionsPageSize bounds how many subscriptions ExportSubscriptions
+// fetches per call to the database while assembling a full bundle.
+const exportSubscriptionsPageSize = 100
+
+// ExportSubscriptions returns every subscription as a SubscriptionBundle,
+// in JSON or, if requested via ?format=yaml, YAML. Admin-key gated, like the
+// audit log, since a bundle can contain every subscription across every
+// user and organization.
+func (h *Handlers) ExportSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var bundle types.SubscriptionBundle
+	bundle.Version = types.SubscriptionBundleVersion
+
+	for offset := 0; ; offset += exportSubscriptionsPageSize {
+		subs, total, err := h.db.ListSubscriptions(r.Context(), exportSubscriptionsPageSize, offset)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list subscriptions for export")
+			writeError(w, http.StatusInternalServerError, "Failed to export subscriptions", "")
+			return
+		}
+		for _, sub := range subs {
+			bundle.Subscriptions = append(bundle.Subscriptions, subscriptionToCreateRequest(sub))
+		}
+		if offset+len(subs) >= total || len(subs) == 0 {
+			break
+		}
+	}
+
+	if strings.EqualFold(r.URL.Query().Get("format"), "yaml") {
+		data, err := yaml.Marshal(bundle)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to marshal subscription bundle as YAML")
+			writeError(w, http.StatusInternalServerError, "Failed to export subscriptions", "")
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Content-Disposition", `attachment; filename="subscriptions.yaml"`)
+		w.Write(data)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, bundle)
+}
+
+// subscriptionToCreateRequest converts a stored subscription back into the
+// request shape used to create it, for export. It intentionally omits the
+// API key (never stored in plaintext) and warm-up state (a one-time
+// creation-time effect, not part of a subscription's steady-state config).
+func subscriptionToCreateRequest(sub types.Subscription) types.CreateSubscriptionRequest {
+	channels := make([]types.ChannelRequest, len(sub.Channels))
+	for i, ch := range sub.Channels {
+		channels[i] = types.ChannelRequest{Type: ch.Type, Config: ch.Config}
+	}
+
+	return types.CreateSubscriptionRequest{
+		Name:             sub.Name,
+		Description:      sub.Description,
+		Filters:          sub.Filters,
+		Channels:         channels,
+		Routes:           sub.Routes,
+		DeliverySchedule: sub.DeliverySchedule,
+		ScheduledReport:  sub.ScheduledReport,
+		CoalesceWindow:   sub.CoalesceWindow,
+		AllowedCIDRs:     sub.AllowedCIDRs,
+	}
+}
+
+// ImportSubscriptions creates a subscription for each entry in a submitted
+// SubscriptionBundle (JSON or, with Content-Type: application/yaml, YAML).
+// Each entry is validated and created independently: one invalid entry is
+// recorded in the result's Errors and does not abort the rest of the
+// bundle. Admin-key gated, like export, since it can create subscriptions
+// on behalf of any user or organization.
+func (h *Handlers) ImportSubscriptions(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	var bundle types.SubscriptionBundle
+	if strings.Contains(r.Header.Get("Content-Type"), "yaml") {
+		err = yaml.Unmarshal(body, &bundle)
+	} else {
+		err = json.Unmarshal(body, &bundle)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if bundle.Version != types.SubscriptionBundleVersion {
+		writeError(w, http.StatusBadRequest, "Unsupported bundle version", fmt.Sprintf("expected %d, got %d", types.SubscriptionBundleVersion, bundle.Version))
+		return
+	}
+
+	result := types.ImportSubscriptionsResult{}
+	for i, req := range bundle.Subscriptions {
+		if err := h.validate.Struct(req); err != nil {
+			result.Errors = append(result.Errors, types.ImportSubscriptionError{Index: i, Name: req.Name, Error: err.Error()})
+			continue
+		}
+		if err := h.subscriptionMgr.ValidateFilters(req.Filters); err != nil {
+			result.Errors = append(result.Errors, types.ImportSubscriptionError{Index: i, Name: req.Name, Error: err.Error()})
+			continue
+		}
+		if err := h.subscriptionMgr.ValidateChannelTemplates(req.Channels); err != nil {
+			result.Errors = append(result.Errors, types.ImportSubscriptionError{Index: i, Name: req.Name, Error: err.Error()})
+			continue
+		}
+
+		sub, apiKey, err := h.subscriptionMgr.Create(r.Context(), req, nil, nil)
+		if err != nil {
+			result.Errors = append(result.Errors, types.ImportSubscriptionError{Index: i, Name: req.Name, Error: err.Error()})
+			continue
+		}
+
+		h.auditMgr.RecordAsync(r.Context(), "create", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, sub)
+
+		result.Imported++
+		result.Subscriptions = append(result.Subscriptions, types.SubscriptionResponse{Subscription: *sub, APIKey: apiKey})
+	}
+
+	writeJSON(w, http.StatusCreated, result)
+}
+
+// ApplySubscriptions reconciles live subscriptions to match a submitted
+// SubscriptionBundle, GitOps-style: subscriptions in the bundle but not
+// live are created, subscriptions in both that differ are updated, and
+// subscriptions live but not in the bundle are deleted. Matching is by
+// Name, since a bundle entry carries no ID; renaming a subscription in the
+// bundle is seen as deleting the old one and creating a new one. Pass
+// ?dry_run=true to compute the plan without applying it. Admin-key gated,
+// like import and export, since it can create, modify, or delete
+// subscriptions on behalf of any user or organization.
+func (h *Handlers) ApplySubscriptions(w http.ResponseWriter, r *http.Request) {
+	dryRun := strings.EqualFold(r.URL.Query().Get("dry_run"), "true")
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	var bundle types.SubscriptionBundle
+	if strings.Contains(r.Header.Get("Content-Type"), "yaml") {
+		err = yaml.Unmarshal(body, &bundle)
+	} else {
+		err = json.Unmarshal(body, &bundle)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if bundle.Version != types.SubscriptionBundleVersion {
+		writeError(w, http.StatusBadRequest, "Unsupported bundle version", fmt.Sprintf("expected %d, got %d", types.SubscriptionBundleVersion, bundle.Version))
+		return
+	}
+
+	live := make(map[string]types.Subscription)
+	for offset := 0; ; offset += exportSubscriptionsPageSize {
+		subs, total, err := h.db.ListSubscriptions(r.Context(), exportSubscriptionsPageSize, offset)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list subscriptions for apply")
+			writeError(w, http.StatusInternalServerError, "Failed to apply subscriptions", "")
+			return
+		}
+		for _, sub := range subs {
+			live[sub.Name] = sub
+		}
+		if offset+len(subs) >= total || len(subs) == 0 {
+			break
+		}
+	}
+
+	desired := make(map[string]bool, len(bundle.Subscriptions))
+	result := types.ApplySubscriptionsResult{DryRun: dryRun}
+
+	for _, req := range bundle.Subscriptions {
+		desired[req.Name] = true
+
+		if sub, ok := live[req.Name]; ok {
+			if reflect.DeepEqual(subscriptionToCreateRequest(sub), req) {
+				result.Actions = append(result.Actions, types.SubscriptionPlanAction{Action: "unchanged", Name: req.Name, ID: &sub.ID})
+				continue
+			}
+
+			action := types.SubscriptionPlanAction{Action: "update", Name: req.Name, ID: &sub.ID}
+			if !dryRun {
+				if err := h.validate.Struct(req); err != nil {
+					action.Error = err.Error()
+				} else {
+					updated, err := h.subscriptionMgr.Update(r.Context(), sub.ID, updateRequestFromCreateRequest(req))
+					if err != nil {
+						action.Error = err.Error()
+					} else {
+						h.auditMgr.RecordAsync(r.Context(), "update", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), sub, updated)
+					}
+				}
+			}
+			result.Actions = append(result.Actions, action)
+			continue
+		}
+
+		action := types.SubscriptionPlanAction{Action: "create", Name: req.Name}
+		if !dryRun {
+			if err := h.validate.Struct(req); err != nil {
+				action.Error = err.Error()
+			} else {
+				sub, _, err := h.subscriptionMgr.Create(r.Context(), req, nil, nil)
+				if err != nil {
+					action.Error = err.Error()
+				} else {
+					action.ID = &sub.ID
+					h.auditMgr.RecordAsync(r.Context(), "create", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, sub)
+				}
+			}
+		}
+		result.Actions = append(result.Actions, action)
+	}
+
+	for name, sub := range live {
+		if desired[name] {
+			continue
+		}
+
+		action := types.SubscriptionPlanAction{Action: "delete", Name: name, ID: &sub.ID}
+		if !dryRun {
+			if err := h.subscriptionMgr.Delete(r.Context(), sub.ID); err != nil {
+				action.Error = err.Error()
+			} else {
+				h.auditMgr.RecordAsync(r.Context(), "delete", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), sub, nil)
+			}
+		}
+		result.Actions = append(result.Actions, action)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// updateRequestFromCreateRequest converts a bundle entry into the request
+// shape ApplySubscriptions needs to update the existing subscription with
+// the same name.
+func updateRequestFromCreateRequest(req types.CreateSubscriptionRequest) types.UpdateSubscriptionRequest {
+	return types.UpdateSubscriptionRequest{
+		Description:      &req.Description,
+		Filters:          &req.Filters,
+		Channels:         req.Channels,
+		Routes:           req.Routes,
+		DeliverySchedule: &req.DeliverySchedule,
+		ScheduledReport:  &req.ScheduledReport,
+		CoalesceWindow:   &req.CoalesceWindow,
+		AllowedCIDRs:     req.AllowedCIDRs,
+	}
+}
+
+// subscriptionETag derives a weak ETag from a subscription's last-modified
+// time, so a client (e.g. a Terraform provider) can detect and reject a
+// write based on stale state instead of silently clobbering a concurrent
+// change.
+func subscriptionETag(sub *types.Subscription) string {
+	return fmt.Sprintf(`"%d"`, sub.UpdatedAt.UnixNano())
+}
+
+// checkIfMatch enforces an optimistic-concurrency precondition: if the
+// request carries an If-Match header, it must match the subscription's
+// current ETag. Returns false (having already written the response) if the
+// precondition fails.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, sub *types.Subscription) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	if ifMatch != subscriptionETag(sub) {
+		writeError(w, http.StatusPreconditionFailed, "Subscription was modified since it was last read", "")
+		return false
+	}
+	return true
+}
+
 // GetSubscription returns a single subscription.
 func (h *Handlers) GetSubscription(w http.ResponseWriter, r *http.Request) {
 	sub := r.Context().Value("subscription").(*types.Subscription)
+	w.Header().Set("ETag", subscriptionETag(sub))
 	writeJSON(w, http.StatusOK, sub)
 }
 
-// UpdateSubscription updates a subscription.
+// UpdateSubscription updates a subscription. A request carrying an If-Match
+// header is only applied if it matches the subscription's current ETag,
+// for optimistic concurrency.
 func (h *Handlers) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
 	sub := r.Context().Value("subscription").(*types.Subscription)
 
+	if !checkIfMatch(w, r, sub) {
+		return
+	}
+
 	var req types.UpdateSubscriptionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, http.StatusBadRequest, "Invalid request body", "")
@@ -189,6 +679,20 @@ func (h *Handlers) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if req.Filters != nil {
+		if err := h.subscriptionMgr.ValidateFilters(*req.Filters); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid filters", err.Error())
+			return
+		}
+	}
+
+	if len(req.Channels) > 0 {
+		if err := h.subscriptionMgr.ValidateChannelTemplates(req.Channels); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid channel configuration", err.Error())
+			return
+		}
+	}
+
 	updated, err := h.subscriptionMgr.Update(r.Context(), sub.ID, req)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update subscription")
@@ -196,19 +700,30 @@ func (h *Handlers) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditMgr.RecordAsync(r.Context(), "update", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), sub, updated)
+
+	w.Header().Set("ETag", subscriptionETag(updated))
 	writeJSON(w, http.StatusOK, updated)
 }
 
-// DeleteSubscription deletes a subscription.
+// DeleteSubscription deletes a subscription. A request carrying an If-Match
+// header is only applied if it matches the subscription's current ETag,
+// for optimistic concurrency.
 func (h *Handlers) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
 	sub := r.Context().Value("subscription").(*types.Subscription)
 
+	if !checkIfMatch(w, r, sub) {
+		return
+	}
+
 	if err := h.subscriptionMgr.Delete(r.Context(), sub.ID); err != nil {
 		log.Error().Err(err).Msg("Failed to delete subscription")
 		writeError(w, http.StatusInternalServerError, "Failed to delete subscription", "")
 		return
 	}
 
+	h.auditMgr.RecordAsync(r.Context(), "delete", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), sub, nil)
+
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -222,6 +737,8 @@ func (h *Handlers) PauseSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditMgr.RecordAsync(r.Context(), "pause", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, nil)
+
 	writeJSON(w, http.StatusOK, map[string]string{"status": "paused"})
 }
 
@@ -235,6 +752,8 @@ func (h *Handlers) ResumeSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditMgr.RecordAsync(r.Context(), "resume", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, nil)
+
 	writeJSON(w, http.StatusOK, map[string]string{"status": "active"})
 }
 
@@ -255,6 +774,22 @@ func (h *Handlers) TestSubscription(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetSubscriptionHealth returns a subscription's delivery health score and
+// actionable recommendations (e.g. a channel failing outright, filters that
+// haven't matched anything, or the subscription nearing its rate limit).
+func (h *Handlers) GetSubscriptionHealth(w http.ResponseWriter, r *http.Request) {
+	sub := r.Context().Value("subscription").(*types.Subscription)
+
+	report, err := h.subscriptionMgr.Health(r.Context(), sub.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to compute subscription health")
+		writeError(w, http.StatusInternalServerError, "Failed to compute subscription health", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, report)
+}
+
 // GetSubscriptionNotifications returns notifications for a subscription.
 func (h *Handlers) GetSubscriptionNotifications(w http.ResponseWriter, r *http.Request) {
 	sub := r.Context().Value("subscription").(*types.Subscription)
@@ -274,6 +809,125 @@ func (h *Handlers) GetSubscriptionNotifications(w http.ResponseWriter, r *http.R
 	writeJSON(w, http.StatusOK, notifications)
 }
 
+// SearchSubscriptionNotifications searches a subscription's notification
+// history by server name, change type, and error text, so a user can answer
+// "did I ever get notified about server X" without trawling chat history.
+func (h *Handlers) SearchSubscriptionNotifications(w http.ResponseWriter, r *http.Request) {
+	sub := r.Context().Value("subscription").(*types.Subscription)
+
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "Missing 'q' parameter", "")
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	notifications, err := h.db.SearchNotifications(r.Context(), sub.ID, query, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to search notifications")
+		writeError(w, http.StatusInternalServerError, "Failed to search notifications", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, notifications)
+}
+
+// defaultAnalyticsWindow and defaultAnalyticsBucket bound GetSubscriptionAnalytics
+// when the caller doesn't specify a "since" or "bucket" query parameter.
+const (
+	defaultAnalyticsWindow = 7 * 24 * time.Hour
+	defaultAnalyticsBucket = time.Hour
+)
+
+// GetSubscriptionAnalytics returns time-bucketed delivery counts,
+// per-channel success rates and median latency, and the noisiest servers
+// for a subscription over a window, to power the dashboard.
+func (h *Handlers) GetSubscriptionAnalytics(w http.ResponseWriter, r *http.Request) {
+	sub := r.Context().Value("subscription").(*types.Subscription)
+
+	since := time.Now().Add(-defaultAnalyticsWindow)
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid 'since' parameter", "Expected RFC3339 format")
+			return
+		}
+	}
+
+	bucketSize := defaultAnalyticsBucket
+	if bucketStr := r.URL.Query().Get("bucket"); bucketStr != "" {
+		var err error
+		bucketSize, err = time.ParseDuration(bucketStr)
+		if err != nil || bucketSize <= 0 {
+			writeError(w, http.StatusBadRequest, "Invalid 'bucket' parameter", "Expected a Go duration string, e.g. '1h'")
+			return
+		}
+	}
+
+	analytics, err := h.db.GetSubscriptionAnalytics(r.Context(), sub.ID, since, bucketSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get subscription analytics")
+		writeError(w, http.StatusInternalServerError, "Failed to get subscription analytics", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, analytics)
+}
+
+// GetDiff computes the diff between the registry snapshots closest to the
+// given "from" and "to" timestamps.
+func (h *Handlers) GetDiff(w http.ResponseWriter, r *http.Request) {
+	fromStr := r.URL.Query().Get("from")
+	toStr := r.URL.Query().Get("to")
+	if fromStr == "" || toStr == "" {
+		writeError(w, http.StatusBadRequest, "Missing parameters", "Both 'from' and 'to' are required")
+		return
+	}
+
+	fromTime, err := time.Parse(time.RFC3339, fromStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid 'from' parameter", "Expected RFC3339 format")
+		return
+	}
+
+	toTime, err := time.Parse(time.RFC3339, toStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid 'to' parameter", "Expected RFC3339 format")
+		return
+	}
+
+	fromSnapshot, err := h.db.GetSnapshotAt(r.Context(), fromTime)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get 'from' snapshot")
+		writeError(w, http.StatusInternalServerError, "Failed to get 'from' snapshot", "")
+		return
+	}
+	if fromSnapshot == nil {
+		writeError(w, http.StatusNotFound, "No snapshot found at or before 'from'", "")
+		return
+	}
+
+	toSnapshot, err := h.db.GetSnapshotAt(r.Context(), toTime)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get 'to' snapshot")
+		writeError(w, http.StatusInternalServerError, "Failed to get 'to' snapshot", "")
+		return
+	}
+	if toSnapshot == nil {
+		writeError(w, http.StatusNotFound, "No snapshot found at or before 'to'", "")
+		return
+	}
+
+	result := h.diffEngine.Compare(fromSnapshot, toSnapshot)
+
+	writeJSON(w, http.StatusOK, result)
+}
+
 // ListChanges returns recent changes.
 func (h *Handlers) ListChanges(w http.ResponseWriter, r *http.Request) {
 	// Parse query parameters
@@ -295,8 +949,14 @@ func (h *Handlers) ListChanges(w http.ResponseWriter, r *http.Request) {
 		limit = 100
 	}
 
-	changes, err := h.db.GetChangesSince(r.Context(), since, limit)
+	cursor := r.URL.Query().Get("cursor")
+
+	changes, nextCursor, err := h.db.GetChangesSincePage(r.Context(), since, cursor, limit)
 	if err != nil {
+		if cursor != "" && strings.HasPrefix(err.Error(), "invalid cursor") {
+			writeError(w, http.StatusBadRequest, "Invalid 'cursor' parameter", err.Error())
+			return
+		}
 		log.Error().Err(err).Msg("Failed to get changes")
 		writeError(w, http.StatusInternalServerError, "Failed to get changes", "")
 		return
@@ -305,6 +965,7 @@ func (h *Handlers) ListChanges(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, types.ChangesResponse{
 		Changes:    changes,
 		TotalCount: len(changes),
+		NextCursor: nextCursor,
 	})
 }
 
@@ -332,7 +993,38 @@ func (h *Handlers) GetChange(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, change)
 }
 
-// ListServers lists servers from the registry.
+// GetTransparencyEntry returns the transparency log entry at index along
+// with an inclusion proof verifying it against the change it references.
+func (h *Handlers) GetTransparencyEntry(w http.ResponseWriter, r *http.Request) {
+	if h.transparencyLog == nil {
+		writeError(w, http.StatusNotFound, "Transparency log is not enabled", "")
+		return
+	}
+
+	index, err := strconv.ParseInt(chi.URLParam(r, "index"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid index", "")
+		return
+	}
+
+	proof, err := h.transparencyLog.GetProof(r.Context(), index)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get transparency entry")
+		writeError(w, http.StatusInternalServerError, "Failed to get transparency entry", "")
+		return
+	}
+
+	if proof == nil {
+		writeError(w, http.StatusNotFound, "Transparency entry not found", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, proof)
+}
+
+// ListServers lists servers from the registry, optionally filtered to
+// those matching a single category (see internal/category) via the tag
+// query parameter.
 func (h *Handlers) ListServers(w http.ResponseWriter, r *http.Request) {
 	servers, err := h.registryClient.ListServers(r.Context())
 	if err != nil {
@@ -341,6 +1033,16 @@ func (h *Handlers) ListServers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		filtered := make([]types.Server, 0, len(servers))
+		for _, s := range servers {
+			if hasTag(category.Categorize(s), tag) {
+				filtered = append(filtered, s)
+			}
+		}
+		servers = filtered
+	}
+
 	writeJSON(w, http.StatusOK, map[string]interface{}{
 		"servers": servers,
 		"count":   len(servers),
@@ -366,80 +1068,378 @@ func (h *Handlers) GetServer(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, server)
 }
 
-// GetServerChanges returns changes for a specific server.
-func (h *Handlers) GetServerChanges(w http.ResponseWriter, r *http.Request) {
-	serverName := chi.URLParam(r, "serverName")
+// ListCategories returns every category servers can be tagged with (see
+// internal/category), for populating a filter UI or discovering valid
+// values for the tag parameter on ListServers.
+func (h *Handlers) ListCategories(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"categories": category.AllCategories(),
+	})
+}
 
-	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
-	if limit <= 0 || limit > 100 {
-		limit = 50
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
 	}
+	return false
+}
 
-	changes, err := h.db.GetChangesForServer(r.Context(), serverName, limit)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to get server changes")
-		writeError(w, http.StatusInternalServerError, "Failed to get server changes", "")
+// SemanticSearchServers ranks registry servers against a natural-language
+// query using a lightweight local embedding (see internal/semantic)
+// instead of requiring an exact keyword match. Embeddings are cached in
+// server_embeddings and only recomputed when a server's name or
+// description has changed since it was last cached.
+func (h *Handlers) SemanticSearchServers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "q is required", "")
 		return
 	}
 
-	writeJSON(w, http.StatusOK, changes)
-}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
 
-// RSSFeed returns an RSS feed of recent changes.
-func (h *Handlers) RSSFeed(w http.ResponseWriter, r *http.Request) {
-	feed, err := h.buildFeed(r)
+	servers, err := h.registryClient.ListServers(r.Context())
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to build feed")
-		writeError(w, http.StatusInternalServerError, "Failed to build feed", "")
+		log.Error().Err(err).Msg("Failed to list servers")
+		writeError(w, http.StatusInternalServerError, "Failed to list servers", "")
 		return
 	}
 
-	rss, err := feed.ToRss()
+	cached, err := h.db.ListServerEmbeddings(r.Context())
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "Failed to generate RSS", "")
+		log.Error().Err(err).Msg("Failed to load cached server embeddings")
+		writeError(w, http.StatusInternalServerError, "Failed to search servers", "")
 		return
 	}
+	cachedByName := make(map[string]types.ServerEmbedding, len(cached))
+	for _, e := range cached {
+		cachedByName[e.ServerName] = e
+	}
+
+	queryVec := semantic.Embed(query)
 
-	w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
-	w.Write([]byte(rss))
+	type semanticResult struct {
+		Server     types.Server `json:"server"`
+		Similarity float64      `json:"similarity"`
+	}
+	results := make([]semanticResult, 0, len(servers))
+	for _, s := range servers {
+		hash := embeddingContentHash(s.Name, s.Description)
+
+		var vec semantic.Vector
+		if existing, ok := cachedByName[s.Name]; ok && existing.ContentHash == hash && len(existing.Embedding) == semantic.Dimensions {
+			copy(vec[:], existing.Embedding)
+		} else {
+			vec = semantic.Embed(s.Name + " " + s.Description)
+			if err := h.db.UpsertServerEmbedding(r.Context(), types.ServerEmbedding{
+				ServerName:  s.Name,
+				ContentHash: hash,
+				Embedding:   vec[:],
+			}); err != nil {
+				log.Warn().Err(err).Str("server", s.Name).Msg("Failed to cache server embedding")
+			}
+		}
+
+		results = append(results, semanticResult{Server: s, Similarity: semantic.CosineSimilarity(queryVec, vec)})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Similarity > results[j].Similarity
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"results": results,
+		"count":   len(results),
+	})
 }
 
-// AtomFeed returns an Atom feed of recent changes.
-func (h *Handlers) AtomFeed(w http.ResponseWriter, r *http.Request) {
-	feed, err := h.buildFeed(r)
+// embeddingContentHash identifies the text a cached embedding was computed
+// from, so an edited server's embedding is recognized as stale.
+func embeddingContentHash(name, description string) string {
+	sum := sha256.Sum256([]byte(name + "\x00" + description))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateWatchlist creates a new watchlist: a lightweight, API-key-scoped
+// list of server names, distinct from a full Subscription. The returned API
+// key is shown only once and authenticates GetWatchlistStatus/
+// UpdateWatchlist.
+func (h *Handlers) CreateWatchlist(w http.ResponseWriter, r *http.Request) {
+	var req types.CreateWatchlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	apiKey, err := subscription.GenerateAPIKey()
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to build feed")
-		writeError(w, http.StatusInternalServerError, "Failed to build feed", "")
+		log.Error().Err(err).Msg("Failed to generate watchlist API key")
+		writeError(w, http.StatusInternalServerError, "Failed to create watchlist", "")
+		return
+	}
+
+	now := time.Now().UTC()
+	watchlist := &types.Watchlist{
+		ID:          uuid.New(),
+		APIKeyHash:  subscription.HashAPIKey(apiKey),
+		ServerNames: req.ServerNames,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := h.db.CreateWatchlist(r.Context(), watchlist); err != nil {
+		log.Error().Err(err).Msg("Failed to create watchlist")
+		writeError(w, http.StatusInternalServerError, "Failed to create watchlist", "")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, types.WatchlistResponse{
+		Watchlist: *watchlist,
+		APIKey:    apiKey,
+	})
+}
+
+// GetWatchlistStatus returns a watchlist's current per-server status:
+// whether each watched server still exists in the registry and, if so, its
+// latest version.
+func (h *Handlers) GetWatchlistStatus(w http.ResponseWriter, r *http.Request) {
+	watchlist := middleware.GetWatchlistFromContext(r.Context())
+	if watchlist == nil {
+		writeError(w, http.StatusUnauthorized, "Invalid API key", "")
 		return
 	}
 
-	atom, err := feed.ToAtom()
+	servers, err := h.registryClient.ListServers(r.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list servers")
+		writeError(w, http.StatusInternalServerError, "Failed to get watchlist status", "")
+		return
+	}
+	byName := make(map[string]types.Server, len(servers))
+	for _, s := range servers {
+		byName[s.Name] = s
+	}
+
+	statuses := make([]types.WatchlistServerStatus, 0, len(watchlist.ServerNames))
+	for _, name := range watchlist.ServerNames {
+		s, ok := byName[name]
+		status := types.WatchlistServerStatus{Name: name, Found: ok}
+		if ok && s.VersionDetail != nil {
+			status.LatestVersion = s.VersionDetail.Version
+		}
+		statuses = append(statuses, status)
+	}
+
+	writeJSON(w, http.StatusOK, types.WatchlistStatusResponse{
+		Watchlist: *watchlist,
+		Servers:   statuses,
+	})
+}
+
+// UpdateWatchlist replaces a watchlist's watched server names, e.g. for a
+// CLI's watchlist add/remove commands.
+func (h *Handlers) UpdateWatchlist(w http.ResponseWriter, r *http.Request) {
+	watchlist := middleware.GetWatchlistFromContext(r.Context())
+	if watchlist == nil {
+		writeError(w, http.StatusUnauthorized, "Invalid API key", "")
+		return
+	}
+
+	var req types.UpdateWatchlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	watchlist.ServerNames = req.ServerNames
+	watchlist.UpdatedAt = time.Now().UTC()
+
+	if err := h.db.UpdateWatchlist(r.Context(), watchlist); err != nil {
+		log.Error().Err(err).Msg("Failed to update watchlist")
+		writeError(w, http.StatusInternalServerError, "Failed to update watchlist", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, watchlist)
+}
+
+// GetServerChanges returns changes for a specific server.
+func (h *Handlers) GetServerChanges(w http.ResponseWriter, r *http.Request) {
+	serverName := chi.URLParam(r, "serverName")
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	changes, err := h.db.GetChangesForServer(r.Context(), serverName, limit)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "Failed to generate Atom", "")
+		log.Error().Err(err).Msg("Failed to get server changes")
+		writeError(w, http.StatusInternalServerError, "Failed to get server changes", "")
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
-	w.Write([]byte(atom))
+	writeJSON(w, http.StatusOK, changes)
+}
+
+// RSSFeed returns an RSS feed of recent changes.
+func (h *Handlers) RSSFeed(w http.ResponseWriter, r *http.Request) {
+	h.serveFeed(w, r, "rss", "application/rss+xml; charset=utf-8", (*feeds.Feed).ToRss)
+}
+
+// AtomFeed returns an Atom feed of recent changes.
+func (h *Handlers) AtomFeed(w http.ResponseWriter, r *http.Request) {
+	h.serveFeed(w, r, "atom", "application/atom+xml; charset=utf-8", (*feeds.Feed).ToAtom)
 }
 
 // JSONFeed returns a JSON feed of recent changes.
 func (h *Handlers) JSONFeed(w http.ResponseWriter, r *http.Request) {
-	feed, err := h.buildFeed(r)
+	h.serveFeed(w, r, "json", "application/feed+json; charset=utf-8", (*feeds.Feed).ToJSON)
+}
+
+// GetChangelogReport renders a human-readable changelog of registry
+// activity since the given time (default: the last 7 days) as Markdown or
+// HTML (?format=html), ready to paste into a newsletter or release notes.
+func (h *Handlers) GetChangelogReport(w http.ResponseWriter, r *http.Request) {
+	sinceStr := r.URL.Query().Get("since")
+	var since time.Time
+	if sinceStr != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid 'since' parameter", "Expected RFC3339 format")
+			return
+		}
+	} else {
+		since = time.Now().Add(-7 * 24 * time.Hour)
+	}
+	until := time.Now()
+
+	changes, err := h.db.GetChangesSince(r.Context(), since, 500)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to build feed")
-		writeError(w, http.StatusInternalServerError, "Failed to build feed", "")
+		log.Error().Err(err).Msg("Failed to get changes for changelog report")
+		writeError(w, http.StatusInternalServerError, "Failed to get changes", "")
 		return
 	}
 
-	jsonFeed, err := feed.ToJSON()
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "Failed to generate JSON feed", "")
+	changelog := report.BuildChangelog(changes, since, until)
+
+	if strings.ToLower(r.URL.Query().Get("format")) == "html" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(changelog.RenderHTML()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Write([]byte(changelog.RenderMarkdown()))
+}
+
+// feedCacheTTL bounds how long a rendered feed is served out of the cache
+// before it's regenerated from the database, so heavy feed-reader traffic
+// (most readers poll every few minutes) doesn't turn into a Postgres query
+// per request.
+const feedCacheTTL = 60 * time.Second
+
+// cachedFeed is what's stored in the cache under a feed's cache key.
+type cachedFeed struct {
+	Body         string    `json:"body"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// serveFeed renders (or reuses a cached rendering of) a feed in the given
+// format and writes it with ETag/Last-Modified/Cache-Control headers, so a
+// conditional request from a well-behaved feed reader gets a cheap 304
+// instead of the full body. If FeedSigningKey is configured, the response
+// also carries an HMAC-SHA256 signature of the body in X-Feed-Signature, so
+// a reader can verify the feed came from this server unmodified.
+func (h *Handlers) serveFeed(w http.ResponseWriter, r *http.Request, format, contentType string, render func(*feeds.Feed) (string, error)) {
+	cacheKey := "mcp:feed:" + format
+
+	var cf cachedFeed
+	if cached, err := h.cache.Get(r.Context(), cacheKey); err != nil {
+		log.Warn().Err(err).Str("format", format).Msg("Failed to read cached feed")
+	} else if cached != nil {
+		if err := json.Unmarshal(cached, &cf); err != nil {
+			log.Warn().Err(err).Str("format", format).Msg("Failed to decode cached feed")
+			cf = cachedFeed{}
+		}
+	}
+
+	if cf.Body == "" {
+		feed, err := h.buildFeed(r)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to build feed")
+			writeError(w, http.StatusInternalServerError, "Failed to build feed", "")
+			return
+		}
+
+		body, err := render(feed)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to generate feed", "")
+			return
+		}
+
+		cf = cachedFeed{Body: body, LastModified: feedLastModified(feed)}
+		if data, err := json.Marshal(cf); err != nil {
+			log.Warn().Err(err).Str("format", format).Msg("Failed to encode feed for caching")
+		} else if err := h.cache.Set(r.Context(), cacheKey, data, feedCacheTTL); err != nil {
+			log.Warn().Err(err).Str("format", format).Msg("Failed to cache feed")
+		}
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256([]byte(cf.Body)))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", cf.LastModified.UTC().Format(http.TimeFormat))
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(feedCacheTTL.Seconds())))
+	if h.feedSigningKey != "" {
+		mac := hmac.New(sha256.New, []byte(h.feedSigningKey))
+		mac.Write([]byte(cf.Body))
+		w.Header().Set("X-Feed-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	if match := r.Header.Get("If-None-Match"); match != "" && match == etag {
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
+	if since := r.Header.Get("If-Modified-Since"); since != "" {
+		if t, err := http.ParseTime(since); err == nil && !cf.LastModified.After(t) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write([]byte(cf.Body))
+}
 
-	w.Header().Set("Content-Type", "application/feed+json; charset=utf-8")
-	w.Write([]byte(jsonFeed))
+// feedLastModified is the most recent timestamp among a feed's items (or its
+// creation time, if it has none), used for the Last-Modified header.
+func feedLastModified(feed *feeds.Feed) time.Time {
+	latest := feed.Created
+	for _, item := range feed.Items {
+		if item.Created.After(latest) {
+			latest = item.Created
+		}
+	}
+	return latest
 }
 
 func (h *Handlers) buildFeed(r *http.Request) (*feeds.Feed, error) {
@@ -458,6 +1458,26 @@ func (h *Handlers) buildFeed(r *http.Request) (*feeds.Feed, error) {
 		Created:     time.Now(),
 	}
 
+	if h.pollInterval > 0 {
+		history, err := h.db.GetPollHistorySince(r.Context(), since)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to load poll history for gap detection")
+		} else {
+			gaps := monitoring.DetectGaps(history, h.pollInterval, h.pollInterval, time.Now())
+			for _, gap := range gaps {
+				feed.Items = append(feed.Items, &feeds.Item{
+					Title: "Monitoring gap: no successful poll",
+					Description: fmt.Sprintf(
+						"The registry was not being monitored from %s to %s, so any changes in that window may be missing from this feed.",
+						gap.From.Format(time.RFC1123), gap.To.Format(time.RFC1123),
+					),
+					Id:      fmt.Sprintf("gap-%d-%d", gap.From.Unix(), gap.To.Unix()),
+					Created: gap.To,
+				})
+			}
+		}
+	}
+
 	for _, change := range changes {
 		var title, description string
 		switch change.ChangeType {
@@ -492,6 +1512,464 @@ func (h *Handlers) buildFeed(r *http.Request) (*feeds.Feed, error) {
 	return feed, nil
 }
 
+// PreviewNotification renders the message a channel would receive for a
+// change without sending it, so template authors and UI builders can
+// iterate on channel config safely.
+func (h *Handlers) PreviewNotification(w http.ResponseWriter, r *http.Request) {
+	if h.dispatcher == nil {
+		writeError(w, http.StatusServiceUnavailable, "Notification dispatcher not available", "")
+		return
+	}
+
+	var req struct {
+		Channel types.Channel `json:"channel" validate:"required"`
+		Change  types.Change  `json:"change" validate:"required"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+
+	if req.Channel.Type == "" {
+		writeError(w, http.StatusBadRequest, "channel.type is required", "")
+		return
+	}
+
+	rendered, err := h.dispatcher.Preview(&req.Channel, &req.Change)
+	if err != nil {
+		if errors.Is(err, notifier.ErrPreviewNotSupported) {
+			writeError(w, http.StatusNotImplemented, err.Error(), "")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "Failed to render preview", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"channel_type": req.Channel.Type,
+		"preview":      rendered,
+	})
+}
+
+// slackInteractionPayload is the subset of Slack's block_actions
+// interactivity payload (https://api.slack.com/reference/interaction-payloads/block-actions)
+// this handler acts on.
+type slackInteractionPayload struct {
+	Type    string `json:"type"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+}
+
+// SlackInteraction handles Block Kit button clicks (e.g. "Mute this
+// server") sent to this server by Slack. Slack requires a response within
+// ~3 seconds, so this only performs the one state change the button
+// requests and replies with a short confirmation message.
+func (h *Handlers) SlackInteraction(w http.ResponseWriter, r *http.Request) {
+	if h.slackSigningKey == "" {
+		writeError(w, http.StatusServiceUnavailable, "Slack interactivity is not configured", "")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+	signature := r.Header.Get("X-Slack-Signature")
+	if !slack.VerifySignature(h.slackSigningKey, timestamp, signature, body) {
+		writeError(w, http.StatusUnauthorized, "Invalid Slack signature", "")
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+
+	var payload slackInteractionPayload
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid interaction payload", "")
+		return
+	}
+
+	var message string
+	for _, action := range payload.Actions {
+		if action.ActionID != slack.ActionMuteServer {
+			continue
+		}
+
+		subscriptionID, serverName, err := slack.ParseMuteButtonValue(action.Value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid mute action", err.Error())
+			return
+		}
+
+		id, err := uuid.Parse(subscriptionID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid subscription id", "")
+			return
+		}
+
+		if err := h.subscriptionMgr.MuteServer(r.Context(), id, serverName); err != nil {
+			log.Error().Err(err).Str("server", serverName).Msg("Failed to mute server from Slack interaction")
+			writeError(w, http.StatusInternalServerError, "Failed to mute server", "")
+			return
+		}
+
+		message = fmt.Sprintf("Muted *%s* for this subscription.", serverName)
+	}
+
+	if message == "" {
+		message = "No action taken."
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"response_type": "ephemeral",
+		"text":          message,
+	})
+}
+
+// DiscordInteraction handles Discord's HTTP interactions endpoint, backing
+// the "/mcp watch|unwatch|recent" slash commands. Discord requires a
+// response within roughly 3 seconds and expects a PING to be answered with
+// a PONG of the same shape, so this endpoint is exempt from API key auth
+// and instead verifies Discord's Ed25519 request signature.
+func (h *Handlers) DiscordInteraction(w http.ResponseWriter, r *http.Request) {
+	if h.discordBot == nil {
+		writeError(w, http.StatusServiceUnavailable, "Discord bot is not configured", "")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	timestamp := r.Header.Get("X-Signature-Timestamp")
+	signature := r.Header.Get("X-Signature-Ed25519")
+	if !h.discordBot.VerifySignature(timestamp, signature, body) {
+		writeError(w, http.StatusUnauthorized, "Invalid Discord request signature", "")
+		return
+	}
+
+	response, err := h.discordBot.HandleInteraction(r.Context(), body)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to handle Discord interaction")
+		writeError(w, http.StatusInternalServerError, "Failed to handle interaction", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// TelegramInteraction handles Telegram's bot webhook, backing the
+// "/start", "/subscribe", "/mute", and "/recent" commands. Telegram does not
+// sign requests, so this endpoint is exempt from API key auth and instead
+// verifies the secret token configured via setWebhook.
+func (h *Handlers) TelegramInteraction(w http.ResponseWriter, r *http.Request) {
+	if h.telegramBot == nil {
+		writeError(w, http.StatusServiceUnavailable, "Telegram bot is not configured", "")
+		return
+	}
+
+	if !h.telegramBot.VerifySecretToken(r.Header.Get("X-Telegram-Bot-Api-Secret-Token")) {
+		writeError(w, http.StatusUnauthorized, "Invalid Telegram secret token", "")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	response, err := h.telegramBot.HandleUpdate(r.Context(), body)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to handle Telegram update")
+		writeError(w, http.StatusInternalServerError, "Failed to handle update", "")
+		return
+	}
+	if response == nil {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// unsubscribeFrequencies lists the digest frequencies the preference page
+// offers, in the order shown.
+var unsubscribeFrequencies = []types.DigestFrequency{
+	types.DigestImmediate,
+	types.DigestHourly,
+	types.DigestDaily,
+	types.DigestWeekly,
+}
+
+// Unsubscribe serves the link embedded in notification emails' footer and
+// List-Unsubscribe header. GET shows a preference page to switch digest
+// frequency or unsubscribe completely; POST applies the chosen change,
+// including a bare "List-Unsubscribe=One-Click" body per RFC 8058, which
+// mail clients submit without ever rendering the response.
+func (h *Handlers) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	if h.dispatcher == nil {
+		writeError(w, http.StatusServiceUnavailable, "Notification dispatcher not available", "")
+		return
+	}
+
+	subscriptionID := r.URL.Query().Get("sub")
+	token := r.URL.Query().Get("token")
+	id, err := uuid.Parse(subscriptionID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid subscription id", "")
+		return
+	}
+
+	channels, err := h.db.GetChannelsForSubscription(r.Context(), id)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to look up channels for unsubscribe link")
+		writeError(w, http.StatusInternalServerError, "Failed to look up subscription", "")
+		return
+	}
+
+	var emailChannel *types.Channel
+	for i := range channels {
+		if channels[i].Type == types.ChannelEmail {
+			emailChannel = &channels[i]
+			break
+		}
+	}
+	if emailChannel == nil {
+		writeError(w, http.StatusNotFound, "No email channel on this subscription", "")
+		return
+	}
+
+	if !h.dispatcher.VerifyUnsubscribeToken(subscriptionID, emailChannel.Config.EmailAddress, token) {
+		writeError(w, http.StatusUnauthorized, "Invalid or expired unsubscribe link", "")
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		writeUnsubscribePage(w, subscriptionID, token, emailChannel.Config.EmailDigest, "")
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid form data", "")
+		return
+	}
+
+	frequency := types.DigestFrequency(r.PostForm.Get("frequency"))
+	if frequency != "" {
+		emailChannel.Config.EmailDigest = frequency
+	} else {
+		emailChannel.Enabled = false
+	}
+
+	if err := h.db.UpdateChannel(r.Context(), emailChannel); err != nil {
+		log.Error().Err(err).Msg("Failed to update channel from unsubscribe request")
+		writeError(w, http.StatusInternalServerError, "Failed to update preferences", "")
+		return
+	}
+
+	// List-Unsubscribe-Post submissions are never rendered, but browser form
+	// submissions land here too, so still confirm what happened.
+	if frequency != "" {
+		writeUnsubscribePage(w, subscriptionID, token, frequency, fmt.Sprintf("Frequency updated to %s.", frequency))
+	} else {
+		writeUnsubscribePage(w, subscriptionID, token, emailChannel.Config.EmailDigest, "You've been unsubscribed from these emails.")
+	}
+}
+
+func writeUnsubscribePage(w http.ResponseWriter, subscriptionID, token string, current types.DigestFrequency, message string) {
+	var options strings.Builder
+	for _, f := range unsubscribeFrequencies {
+		selected := ""
+		if f == current {
+			selected = " selected"
+		}
+		fmt.Fprintf(&options, `<option value="%s"%s>%s</option>`, html.EscapeString(string(f)), selected, html.EscapeString(string(f)))
+	}
+
+	messageHTML := ""
+	if message != "" {
+		messageHTML = fmt.Sprintf(`<p class="message">%s</p>`, html.EscapeString(message))
+	}
+
+	escapedID := html.EscapeString(subscriptionID)
+	escapedToken := html.EscapeString(token)
+	page := fmt.Sprintf(unsubscribePageTemplate,
+		messageHTML,
+		escapedID, escapedToken, options.String(),
+		escapedID, escapedToken)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(page))
+}
+
+const unsubscribePageTemplate = `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>Email preferences - MCP Notify</title>
+	<style>
+		body { font-family: -apple-system, sans-serif; margin: 2rem; color: #1a1a1a; max-width: 480px; }
+		h1 { font-size: 1.25rem; }
+		.message { color: #0a7d2c; }
+		form { margin-top: 1.5rem; }
+		select, button { font-size: 1rem; padding: .3rem .5rem; }
+		.danger { color: #b3261e; background: none; border: 1px solid #b3261e; border-radius: 4px; cursor: pointer; }
+	</style>
+</head>
+<body>
+	<h1>Email notification preferences</h1>
+	%s
+	<form method="POST" action="/unsubscribe?sub=%s&token=%s">
+		<label for="frequency">Digest frequency</label><br>
+		<select name="frequency" id="frequency">%s</select>
+		<button type="submit">Save</button>
+	</form>
+	<form method="POST" action="/unsubscribe?sub=%s&token=%s">
+		<button type="submit" class="danger">Unsubscribe completely</button>
+	</form>
+</body>
+</html>`
+
+// verifyBounceWebhookSecret checks the "secret" query parameter against the
+// configured value in constant time. Used by the SES and SendGrid bounce
+// webhooks, neither of which signs its requests in a way this module
+// verifies: their webhook URLs must be configured with this value as a
+// query parameter.
+func verifyBounceWebhookSecret(configured string, r *http.Request) bool {
+	if configured == "" {
+		return false
+	}
+	provided := r.URL.Query().Get("secret")
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(configured)) == 1
+}
+
+// disableBouncedChannels disables the email channel for every hard bounce
+// or complaint in events, logging (but not failing the request on) any
+// individual lookup error so one bad address can't block the rest.
+func (h *Handlers) disableBouncedChannels(ctx context.Context, events []email.BounceEvent) {
+	for _, event := range events {
+		if !event.Permanent {
+			continue
+		}
+		disabled, err := h.subscriptionMgr.DisableEmailChannel(ctx, event.Email)
+		if err != nil {
+			log.Error().Err(err).Str("email", event.Email).Msg("Failed to disable channel after bounce")
+			continue
+		}
+		if disabled > 0 {
+			log.Info().Str("email", event.Email).Str("reason", event.Reason).Int("channels", disabled).Msg("Disabled email channel(s) after bounce/complaint")
+		}
+	}
+}
+
+// EmailBounceSES handles Amazon SES's SNS-delivered bounce/complaint
+// notifications, auto-disabling the affected channel on a hard bounce or
+// complaint. SNS does not sign requests in a way this module verifies, so
+// the webhook URL must include a "secret" query parameter matching
+// EmailConfig.BounceWebhookSecret.
+func (h *Handlers) EmailBounceSES(w http.ResponseWriter, r *http.Request) {
+	if !verifyBounceWebhookSecret(h.emailBounceWebhookSecret, r) {
+		writeError(w, http.StatusUnauthorized, "Invalid or missing webhook secret", "")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	events, isConfirm, subscribeURL, err := email.ParseSESNotification(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid SNS notification", err.Error())
+		return
+	}
+
+	if isConfirm {
+		if err := email.ConfirmSESSubscription(r.Context(), subscribeURL); err != nil {
+			log.Error().Err(err).Msg("Failed to confirm SES SNS subscription")
+			writeError(w, http.StatusInternalServerError, "Failed to confirm subscription", "")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "confirmed"})
+		return
+	}
+
+	h.disableBouncedChannels(r.Context(), events)
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// EmailBounceSendGrid handles SendGrid's Event Webhook, auto-disabling the
+// affected channel on a hard bounce or spam complaint. SendGrid does not
+// sign requests in a way this module verifies, so the webhook URL must
+// include a "secret" query parameter matching EmailConfig.BounceWebhookSecret.
+func (h *Handlers) EmailBounceSendGrid(w http.ResponseWriter, r *http.Request) {
+	if !verifyBounceWebhookSecret(h.emailBounceWebhookSecret, r) {
+		writeError(w, http.StatusUnauthorized, "Invalid or missing webhook secret", "")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	events, err := email.ParseSendGridEvents(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid SendGrid events", err.Error())
+		return
+	}
+
+	h.disableBouncedChannels(r.Context(), events)
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// EmailBounceMailgun handles Mailgun's signed webhook, auto-disabling the
+// affected channel on a permanent delivery failure or spam complaint.
+// Authenticated by verifying Mailgun's own HMAC signature rather than a
+// shared secret, since Mailgun signs every request.
+func (h *Handlers) EmailBounceMailgun(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body", "")
+		return
+	}
+
+	sig, err := email.ParseMailgunSignature(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid Mailgun webhook", err.Error())
+		return
+	}
+	if !email.VerifyMailgunSignature(sig, h.mailgunSigningKey) {
+		writeError(w, http.StatusUnauthorized, "Invalid Mailgun signature", "")
+		return
+	}
+
+	event, ok, err := email.ParseMailgunEvent(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid Mailgun webhook", err.Error())
+		return
+	}
+	if ok {
+		h.disableBouncedChannels(r.Context(), []email.BounceEvent{event})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
 // TestWebhook tests a webhook URL without creating a subscription.
 func (h *Handlers) TestWebhook(w http.ResponseWriter, r *http.Request) {
 	var req struct {
@@ -583,6 +2061,143 @@ func (h *Handlers) ServeOpenAPIYAML(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ServeDashboard serves a minimal read-only operator dashboard: recent
+// changes, servers with per-server history, and (given a subscription's ID
+// and API key, entered locally in the browser) its health, channel success/
+// failure rates, and pending retry queue. It's plain HTML/JS calling the
+// same REST endpoints a script would, rather than a separate built
+// frontend, so there's nothing to build or version alongside the server.
+func (h *Handlers) ServeDashboard(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html")
+	w.Write([]byte(dashboardHTML))
+}
+
+const dashboardHTML = `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>MCP Notify</title>
+	<style>
+		body { font-family: -apple-system, sans-serif; margin: 2rem; color: #1a1a1a; }
+		h1 { font-size: 1.25rem; }
+		h2 { font-size: 1rem; margin-top: 2rem; border-bottom: 1px solid #ddd; padding-bottom: .25rem; }
+		table { border-collapse: collapse; width: 100%; font-size: .9rem; }
+		th, td { text-align: left; padding: .3rem .6rem; border-bottom: 1px solid #eee; }
+		.ok { color: #0a7d2c; }
+		.bad { color: #b3261e; }
+		.muted { color: #666; }
+		input { font-family: monospace; }
+		#subscription-form { display: flex; gap: .5rem; align-items: center; }
+		section { max-width: 900px; }
+	</style>
+</head>
+<body>
+	<h1>MCP Notify</h1>
+	<p class="muted">Read-only operator dashboard, built on the same REST API a script would call.</p>
+
+	<section>
+		<h2>Recent changes</h2>
+		<table id="changes-table"><thead><tr><th>Time</th><th>Server</th><th>Type</th></tr></thead><tbody></tbody></table>
+	</section>
+
+	<section>
+		<h2>Servers</h2>
+		<table id="servers-table"><thead><tr><th>Name</th><th>Latest version</th></tr></thead><tbody></tbody></table>
+	</section>
+
+	<section>
+		<h2>Subscription health</h2>
+		<p class="muted">Enter a subscription's ID and API key (kept only in this browser tab) to see its delivery health, channel success/failure rates, and retry queue.</p>
+		<form id="subscription-form" onsubmit="loadSubscription(); return false">
+			<input id="sub-id" placeholder="subscription ID" size="36">
+			<input id="sub-key" placeholder="API key" size="36" type="password">
+			<button type="submit">Load</button>
+		</form>
+		<div id="subscription-result"></div>
+	</section>
+
+	<script>
+	async function getJSON(path, headers) {
+		const res = await fetch(path, { headers: headers || {} });
+		if (!res.ok) throw new Error((await res.json()).error || res.statusText);
+		return res.json();
+	}
+
+	function escapeHTML(s) {
+		return String(s).replace(/[&<>"']/g, c => ({'&':'&amp;','<':'&lt;','>':'&gt;','"':'&quot;',"'":'&#39;'}[c]));
+	}
+
+	async function loadChanges() {
+		const tbody = document.querySelector('#changes-table tbody');
+		try {
+			const data = await getJSON('/api/v1/changes?limit=25');
+			const changes = data.changes || data || [];
+			tbody.innerHTML = changes.map(c =>
+				'<tr><td>' + escapeHTML(c.created_at || c.timestamp || '') + '</td><td>' +
+				escapeHTML(c.server_name || '') + '</td><td>' + escapeHTML(c.type || c.change_type || '') + '</td></tr>'
+			).join('');
+		} catch (e) {
+			tbody.innerHTML = '<tr><td colspan="3" class="bad">' + escapeHTML(e.message) + '</td></tr>';
+		}
+	}
+
+	async function loadServers() {
+		const tbody = document.querySelector('#servers-table tbody');
+		try {
+			const data = await getJSON('/api/v1/servers?limit=25');
+			const servers = data.servers || data || [];
+			tbody.innerHTML = servers.map(s =>
+				'<tr><td>' + escapeHTML(s.name || '') + '</td><td>' + escapeHTML(s.version || s.latest_version || '') + '</td></tr>'
+			).join('');
+		} catch (e) {
+			tbody.innerHTML = '<tr><td colspan="2" class="bad">' + escapeHTML(e.message) + '</td></tr>';
+		}
+	}
+
+	async function loadSubscription() {
+		const id = document.getElementById('sub-id').value.trim();
+		const key = document.getElementById('sub-key').value.trim();
+		const out = document.getElementById('subscription-result');
+		out.innerHTML = '<p class="muted">Loading&hellip;</p>';
+		if (!id || !key) {
+			out.innerHTML = '<p class="bad">Both fields are required.</p>';
+			return;
+		}
+		const headers = { 'X-API-Key': key };
+		try {
+			const [health, notifications] = await Promise.all([
+				getJSON('/api/v1/subscriptions/' + encodeURIComponent(id) + '/health', headers),
+				getJSON('/api/v1/subscriptions/' + encodeURIComponent(id) + '/notifications?limit=25', headers),
+			]);
+
+			const channelRows = (health.channel_stats || []).map(c =>
+				'<tr><td>' + escapeHTML(c.type) + '</td><td class="ok">' + c.success_count +
+				'</td><td class="bad">' + c.failure_count + '</td><td>' + escapeHTML(c.last_error || '') + '</td></tr>'
+			).join('');
+
+			const pending = (notifications || []).filter(n => n.next_retry);
+			const retryRows = pending.map(n =>
+				'<tr><td>' + escapeHTML(n.server_name || '') + '</td><td>' + n.attempts +
+				'</td><td>' + escapeHTML(n.next_retry) + '</td></tr>'
+			).join('');
+
+			out.innerHTML =
+				'<p>Health score: <strong>' + health.score + '/100</strong>' +
+				(health.delivery_success_rate >= 0 ? ' &middot; delivery success rate ' + Math.round(health.delivery_success_rate * 100) + '%' : '') + '</p>' +
+				(health.recommendations ? '<ul>' + health.recommendations.map(r => '<li>' + escapeHTML(r) + '</li>').join('') + '</ul>' : '') +
+				'<h3>Channels</h3><table><thead><tr><th>Type</th><th>Success</th><th>Failure</th><th>Last error</th></tr></thead><tbody>' + channelRows + '</tbody></table>' +
+				'<h3>Retry queue (' + pending.length + ' pending)</h3><table><thead><tr><th>Server</th><th>Attempts</th><th>Next retry</th></tr></thead><tbody>' + retryRows + '</tbody></table>';
+		} catch (e) {
+			out.innerHTML = '<p class="bad">' + escapeHTML(e.message) + '</p>';
+		}
+	}
+
+	loadChanges();
+	loadServers();
+	</script>
+</body>
+</html>`
+
 // Helper functions
 
 func writeJSON(w http.ResponseWriter, status int, data interface{}) {
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// CreateOrganization creates a new organization owned by the logged-in user.
+func (h *Handlers) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+
+	var req types.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	org, err := h.organizationMgr.Create(r.Context(), req, user.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create organization")
+		writeError(w, http.StatusBadRequest, "Failed to create organization", err.Error())
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "create", "organization", org.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, org)
+
+	writeJSON(w, http.StatusCreated, org)
+}
+
+// ListMyOrganizations lists the organizations the logged-in user belongs to.
+func (h *Handlers) ListMyOrganizations(w http.ResponseWriter, r *http.Request) {
+	user := middleware.GetUserFromContext(r.Context())
+
+	orgs, err := h.db.ListOrganizationsForUser(r.Context(), user.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list organizations for user")
+		writeError(w, http.StatusInternalServerError, "Failed to list organizations", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, orgs)
+}
+
+// GetOrganization returns an organization the caller is a member of.
+func (h *Handlers) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	org, err := h.db.GetOrganizationByID(r.Context(), middleware.GetOrgIDFromContext(r.Context()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get organization")
+		writeError(w, http.StatusInternalServerError, "Failed to get organization", "")
+		return
+	}
+	if org == nil {
+		writeError(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, org)
+}
+
+// UpdateOrganization updates an organization's name or subscription quota.
+// Requires the caller to be an owner or admin.
+func (h *Handlers) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	var req types.UpdateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	orgID := middleware.GetOrgIDFromContext(r.Context())
+	org, err := h.organizationMgr.Update(r.Context(), orgID, req)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update organization")
+		writeError(w, http.StatusBadRequest, "Failed to update organization", err.Error())
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "update", "organization", orgID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, org)
+
+	writeJSON(w, http.StatusOK, org)
+}
+
+// DeleteOrganization deletes an organization. Requires the caller to be an
+// owner. Its subscriptions are orphaned, not deleted with it.
+func (h *Handlers) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+	orgID := middleware.GetOrgIDFromContext(r.Context())
+	if err := h.organizationMgr.Delete(r.Context(), orgID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete organization")
+		writeError(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "delete", "organization", orgID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, nil)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListOrganizationMembers lists the members of an organization the caller
+// belongs to.
+func (h *Handlers) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+	members, err := h.db.ListOrganizationMembers(r.Context(), middleware.GetOrgIDFromContext(r.Context()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list organization members")
+		writeError(w, http.StatusInternalServerError, "Failed to list members", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, members)
+}
+
+// AddOrganizationMember adds a user to an organization, or changes their
+// role if they're already a member. Requires the caller to be an owner or
+// admin.
+func (h *Handlers) AddOrganizationMember(w http.ResponseWriter, r *http.Request) {
+	var req types.AddOrganizationMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	orgID := middleware.GetOrgIDFromContext(r.Context())
+	if err := h.organizationMgr.AddMember(r.Context(), orgID, req.UserID, req.Role); err != nil {
+		log.Error().Err(err).Msg("Failed to add organization member")
+		writeError(w, http.StatusBadRequest, "Failed to add member", err.Error())
+		return
+	}
+
+	member := types.OrganizationMember{OrgID: orgID, UserID: req.UserID, Role: req.Role}
+	h.auditMgr.RecordAsync(r.Context(), "add_member", "organization", orgID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, member)
+
+	writeJSON(w, http.StatusCreated, member)
+}
+
+// RemoveOrganizationMember removes a member from an organization. Requires
+// the caller to be an owner or admin.
+func (h *Handlers) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request) {
+	memberID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid user ID", "")
+		return
+	}
+
+	orgID := middleware.GetOrgIDFromContext(r.Context())
+	if err := h.organizationMgr.RemoveMember(r.Context(), orgID, memberID); err != nil {
+		log.Error().Err(err).Msg("Failed to remove organization member")
+		writeError(w, http.StatusBadRequest, "Failed to remove member", err.Error())
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "remove_member", "organization", orgID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), map[string]uuid.UUID{"user_id": memberID}, nil)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListOrganizationSubscriptions lists the subscriptions owned by an
+// organization the caller belongs to.
+func (h *Handlers) ListOrganizationSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subs, err := h.db.ListSubscriptionsForOrganization(r.Context(), middleware.GetOrgIDFromContext(r.Context()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list organization subscriptions")
+		writeError(w, http.StatusInternalServerError, "Failed to list subscriptions", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, subs)
+}
+
+// CreateOrganizationSubscription creates a subscription owned by an
+// organization the caller belongs to, rejecting it if the organization has
+// reached its subscription quota. Requires the caller to be an owner or
+// admin.
+func (h *Handlers) CreateOrganizationSubscription(w http.ResponseWriter, r *http.Request) {
+	var req types.CreateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+	if err := h.subscriptionMgr.ValidateFilters(req.Filters); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid filters", err.Error())
+		return
+	}
+	if err := h.subscriptionMgr.ValidateChannelTemplates(req.Channels); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid channel configuration", err.Error())
+		return
+	}
+
+	orgID := middleware.GetOrgIDFromContext(r.Context())
+	if err := h.organizationMgr.CheckSubscriptionQuota(r.Context(), orgID); err != nil {
+		writeError(w, http.StatusPaymentRequired, "Subscription quota exceeded", err.Error())
+		return
+	}
+	if err := h.organizationMgr.CheckChannelQuota(r.Context(), orgID, len(req.Channels)); err != nil {
+		writeError(w, http.StatusPaymentRequired, "Channel quota exceeded", err.Error())
+		return
+	}
+
+	sub, apiKey, err := h.subscriptionMgr.Create(r.Context(), req, nil, &orgID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create organization subscription")
+		writeError(w, http.StatusInternalServerError, "Failed to create subscription", "")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, types.SubscriptionResponse{Subscription: *sub, APIKey: apiKey})
+}
+
+// GetOrganizationUsage returns an organization's current usage against its
+// quota tier: subscriptions owned and notifications sent this calendar
+// month, against each quota's max (0 meaning unlimited).
+func (h *Handlers) GetOrganizationUsage(w http.ResponseWriter, r *http.Request) {
+	orgID := middleware.GetOrgIDFromContext(r.Context())
+	usage, err := h.organizationMgr.Usage(r.Context(), orgID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get organization usage")
+		writeError(w, http.StatusInternalServerError, "Failed to get organization usage", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, usage)
+}
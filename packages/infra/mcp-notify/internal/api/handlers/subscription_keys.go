@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// CreateSubscriptionAPIKey mints a new, additional API key for the
+// authenticated subscription. The key value is returned once, in the
+// response body; only its hash is stored.
+func (h *Handlers) CreateSubscriptionAPIKey(w http.ResponseWriter, r *http.Request) {
+	sub := middleware.GetSubscriptionFromContext(r.Context())
+
+	var req types.CreateSubscriptionAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body", "")
+		return
+	}
+	if err := h.validate.Struct(req); err != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed", err.Error())
+		return
+	}
+
+	keyValue, err := subscription.GenerateAPIKey()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to generate subscription API key")
+		writeError(w, http.StatusInternalServerError, "Failed to create key", "")
+		return
+	}
+
+	key := &types.SubscriptionAPIKey{
+		ID:             uuid.New(),
+		SubscriptionID: sub.ID,
+		Name:           req.Name,
+		KeyHash:        subscription.HashAPIKey(keyValue),
+		KeyHint:        keyValue[len(keyValue)-4:],
+		Scopes:         req.Scopes,
+		CreatedAt:      time.Now().UTC(),
+		ExpiresAt:      req.ExpiresAt,
+	}
+
+	if err := h.db.CreateSubscriptionAPIKey(r.Context(), key); err != nil {
+		log.Error().Err(err).Msg("Failed to create subscription API key")
+		writeError(w, http.StatusInternalServerError, "Failed to create key", "")
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "create", "subscription_api_key", key.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, key)
+
+	writeJSON(w, http.StatusCreated, types.SubscriptionAPIKeyResponse{
+		SubscriptionAPIKey: *key,
+		Key:                keyValue,
+	})
+}
+
+// ListSubscriptionAPIKeys lists the authenticated subscription's API keys.
+// Key values themselves are never returned, only metadata.
+func (h *Handlers) ListSubscriptionAPIKeys(w http.ResponseWriter, r *http.Request) {
+	sub := middleware.GetSubscriptionFromContext(r.Context())
+
+	keys, err := h.db.ListSubscriptionAPIKeys(r.Context(), sub.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list subscription API keys")
+		writeError(w, http.StatusInternalServerError, "Failed to list keys", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, keys)
+}
+
+// RevokeSubscriptionAPIKey revokes one of the authenticated subscription's
+// API keys.
+func (h *Handlers) RevokeSubscriptionAPIKey(w http.ResponseWriter, r *http.Request) {
+	sub := middleware.GetSubscriptionFromContext(r.Context())
+
+	keyID, err := uuid.Parse(chi.URLParam(r, "keyID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid key ID", "")
+		return
+	}
+
+	if err := h.db.RevokeSubscriptionAPIKey(r.Context(), keyID, sub.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to revoke subscription API key")
+		writeError(w, http.StatusNotFound, "Key not found", "")
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "revoke", "subscription_api_key", keyID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, nil)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RotateSubscriptionAPIKey replaces the authenticated subscription's
+// original API key with a newly generated one, invalidating the old value
+// immediately. The new key is returned once, in the response body; only its
+// hash is stored.
+func (h *Handlers) RotateSubscriptionAPIKey(w http.ResponseWriter, r *http.Request) {
+	sub := middleware.GetSubscriptionFromContext(r.Context())
+
+	newKey, err := h.subscriptionMgr.RotateAPIKey(r.Context(), sub.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to rotate subscription API key")
+		writeError(w, http.StatusInternalServerError, "Failed to rotate key", "")
+		return
+	}
+
+	h.auditMgr.RecordAsync(r.Context(), "rotate", "subscription", sub.ID, actorType(r.Context()), actorID(r.Context()), middleware.ClientIP(r), nil, nil)
+
+	writeJSON(w, http.StatusOK, map[string]string{"api_key": newKey})
+}
@@ -4,6 +4,7 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -49,6 +50,11 @@ func APIKeyAuth(subscriptionMgr *subscription.Manager) func(next http.Handler) h
 				return
 			}
 
+			if !ipAllowed(sub.AllowedCIDRs, ClientIP(r)) {
+				writeJSONError(w, http.StatusForbidden, "IP_NOT_ALLOWED", "Request IP is not in the subscription's allowlist", nil)
+				return
+			}
+
 			// Store subscription in context
 			ctx := context.WithValue(r.Context(), SubscriptionContextKey, sub)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -106,6 +112,11 @@ func APIKeyAuthForSubscription(subscriptionMgr *subscription.Manager) func(next
 				return
 			}
 
+			if !ipAllowed(sub.AllowedCIDRs, ClientIP(r)) {
+				writeJSONError(w, http.StatusForbidden, "IP_NOT_ALLOWED", "Request IP is not in the subscription's allowlist", nil)
+				return
+			}
+
 			// Store subscription in context
 			ctx := context.WithValue(r.Context(), SubscriptionContextKey, sub)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -155,6 +166,33 @@ func OptionalAuth(subscriptionMgr *subscription.Manager) func(next http.Handler)
 	}
 }
 
+// ipAllowed reports whether ip satisfies a subscription's AllowedCIDRs. An
+// empty allowlist permits any IP, preserving the original unrestricted
+// behavior. A malformed ip or CIDR entry is treated as non-matching rather
+// than an error, so a bad allowlist entry fails closed instead of panicking.
+func ipAllowed(allowedCIDRs []string, ip string) bool {
+	if len(allowedCIDRs) == 0 {
+		return true
+	}
+
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return false
+	}
+
+	for _, cidr := range allowedCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(parsedIP) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Note: extractAPIKey and hashAPIKey are defined in middleware.go
 
 // writeJSONError writes a JSON error response.
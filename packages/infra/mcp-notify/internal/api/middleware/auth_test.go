@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPAllowed_EmptyAllowlistPermitsAny(t *testing.T) {
+	assert.True(t, ipAllowed(nil, "203.0.113.1"))
+}
+
+func TestIPAllowed_MatchingCIDR(t *testing.T) {
+	assert.True(t, ipAllowed([]string{"203.0.113.0/24"}, "203.0.113.1"))
+}
+
+func TestIPAllowed_NonMatchingCIDR(t *testing.T) {
+	assert.False(t, ipAllowed([]string{"203.0.113.0/24"}, "198.51.100.1"))
+}
+
+func TestIPAllowed_MatchesAnyOfMultipleCIDRs(t *testing.T) {
+	cidrs := []string{"10.0.0.0/8", "203.0.113.0/24"}
+	assert.True(t, ipAllowed(cidrs, "203.0.113.5"))
+	assert.False(t, ipAllowed(cidrs, "198.51.100.5"))
+}
+
+func TestIPAllowed_MalformedIPFailsClosed(t *testing.T) {
+	assert.False(t, ipAllowed([]string{"203.0.113.0/24"}, "not-an-ip"))
+}
+
+func TestIPAllowed_MalformedCIDREntrySkipped(t *testing.T) {
+	// A bad entry in the allowlist shouldn't panic or match everything; it's
+	// just ignored, so an IP still needs to match one of the well-formed ones.
+	cidrs := []string{"not-a-cidr", "203.0.113.0/24"}
+	assert.True(t, ipAllowed(cidrs, "203.0.113.5"))
+	assert.False(t, ipAllowed(cidrs, "198.51.100.5"))
+}
+
+func TestIPAllowed_SpoofedAllowlistedIPViaHeaderIsNotEnough(t *testing.T) {
+	// ipAllowed itself is header-agnostic: it only ever sees the IP
+	// getClientIP resolved from RemoteAddr, never a caller-supplied
+	// X-Forwarded-For/X-Real-IP value, so a request claiming to be an
+	// allowlisted IP via a spoofed header can't satisfy this check unless
+	// RemoteAddr itself is on the list.
+	cidrs := []string{"203.0.113.0/24"}
+	spoofedRemoteAddr := "198.51.100.9" // attacker's real address
+	assert.False(t, ipAllowed(cidrs, spoofedRemoteAddr))
+}
@@ -0,0 +1,149 @@
+// Package middleware provides HTTP middleware for the API server.
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+)
+
+const (
+	// idempotencyResponseTTL is how long a stored response is replayed for.
+	// Long enough to cover any realistic client retry backoff, short enough
+	// that the cache doesn't grow unbounded.
+	idempotencyResponseTTL = 24 * time.Hour
+
+	// idempotencyLockTTL bounds how long a key stays locked if the handler
+	// it's guarding never finishes (e.g. the process crashes mid-request),
+	// so a stuck lock can't wedge a key forever.
+	idempotencyLockTTL = 30 * time.Second
+)
+
+// idempotentResponse is what's stored in the cache under an idempotency key,
+// so a retried request can be replayed byte-for-byte.
+type idempotentResponse struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// Idempotency makes the wrapped handler safe to retry: a request carrying an
+// Idempotency-Key header has its first 2xx response cached (scoped to the
+// authenticated subscription or user, so one caller's key can't collide with
+// another's) and replayed verbatim on any later request with the same key,
+// instead of running the handler again. A second request that arrives while
+// the first is still in flight gets 409 rather than racing it. Requests
+// without the header are unaffected.
+//
+// This must run after any auth middleware that populates the subscription or
+// user context, so retries can be scoped per caller.
+func Idempotency(cache db.Cache) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimSpace(r.Header.Get("Idempotency-Key"))
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cacheKey := fmt.Sprintf("mcp:idempotency:%s:%s", idempotencyScope(r.Context()), key)
+
+			if cached, err := cache.Get(r.Context(), cacheKey); err != nil {
+				log.Error().Err(err).Str("key", cacheKey).Msg("Failed to look up idempotency key")
+			} else if cached != nil {
+				replayIdempotentResponse(w, cached)
+				return
+			}
+
+			lockKey := cacheKey + ":lock"
+			acquired, err := cache.SetWithNX(r.Context(), lockKey, []byte("1"), idempotencyLockTTL)
+			if err != nil {
+				log.Error().Err(err).Str("key", cacheKey).Msg("Failed to acquire idempotency lock")
+				next.ServeHTTP(w, r) // Fail open on cache error.
+				return
+			}
+			if !acquired {
+				writeJSONError(w, http.StatusConflict, "REQUEST_IN_PROGRESS",
+					"A request with this idempotency key is already in progress", nil)
+				return
+			}
+			defer cache.Delete(r.Context(), lockKey)
+
+			rec := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			if rec.status < 200 || rec.status >= 300 {
+				return
+			}
+
+			stored, err := json.Marshal(idempotentResponse{Status: rec.status, Body: rec.body.Bytes()})
+			if err != nil {
+				log.Error().Err(err).Str("key", cacheKey).Msg("Failed to marshal idempotent response")
+				return
+			}
+			if err := cache.Set(r.Context(), cacheKey, stored, idempotencyResponseTTL); err != nil {
+				log.Error().Err(err).Str("key", cacheKey).Msg("Failed to store idempotent response")
+			}
+		})
+	}
+}
+
+// idempotencyScope identifies the caller an idempotency key belongs to, so
+// two different subscriptions (or an authenticated user and an anonymous
+// caller) can't collide by coincidentally reusing the same key value.
+func idempotencyScope(ctx context.Context) string {
+	if sub := GetSubscriptionFromContext(ctx); sub != nil {
+		return "sub:" + sub.ID.String()
+	}
+	if user := GetUserFromContext(ctx); user != nil {
+		return "user:" + user.ID.String()
+	}
+	return "anonymous"
+}
+
+// replayIdempotentResponse writes back a previously stored response exactly
+// as it was first returned.
+func replayIdempotentResponse(w http.ResponseWriter, cached []byte) {
+	var resp idempotentResponse
+	if err := json.Unmarshal(cached, &resp); err != nil {
+		log.Error().Err(err).Msg("Failed to unmarshal cached idempotent response")
+		writeJSONError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to replay idempotent response", nil)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Idempotency-Replayed", "true")
+	w.WriteHeader(resp.Status)
+	w.Write(resp.Body)
+}
+
+// idempotencyRecorder captures the response written by the handler so it can
+// be stored for replay, while still passing it through to the real
+// http.ResponseWriter.
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *idempotencyRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.wroteHeader = true
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *idempotencyRecorder) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
@@ -4,6 +4,7 @@ package middleware
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"net/http"
 	"strings"
@@ -24,6 +25,15 @@ type contextKey string
 const (
 	// SubscriptionContextKey is the context key for the authenticated subscription
 	SubscriptionContextKey contextKey = "subscription"
+
+	// SubscriptionAPIKeyContextKey is the context key for the
+	// *types.SubscriptionAPIKey used to authenticate the request, set by
+	// AuthenticateSubscription. Nil means the subscription's original,
+	// unrestricted API key was used instead of a scoped one.
+	SubscriptionAPIKeyContextKey contextKey = "subscriptionAPIKey"
+
+	// WatchlistContextKey is the context key for the authenticated watchlist.
+	WatchlistContextKey contextKey = "watchlist"
 )
 
 // Logger is a zerolog-based request logger middleware.
@@ -89,19 +99,127 @@ func AuthenticateSubscription(database db.Database) func(http.Handler) http.Hand
 				return
 			}
 
-			// Verify API key matches
+			if !ipAllowed(sub.AllowedCIDRs, ClientIP(r)) {
+				writeJSONError(w, http.StatusForbidden, "IP_NOT_ALLOWED", "Request IP is not in the subscription's allowlist", nil)
+				return
+			}
+
+			// The subscription's original API key grants full, unrestricted
+			// access. Failing that, fall back to one of its additional,
+			// scoped keys.
+			var usedKey *types.SubscriptionAPIKey
 			if sub.APIKey != apiKeyHash {
+				key, err := database.GetSubscriptionAPIKeyByHash(r.Context(), apiKeyHash)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to look up subscription API key")
+					http.Error(w, `{"error": "internal server error"}`, http.StatusInternalServerError)
+					return
+				}
+				if key == nil || key.SubscriptionID != subscriptionID || !key.Active() {
+					http.Error(w, `{"error": "invalid API key"}`, http.StatusUnauthorized)
+					return
+				}
+
+				if err := database.UpdateSubscriptionAPIKeyLastUsed(r.Context(), key.ID, time.Now().UTC()); err != nil {
+					log.Warn().Err(err).Msg("Failed to record subscription API key use")
+				}
+				usedKey = key
+			}
+
+			// Store subscription and the key used to authenticate in context
+			ctx := context.WithValue(r.Context(), SubscriptionContextKey, sub)
+			ctx = context.WithValue(ctx, SubscriptionAPIKeyContextKey, usedKey)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AuthenticateWatchlist is a middleware that authenticates requests using
+// the API key returned when the watchlist was created (see
+// AuthenticateSubscription for the equivalent on subscriptions). Unlike
+// subscriptions, a watchlist has no URL-supplied ID: the API key alone
+// identifies it.
+func AuthenticateWatchlist(database db.Database) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			apiKey := extractAPIKey(r)
+			if apiKey == "" {
+				http.Error(w, `{"error": "API key required"}`, http.StatusUnauthorized)
+				return
+			}
+
+			watchlist, err := database.GetWatchlistByAPIKeyHash(r.Context(), hashAPIKey(apiKey))
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to look up watchlist")
+				http.Error(w, `{"error": "internal server error"}`, http.StatusInternalServerError)
+				return
+			}
+			if watchlist == nil {
 				http.Error(w, `{"error": "invalid API key"}`, http.StatusUnauthorized)
 				return
 			}
 
-			// Store subscription in context
-			ctx := context.WithValue(r.Context(), SubscriptionContextKey, sub)
+			ctx := context.WithValue(r.Context(), WatchlistContextKey, watchlist)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// GetWatchlistFromContext retrieves the authenticated watchlist from the
+// context, set by AuthenticateWatchlist.
+func GetWatchlistFromContext(ctx context.Context) *types.Watchlist {
+	watchlist, _ := ctx.Value(WatchlistContextKey).(*types.Watchlist)
+	return watchlist
+}
+
+// GetSubscriptionAPIKeyFromContext retrieves the *types.SubscriptionAPIKey
+// used to authenticate the request, or nil if the subscription's original,
+// unrestricted API key was used instead.
+func GetSubscriptionAPIKeyFromContext(ctx context.Context) *types.SubscriptionAPIKey {
+	key, _ := ctx.Value(SubscriptionAPIKeyContextKey).(*types.SubscriptionAPIKey)
+	return key
+}
+
+// RequireScope requires the API key that authenticated the request (see
+// AuthenticateSubscription) to carry scope. A request authenticated with the
+// subscription's original, unrestricted API key always satisfies this.
+func RequireScope(scope string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := GetSubscriptionAPIKeyFromContext(r.Context())
+			if key != nil && !key.HasScope(scope) {
+				writeJSONError(w, http.StatusForbidden, "FORBIDDEN", "API key lacks required scope: "+scope, nil)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireAdminKey requires the request to carry adminKey (via the same
+// Authorization/X-API-Key headers as subscription auth) in the Authorization
+// or X-API-Key header. Used for operator-only endpoints that have no single
+// owning user or organization, such as the audit log. If adminKey is empty
+// the endpoint is disabled entirely, since an empty key must never match.
+func RequireAdminKey(adminKey string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if adminKey == "" {
+				writeJSONError(w, http.StatusNotFound, "NOT_FOUND", "not found", nil)
+				return
+			}
+
+			key := extractAPIKey(r)
+			if key == "" || subtle.ConstantTimeCompare([]byte(key), []byte(adminKey)) != 1 {
+				writeJSONError(w, http.StatusUnauthorized, "UNAUTHORIZED", "invalid admin API key", nil)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // GetSubscriptionFromContext retrieves the authenticated subscription from the context.
 func GetSubscriptionFromContext(ctx context.Context) *types.Subscription {
 	sub, ok := ctx.Value(SubscriptionContextKey).(*types.Subscription)
@@ -142,9 +260,9 @@ func hashAPIKey(key string) string {
 
 // RateLimiter is a middleware that limits requests per subscription.
 type RateLimiter struct {
-	cache     db.Cache
-	limit     int64
-	window    time.Duration
+	cache  db.Cache
+	limit  int64
+	window time.Duration
 }
 
 // NewRateLimiter creates a new rate limiter middleware.
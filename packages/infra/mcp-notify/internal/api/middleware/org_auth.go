@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/internal/organization"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// OrgIDContextKey is the context key for the {orgID} URL parameter, set by
+// RequireOrgRole once membership has been checked, so handlers don't need to
+// re-parse and re-validate it.
+const OrgIDContextKey contextKey = "orgID"
+
+// RequireOrgRole requires the request's authenticated user (see UserAuth) to
+// be a member of the {orgID} URL parameter's organization with at least
+// role. It must be chained after UserAuth, which is what populates the user
+// this checks membership for.
+func RequireOrgRole(orgMgr *organization.Manager, role types.OrgRole) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user := GetUserFromContext(r.Context())
+			if user == nil {
+				writeJSONError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "login or a personal access token is required", nil)
+				return
+			}
+
+			orgID, err := uuid.Parse(chi.URLParam(r, "orgID"))
+			if err != nil {
+				writeJSONError(w, http.StatusBadRequest, "INVALID_ORG_ID", "invalid organization ID", nil)
+				return
+			}
+
+			memberRole, isMember, err := orgMgr.RoleOf(r.Context(), orgID, user.ID)
+			if err != nil {
+				writeJSONError(w, http.StatusInternalServerError, "INTERNAL", "failed to check organization membership", nil)
+				return
+			}
+			if !isMember || !memberRole.Meets(role) {
+				writeJSONError(w, http.StatusForbidden, "FORBIDDEN", "insufficient organization role", nil)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), OrgIDContextKey, orgID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// GetOrgIDFromContext retrieves the {orgID} URL parameter validated by
+// RequireOrgRole, or uuid.Nil if it was not applied.
+func GetOrgIDFromContext(ctx context.Context) uuid.UUID {
+	orgID, _ := ctx.Value(OrgIDContextKey).(uuid.UUID)
+	return orgID
+}
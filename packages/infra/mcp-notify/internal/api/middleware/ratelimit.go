@@ -9,6 +9,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/nirholas/mcp-notify/internal/config"
 	"github.com/nirholas/mcp-notify/internal/db"
 )
 
@@ -177,26 +178,102 @@ func RateLimitByEndpoint(cache db.Cache, limit int64, window time.Duration) func
 	}
 }
 
-// getClientIP extracts the client IP address from the request.
-// It checks X-Forwarded-For, X-Real-IP headers, and falls back to RemoteAddr.
-func getClientIP(r *http.Request) string {
-	// Check X-Forwarded-For header (may contain multiple IPs)
-	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
-		// Take the first IP in the list
-		for i := 0; i < len(xff); i++ {
-			if xff[i] == ',' {
-				return xff[:i]
+// SlidingWindow creates a middleware enforcing getCfg's sliding-window rate
+// limit policy against the Redis-backed cache, so the limit holds across
+// replicas instead of per-instance. getCfg is called on every request rather
+// than once at wiring time, so a config reload (see config.Watch) takes
+// effect without restarting the server. An authenticated request (a
+// subscription already present in context, via AuthenticateSubscription or
+// APIKeyAuth) is keyed and budgeted per subscription, checking
+// cfg.APIKeys[subscriptionID] before falling back to cfg.Routes[routeName]
+// then cfg.Default. An unauthenticated request is keyed by client IP and
+// budgeted from cfg.Routes[routeName] then cfg.Default. routeName is chosen
+// by the caller wiring the middleware onto a route (see server.go) rather
+// than derived from the URL, so it stays stable across path parameter
+// changes. The whole middleware is a no-op passthrough when cfg.Enabled is
+// false.
+func SlidingWindow(cache db.Cache, getCfg func() config.RateLimitConfig, routeName string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg := getCfg()
+			if !cfg.Enabled {
+				next.ServeHTTP(w, r)
+				return
 			}
-		}
-		return xff
-	}
 
-	// Check X-Real-IP header
-	if xri := r.Header.Get("X-Real-IP"); xri != "" {
-		return xri
+			policy := cfg.Default
+			if p, ok := cfg.Routes[routeName]; ok {
+				policy = p
+			}
+
+			var key string
+			if sub := GetSubscriptionFromContext(r.Context()); sub != nil {
+				key = "sub:" + sub.ID.String()
+				if p, ok := cfg.APIKeys[sub.ID.String()]; ok {
+					policy = p
+				}
+			} else {
+				key = "ip:" + getClientIP(r)
+			}
+
+			if policy.Limit <= 0 || policy.Window <= 0 {
+				// No usable policy configured for this request; don't limit it.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, remaining, resetAt, err := cache.SlidingWindowAllow(r.Context(), key, policy.Limit, policy.Window)
+			if err != nil {
+				log.Error().Err(err).Str("key", key).Msg("Failed to check rate limit")
+				next.ServeHTTP(w, r) // Fail open on cache error.
+				return
+			}
+
+			w.Header().Set("X-RateLimit-Limit", strconv.FormatInt(policy.Limit, 10))
+			w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+
+			if !allowed {
+				retryAfter := int(time.Until(resetAt).Seconds())
+				if retryAfter < 0 {
+					retryAfter = 0
+				}
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				writeJSONError(w, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED",
+					fmt.Sprintf("Rate limit exceeded. Maximum %d requests per %s", policy.Limit, policy.Window),
+					map[string]string{
+						"limit":       strconv.FormatInt(policy.Limit, 10),
+						"window":      policy.Window.String(),
+						"retry_after": strconv.Itoa(retryAfter),
+					})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
 	}
+}
 
-	// Fall back to RemoteAddr (remove port if present)
+// ClientIP extracts the client IP address from the request.
+// It uses RemoteAddr, the address the TCP connection actually came from.
+func ClientIP(r *http.Request) string {
+	return getClientIP(r)
+}
+
+// getClientIP extracts the client IP address from the request.
+//
+// It intentionally does NOT trust X-Forwarded-For or X-Real-IP: without a
+// configured set of trusted reverse proxies, those headers are just
+// caller-supplied strings, and any client can set them to whatever they
+// like — including an allowlisted IP, fully bypassing rate limiting, IP
+// allowlisting (see ipAllowed) and audit log attribution. RemoteAddr is the
+// address the TCP connection actually came from, so it can't be spoofed by
+// the request itself. When mcp-notify sits behind a reverse proxy,
+// RemoteAddr will be the proxy's address rather than the original client's;
+// there is no trusted-proxy configuration yet to unwrap X-Forwarded-For
+// safely in that setup.
+func getClientIP(r *http.Request) string {
+	// Remove port if present
 	addr := r.RemoteAddr
 	for i := len(addr) - 1; i >= 0; i-- {
 		if addr[i] == ':' {
@@ -208,10 +285,10 @@ func getClientIP(r *http.Request) string {
 
 // BurstRateLimiter provides a token bucket rate limiter for more advanced rate limiting.
 type BurstRateLimiter struct {
-	cache       db.Cache
-	rate        int64         // Tokens added per window
-	burst       int64         // Maximum tokens (bucket size)
-	window      time.Duration // Time window for adding tokens
+	cache  db.Cache
+	rate   int64         // Tokens added per window
+	burst  int64         // Maximum tokens (bucket size)
+	window time.Duration // Time window for adding tokens
 }
 
 // NewBurstRateLimiter creates a new burst rate limiter.
@@ -236,7 +313,7 @@ func (rl *BurstRateLimiter) Allow(r *http.Request) (bool, error) {
 	}
 
 	key := fmt.Sprintf("burst:tokens:%s", identifier)
-	
+
 	// For simplicity, use the basic rate limit
 	// A full token bucket implementation would need Lua scripting in Redis
 	count, err := rl.cache.IncrementRateLimit(r.Context(), key, rl.window)
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClientIP_StripsPort(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.1:54321"
+
+	assert.Equal(t, "203.0.113.1", getClientIP(r))
+}
+
+func TestGetClientIP_NoPort(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.1"
+
+	assert.Equal(t, "203.0.113.1", getClientIP(r))
+}
+
+func TestGetClientIP_IgnoresForwardedHeaders(t *testing.T) {
+	// getClientIP must never trust caller-supplied headers: without a
+	// configured trusted-proxy boundary, X-Forwarded-For/X-Real-IP are just
+	// attacker-controlled strings that would otherwise let a spoofed value
+	// bypass IP allowlisting, rate limiting, and audit log attribution.
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "198.51.100.9:12345"
+	r.Header.Set("X-Forwarded-For", "203.0.113.1")
+	r.Header.Set("X-Real-IP", "203.0.113.1")
+
+	assert.Equal(t, "198.51.100.9", getClientIP(r))
+}
+
+func TestClientIP_MatchesGetClientIP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.1:54321"
+
+	assert.Equal(t, getClientIP(r), ClientIP(r))
+}
@@ -0,0 +1,108 @@
+// Package middleware provides HTTP middleware for the API server.
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/auth"
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// UserContextKey is the context key for the authenticated user (see
+// UserAuth), set alongside SubscriptionContextKey for API-key auth.
+const UserContextKey contextKey = "user"
+
+// UserAuth authenticates requests as a logged-in user, either via a browser
+// session cookie or a personal access token in the Authorization header.
+// Unlike APIKeyAuth, it always requires authentication.
+func UserAuth(database db.Database, sessions *auth.SessionManager) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, err := authenticateUser(r, database, sessions)
+			if err != nil {
+				writeJSONError(w, http.StatusUnauthorized, "UNAUTHENTICATED", err.Error(), nil)
+				return
+			}
+			if user == nil {
+				writeJSONError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "login or a personal access token is required", nil)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), UserContextKey, user)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// OptionalUserAuth attempts to authenticate the request as a logged-in user
+// (session cookie or personal access token) but allows the request through
+// either way, mirroring OptionalAuth's relationship to APIKeyAuth. Handlers
+// that support both anonymous and user-owned flows (e.g. creating a
+// subscription) use this to look up the user without requiring one.
+func OptionalUserAuth(database db.Database, sessions *auth.SessionManager) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, err := authenticateUser(r, database, sessions)
+			if err != nil {
+				log.Debug().Err(err).Msg("Optional user auth: failed to authenticate")
+				next.ServeHTTP(w, r)
+				return
+			}
+			if user == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), UserContextKey, user)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// authenticateUser resolves the user behind a request from its session
+// cookie or its personal access token, returning (nil, nil) if neither is
+// present.
+func authenticateUser(r *http.Request, database db.Database, sessions *auth.SessionManager) (*types.User, error) {
+	if sessions == nil {
+		return nil, nil
+	}
+
+	if cookie, err := r.Cookie(auth.SessionCookieName); err == nil {
+		userID, err := sessions.Verify(cookie.Value)
+		if err != nil {
+			return nil, err
+		}
+		return database.GetUserByID(r.Context(), userID)
+	}
+
+	if token := extractAPIKey(r); token != "" && auth.LooksLikePersonalAccessToken(token) {
+		pat, err := database.GetPersonalAccessTokenByHash(r.Context(), auth.HashPersonalAccessToken(token))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to validate personal access token")
+			return nil, err
+		}
+		if pat == nil || !pat.Active() {
+			return nil, nil
+		}
+
+		if err := database.UpdatePersonalAccessTokenLastUsed(r.Context(), pat.ID, time.Now().UTC()); err != nil {
+			log.Warn().Err(err).Msg("Failed to record personal access token use")
+		}
+
+		return database.GetUserByID(r.Context(), pat.UserID)
+	}
+
+	return nil, nil
+}
+
+// GetUserFromContext retrieves the authenticated user from the request
+// context, or nil if UserAuth was not applied or found no user.
+func GetUserFromContext(ctx context.Context) *types.User {
+	user, _ := ctx.Value(UserContextKey).(*types.User)
+	return user
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/nirholas/mcp-notify/internal/subscription"
+)
+
+// openAPIDoc is a minimal subset of the OpenAPI document, just enough to
+// list the (method, path) pairs it declares.
+type openAPIDoc struct {
+	Paths map[string]map[string]any `yaml:"paths"`
+}
+
+var openAPIMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete,
+}
+
+// normalizeRoute collapses chi's {paramName} and OpenAPI's {paramName} path
+// parameters down to a bare {} so routes can be compared positionally
+// without requiring identical parameter names, and strips the /api/v1
+// prefix that the OpenAPI servers block already encodes as the base URL.
+func normalizeRoute(path string) string {
+	path = strings.TrimPrefix(path, "/api/v1")
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if path == "" {
+		path = "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			segments[i] = "{}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// TestOpenAPISpecCoversRoutes fails if api/openapi.yaml and the chi router
+// wired up in setupRouter have diverged: every route the server exposes
+// must be documented, and every documented route must actually exist.
+func TestOpenAPISpecCoversRoutes(t *testing.T) {
+	specBytes, err := os.ReadFile("../../api/openapi.yaml")
+	require.NoError(t, err)
+
+	var doc openAPIDoc
+	require.NoError(t, yaml.Unmarshal(specBytes, &doc))
+
+	specRoutes := make(map[string]bool)
+	for path, methods := range doc.Paths {
+		for method := range methods {
+			upper := strings.ToUpper(method)
+			if !contains(openAPIMethods, upper) {
+				continue // parameters, description, etc. are not HTTP methods
+			}
+			specRoutes[upper+" "+normalizeRoute(path)] = true
+		}
+	}
+
+	db := NewMockDatabase()
+	cache := NewMockCache()
+	subscriptionMgr := subscription.NewManager(db, cache)
+	srv := NewServer(Config{
+		Database:        db,
+		Cache:           cache,
+		SubscriptionMgr: subscriptionMgr,
+	})
+
+	routerRoutes := make(map[string]bool)
+	err = chi.Walk(srv.Router(), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		routerRoutes[method+" "+normalizeRoute(route)] = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	for route := range routerRoutes {
+		if !specRoutes[route] {
+			t.Errorf("route %q is registered in setupRouter but missing from api/openapi.yaml", route)
+		}
+	}
+
+	for route := range specRoutes {
+		if !routerRoutes[route] {
+			t.Errorf("route %q is documented in api/openapi.yaml but not registered in setupRouter", route)
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
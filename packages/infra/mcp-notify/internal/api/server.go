@@ -5,20 +5,30 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"github.com/go-chi/httprate"
 	"github.com/rs/zerolog/log"
 
 	"github.com/nirholas/mcp-notify/internal/api/handlers"
 	apimiddleware "github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/internal/audit"
+	"github.com/nirholas/mcp-notify/internal/auth"
 	"github.com/nirholas/mcp-notify/internal/config"
 	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/discordbot"
+	"github.com/nirholas/mcp-notify/internal/notifier"
+	"github.com/nirholas/mcp-notify/internal/organization"
+	"github.com/nirholas/mcp-notify/internal/poller"
 	"github.com/nirholas/mcp-notify/internal/registry"
+	"github.com/nirholas/mcp-notify/internal/scheduler"
 	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/internal/telegrambot"
+	"github.com/nirholas/mcp-notify/internal/transparency"
+	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
 // Config holds API server configuration.
@@ -28,10 +38,70 @@ type Config struct {
 	Database        db.Database
 	Cache           db.Cache
 	SubscriptionMgr *subscription.Manager
+	OrganizationMgr *organization.Manager
+	AuditMgr        *audit.Manager
 	RegistryClient  *registry.Client
+	RegistryURL     string
 	CORS            config.CORSConfig
 	Version         string
 	OpenAPISpec     []byte
+	PollInterval    time.Duration
+	Maintenance     *scheduler.MaintenanceScheduler
+	TransparencyLog *transparency.Log
+
+	// AdminAPIKey gates operator-only endpoints, such as the audit log, that
+	// have no single owning user or organization to authorize against.
+	// Empty disables those endpoints entirely.
+	AdminAPIKey string
+
+	// RateLimit is the sliding-window rate limit policy applied to the API.
+	// Disabled (the zero value) means unrestricted, same as before this was
+	// configurable.
+	RateLimit config.RateLimitConfig
+
+	// OIDCProvider and Sessions enable browser login. Both nil disables the
+	// feature entirely; subscriptions then continue to work exactly as
+	// before, standing alone behind their own API key.
+	OIDCProvider     *auth.Provider
+	OIDCProviderName string
+	Sessions         *auth.SessionManager
+
+	// FeedSigningKey, if set, is used to HMAC-SHA256 sign rendered RSS/Atom/
+	// JSON feed bodies. Empty disables signing.
+	FeedSigningKey string
+
+	// Dispatcher renders and sends channel notifications. Required for
+	// POST /api/v1/notifications/preview.
+	Dispatcher *notifier.Dispatcher
+
+	// SlackSigningSecret verifies inbound Block Kit button clicks on
+	// POST /api/v1/integrations/slack/interactions came from Slack. Empty
+	// rejects all of them.
+	SlackSigningSecret string
+
+	// DiscordBot handles "/mcp" slash command interactions on
+	// POST /api/v1/integrations/discord/interactions. May be nil if the
+	// feature is disabled, in which case that endpoint rejects all requests.
+	DiscordBot *discordbot.Bot
+
+	// TelegramBot handles "/start", "/subscribe", "/mute", and "/recent"
+	// commands on POST /api/v1/integrations/telegram/interactions. May be
+	// nil if the feature is disabled, in which case that endpoint rejects
+	// all requests.
+	TelegramBot *telegrambot.Bot
+
+	// EmailBounceWebhookSecret authenticates the SES and SendGrid bounce
+	// webhooks via a "secret" query parameter. Empty rejects both.
+	EmailBounceWebhookSecret string
+
+	// MailgunSigningKey authenticates the Mailgun bounce webhook via
+	// Mailgun's own HMAC request signature. Empty rejects all requests.
+	MailgunSigningKey string
+
+	// Poller, when set, lets GET /api/v1/stats and GET /health fall back to
+	// the poller's in-memory snapshot when the database is unreachable
+	// instead of failing outright. See internal/poller's degraded mode.
+	Poller *poller.Poller
 }
 
 // Server is the HTTP API server.
@@ -40,19 +110,42 @@ type Server struct {
 	router     chi.Router
 	httpServer *http.Server
 	handlers   *handlers.Handlers
+
+	rateLimitMu sync.RWMutex
+	rateLimit   config.RateLimitConfig
 }
 
 // NewServer creates a new API server.
 func NewServer(cfg Config) *Server {
 	s := &Server{
-		config: cfg,
+		config:    cfg,
+		rateLimit: cfg.RateLimit,
 		handlers: handlers.New(handlers.Config{
-			Database:        cfg.Database,
-			Cache:           cfg.Cache,
-			SubscriptionMgr: cfg.SubscriptionMgr,
-			RegistryClient:  cfg.RegistryClient,
-			Version:         cfg.Version,
-			OpenAPISpec:     cfg.OpenAPISpec,
+			Database:           cfg.Database,
+			Cache:              cfg.Cache,
+			SubscriptionMgr:    cfg.SubscriptionMgr,
+			OrganizationMgr:    cfg.OrganizationMgr,
+			AuditMgr:           cfg.AuditMgr,
+			RegistryClient:     cfg.RegistryClient,
+			RegistryURL:        cfg.RegistryURL,
+			Version:            cfg.Version,
+			OpenAPISpec:        cfg.OpenAPISpec,
+			PollInterval:       cfg.PollInterval,
+			Maintenance:        cfg.Maintenance,
+			TransparencyLog:    cfg.TransparencyLog,
+			OIDCProvider:       cfg.OIDCProvider,
+			OIDCProviderName:   cfg.OIDCProviderName,
+			Sessions:           cfg.Sessions,
+			FeedSigningKey:     cfg.FeedSigningKey,
+			Dispatcher:         cfg.Dispatcher,
+			SlackSigningSecret: cfg.SlackSigningSecret,
+			DiscordBot:         cfg.DiscordBot,
+			TelegramBot:        cfg.TelegramBot,
+
+			EmailBounceWebhookSecret: cfg.EmailBounceWebhookSecret,
+			MailgunSigningKey:        cfg.MailgunSigningKey,
+
+			Poller: cfg.Poller,
 		}),
 	}
 
@@ -60,6 +153,23 @@ func NewServer(cfg Config) *Server {
 	return s
 }
 
+// UpdateRateLimit swaps the sliding-window rate limit policy enforced by the
+// already-running router, so a config reload (see config.Watch) can tighten
+// or loosen limits without restarting the server.
+func (s *Server) UpdateRateLimit(cfg config.RateLimitConfig) {
+	s.rateLimitMu.Lock()
+	s.rateLimit = cfg
+	s.rateLimitMu.Unlock()
+}
+
+// getRateLimit returns the currently active rate limit policy, read by the
+// SlidingWindow middleware on every request.
+func (s *Server) getRateLimit() config.RateLimitConfig {
+	s.rateLimitMu.RLock()
+	defer s.rateLimitMu.RUnlock()
+	return s.rateLimit
+}
+
 func (s *Server) setupRouter() {
 	r := chi.NewRouter()
 
@@ -80,48 +190,166 @@ func (s *Server) setupRouter() {
 		MaxAge:           300,
 	}))
 
-	// Rate limiting (100 requests per minute per IP)
-	r.Use(httprate.LimitByIP(100, time.Minute))
+	// Rate limiting, sliding-window and Redis-backed so the limit holds
+	// across replicas instead of resetting per-instance.
+	r.Use(apimiddleware.SlidingWindow(s.config.Cache, s.getRateLimit, "global"))
 
 	// Health endpoints (no auth required)
 	r.Get("/health", s.handlers.Health)
 	r.Get("/ready", s.handlers.Ready)
 
+	// User login (OIDC), independent of /api/v1 since it deals in browser
+	// redirects and cookies rather than JSON. Registered even when disabled
+	// so the handlers can report a clean 404 instead of chi's default.
+	r.Route("/auth", func(r chi.Router) {
+		r.Get("/login", s.handlers.Login)
+		r.Get("/callback", s.handlers.Callback)
+		r.Post("/logout", s.handlers.Logout)
+	})
+
+	// Email unsubscribe/preferences link, independent of /api/v1 since it's
+	// opened directly by mail clients and browsers rather than called as an
+	// API. Authenticated by the link's own signed token, not an API key.
+	r.Get("/unsubscribe", s.handlers.Unsubscribe)
+	r.Post("/unsubscribe", s.handlers.Unsubscribe)
+
 	// API v1 routes
 	r.Route("/api/v1", func(r chi.Router) {
 		// Public endpoints
 		r.Get("/stats", s.handlers.GetStats)
+		r.Get("/maintenance", s.handlers.MaintenanceStatus)
 
 		// Subscriptions
 		r.Route("/subscriptions", func(r chi.Router) {
-			r.Post("/", s.handlers.CreateSubscription)
+			r.With(apimiddleware.OptionalUserAuth(s.config.Database, s.config.Sessions), apimiddleware.Idempotency(s.config.Cache)).Post("/", s.handlers.CreateSubscription)
 			r.Get("/", s.handlers.ListSubscriptions)
 
+			// Bulk export/import of every subscription's configuration, for
+			// migrating between environments. Like the audit log, there's no
+			// single owning user or organization to authorize against, so
+			// this is gated by the operator admin key instead.
+			r.With(apimiddleware.RequireAdminKey(s.config.AdminAPIKey)).Get("/export", s.handlers.ExportSubscriptions)
+			r.With(apimiddleware.RequireAdminKey(s.config.AdminAPIKey), apimiddleware.Idempotency(s.config.Cache)).Post("/import", s.handlers.ImportSubscriptions)
+
+			// Declarative, GitOps-style reconciliation: converge live
+			// subscriptions to match a submitted bundle instead of only
+			// ever adding to them.
+			r.With(apimiddleware.RequireAdminKey(s.config.AdminAPIKey)).Post("/apply", s.handlers.ApplySubscriptions)
+
 			r.Route("/{subscriptionID}", func(r chi.Router) {
 				r.Use(apimiddleware.AuthenticateSubscription(s.config.Database))
-				r.Get("/", s.handlers.GetSubscription)
-				r.Put("/", s.handlers.UpdateSubscription)
-				r.Delete("/", s.handlers.DeleteSubscription)
-				r.Post("/pause", s.handlers.PauseSubscription)
-				r.Post("/resume", s.handlers.ResumeSubscription)
-				r.Post("/test", s.handlers.TestSubscription)
-				r.Get("/notifications", s.handlers.GetSubscriptionNotifications)
+				r.Use(apimiddleware.SlidingWindow(s.config.Cache, s.getRateLimit, "subscription"))
+				r.With(apimiddleware.RequireScope(types.ScopeReadChanges)).Get("/", s.handlers.GetSubscription)
+				r.With(apimiddleware.RequireScope(types.ScopeWriteSubscriptions)).Put("/", s.handlers.UpdateSubscription)
+				r.With(apimiddleware.RequireScope(types.ScopeWriteSubscriptions)).Delete("/", s.handlers.DeleteSubscription)
+				r.With(apimiddleware.RequireScope(types.ScopeWriteSubscriptions)).Post("/pause", s.handlers.PauseSubscription)
+				r.With(apimiddleware.RequireScope(types.ScopeWriteSubscriptions)).Post("/resume", s.handlers.ResumeSubscription)
+				r.With(apimiddleware.RequireScope(types.ScopeAdmin)).Post("/rotate", s.handlers.RotateSubscriptionAPIKey)
+				r.With(apimiddleware.RequireScope(types.ScopeWriteSubscriptions)).Post("/test", s.handlers.TestSubscription)
+				r.With(apimiddleware.RequireScope(types.ScopeReadChanges)).Get("/health", s.handlers.GetSubscriptionHealth)
+				r.With(apimiddleware.RequireScope(types.ScopeReadChanges)).Get("/notifications", s.handlers.GetSubscriptionNotifications)
+				r.With(apimiddleware.RequireScope(types.ScopeReadChanges)).Get("/notifications/search", s.handlers.SearchSubscriptionNotifications)
+				r.With(apimiddleware.RequireScope(types.ScopeReadChanges)).Get("/analytics", s.handlers.GetSubscriptionAnalytics)
+
+				// Additional, scoped API keys for this subscription, beyond
+				// its original fully-privileged one. Managing keys requires
+				// ScopeAdmin, so a restricted key cannot mint itself a
+				// broader one.
+				r.Route("/keys", func(r chi.Router) {
+					r.With(apimiddleware.RequireScope(types.ScopeAdmin)).Post("/", s.handlers.CreateSubscriptionAPIKey)
+					r.With(apimiddleware.RequireScope(types.ScopeAdmin)).Get("/", s.handlers.ListSubscriptionAPIKeys)
+					r.With(apimiddleware.RequireScope(types.ScopeAdmin)).Delete("/{keyID}", s.handlers.RevokeSubscriptionAPIKey)
+				})
+
+				// Re-enables a channel auto-disabled after too many
+				// consecutive delivery failures.
+				r.Route("/channels/{channelID}", func(r chi.Router) {
+					r.With(apimiddleware.RequireScope(types.ScopeWriteSubscriptions)).Post("/enable", s.handlers.EnableChannel)
+				})
+			})
+		})
+
+		// Watchlists: a lightweight, API-key-scoped list of server names
+		// distinct from a full subscription, with no filters, channels, or
+		// delivery behavior.
+		r.Route("/watchlist", func(r chi.Router) {
+			r.With(apimiddleware.Idempotency(s.config.Cache)).Post("/", s.handlers.CreateWatchlist)
+
+			r.Group(func(r chi.Router) {
+				r.Use(apimiddleware.AuthenticateWatchlist(s.config.Database))
+				r.Get("/", s.handlers.GetWatchlistStatus)
+				r.Put("/", s.handlers.UpdateWatchlist)
+			})
+		})
+
+		// Logged-in user account: their profile, their subscriptions, and
+		// the personal access tokens they use in place of one API key per
+		// subscription.
+		r.Route("/me", func(r chi.Router) {
+			r.Use(apimiddleware.UserAuth(s.config.Database, s.config.Sessions))
+			r.Get("/", s.handlers.Me)
+			r.Get("/subscriptions", s.handlers.MySubscriptions)
+
+			r.Route("/tokens", func(r chi.Router) {
+				r.Post("/", s.handlers.CreatePersonalAccessToken)
+				r.Get("/", s.handlers.ListPersonalAccessTokens)
+				r.Delete("/{tokenID}", s.handlers.RevokePersonalAccessToken)
+			})
+		})
+
+		// Organizations: subscriptions owned by a team instead of a single
+		// user, with member roles (owner, admin, viewer) and a per-org
+		// subscription quota.
+		r.Route("/orgs", func(r chi.Router) {
+			r.Use(apimiddleware.UserAuth(s.config.Database, s.config.Sessions))
+
+			r.With(apimiddleware.Idempotency(s.config.Cache)).Post("/", s.handlers.CreateOrganization)
+			r.Get("/", s.handlers.ListMyOrganizations)
+
+			r.Route("/{orgID}", func(r chi.Router) {
+				r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleViewer)).Get("/", s.handlers.GetOrganization)
+				r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleAdmin)).Put("/", s.handlers.UpdateOrganization)
+				r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleOwner)).Delete("/", s.handlers.DeleteOrganization)
+
+				r.Route("/members", func(r chi.Router) {
+					r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleViewer)).Get("/", s.handlers.ListOrganizationMembers)
+					r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleAdmin)).Post("/", s.handlers.AddOrganizationMember)
+					r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleAdmin)).Delete("/{userID}", s.handlers.RemoveOrganizationMember)
+				})
+
+				r.Route("/subscriptions", func(r chi.Router) {
+					r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleViewer)).Get("/", s.handlers.ListOrganizationSubscriptions)
+					r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleAdmin), apimiddleware.Idempotency(s.config.Cache)).Post("/", s.handlers.CreateOrganizationSubscription)
+				})
+
+				r.With(apimiddleware.RequireOrgRole(s.config.OrganizationMgr, types.OrgRoleViewer)).Get("/usage", s.handlers.GetOrganizationUsage)
 			})
 		})
 
 		// Changes
+		r.Get("/diff", s.handlers.GetDiff)
+
 		r.Route("/changes", func(r chi.Router) {
 			r.Get("/", s.handlers.ListChanges)
 			r.Get("/{changeID}", s.handlers.GetChange)
 		})
 
+		// Transparency log (public, so third parties can verify the
+		// change history is complete and untampered)
+		r.Get("/transparency/{index}", s.handlers.GetTransparencyEntry)
+
 		// Servers (proxy to registry with caching)
 		r.Route("/servers", func(r chi.Router) {
 			r.Get("/", s.handlers.ListServers)
+			r.Get("/semantic-search", s.handlers.SemanticSearchServers)
 			r.Get("/{serverName}", s.handlers.GetServer)
 			r.Get("/{serverName}/changes", s.handlers.GetServerChanges)
 		})
 
+		// Category taxonomy servers are tagged with (see internal/category);
+		// ListServers accepts the resulting names via its tag query param.
+		r.Get("/categories", s.handlers.ListCategories)
+
 		// Feeds
 		r.Route("/feeds", func(r chi.Router) {
 			r.Get("/rss", s.handlers.RSSFeed)
@@ -129,16 +357,67 @@ func (s *Server) setupRouter() {
 			r.Get("/json", s.handlers.JSONFeed)
 		})
 
+		// Reports: human-readable summaries of registry activity, distinct
+		// from the machine-oriented /changes and /diff endpoints.
+		r.Route("/reports", func(r chi.Router) {
+			r.Get("/changelog", s.handlers.GetChangelogReport)
+		})
+
 		// Webhooks (for external integrations)
 		r.Post("/webhooks/test", s.handlers.TestWebhook)
+
+		// Renders the message a channel would receive for a change without
+		// sending it, so template authors and UI builders can iterate safely.
+		r.Post("/notifications/preview", s.handlers.PreviewNotification)
+
+		// Slack's callback for Block Kit button clicks (e.g. "Mute this
+		// server"). Authenticated by verifying Slack's own request
+		// signature rather than an API key, since Slack is the caller.
+		r.Route("/integrations/slack", func(r chi.Router) {
+			r.Post("/interactions", s.handlers.SlackInteraction)
+		})
+
+		// Discord's callback for "/mcp" slash command interactions.
+		// Authenticated by verifying Discord's Ed25519 request signature
+		// rather than an API key, since Discord is the caller.
+		r.Route("/integrations/discord", func(r chi.Router) {
+			r.Post("/interactions", s.handlers.DiscordInteraction)
+		})
+
+		// Telegram's bot webhook, backing "/start", "/subscribe", "/mute",
+		// and "/recent". Authenticated by verifying the secret token
+		// configured via setWebhook, since Telegram does not sign requests.
+		r.Route("/integrations/telegram", func(r chi.Router) {
+			r.Post("/interactions", s.handlers.TelegramInteraction)
+		})
+
+		// Bounce/complaint webhooks from the email API providers, so a
+		// hard bounce or spam complaint disables the affected channel
+		// instead of silently failing (or annoying someone) forever.
+		r.Route("/integrations/email", func(r chi.Router) {
+			r.Post("/bounce/ses", s.handlers.EmailBounceSES)
+			r.Post("/bounce/sendgrid", s.handlers.EmailBounceSendGrid)
+			r.Post("/bounce/mailgun", s.handlers.EmailBounceMailgun)
+		})
+
+		// Audit log of mutating operations across all subscriptions and
+		// organizations. There's no single owning user or organization to
+		// authorize against, so this is gated by a separate operator key
+		// instead of session/subscription auth.
+		r.With(apimiddleware.RequireAdminKey(s.config.AdminAPIKey)).Get("/admin/audit", s.handlers.GetAuditLog)
 	})
 
+	// GraphQL (changes/servers/subscriptions with richer filtering than the
+	// REST query params can comfortably express)
+	r.Post("/graphql", s.handlers.GraphQL)
+
 	// Serve OpenAPI spec
 	r.Get("/api/docs", s.handlers.ServeOpenAPISpec)
 	r.Get("/api/openapi.yaml", s.handlers.ServeOpenAPIYAML)
 
-	// Static files for dashboard (if embedded)
-	// r.Handle("/*", http.FileServer(http.FS(dashboardFS)))
+	// Operator dashboard: recent changes, servers, and (given a subscription
+	// ID/API key entered in the browser) its health and retry queue.
+	r.Get("/ui", s.handlers.ServeDashboard)
 
 	s.router = r
 }
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -19,6 +21,8 @@ import (
 
 	"github.com/nirholas/mcp-notify/internal/api/handlers"
 	apimiddleware "github.com/nirholas/mcp-notify/internal/api/middleware"
+	"github.com/nirholas/mcp-notify/internal/audit"
+	"github.com/nirholas/mcp-notify/internal/db"
 	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
@@ -30,6 +34,12 @@ type MockDatabase struct {
 	channels      map[uuid.UUID]*types.Channel
 	changes       map[uuid.UUID]*types.Change
 	notifications map[uuid.UUID]*types.Notification
+	users         map[uuid.UUID]*types.User
+	tokens        map[uuid.UUID]*types.PersonalAccessToken
+	subAPIKeys    map[uuid.UUID]*types.SubscriptionAPIKey
+	orgs          map[uuid.UUID]*types.Organization
+	orgMembers    map[string]*types.OrganizationMember
+	auditEntries  []types.AuditLogEntry
 }
 
 // NewMockDatabase creates a new mock database.
@@ -39,18 +49,39 @@ func NewMockDatabase() *MockDatabase {
 		channels:      make(map[uuid.UUID]*types.Channel),
 		changes:       make(map[uuid.UUID]*types.Change),
 		notifications: make(map[uuid.UUID]*types.Notification),
+		users:         make(map[uuid.UUID]*types.User),
+		tokens:        make(map[uuid.UUID]*types.PersonalAccessToken),
+		subAPIKeys:    make(map[uuid.UUID]*types.SubscriptionAPIKey),
+		orgs:          make(map[uuid.UUID]*types.Organization),
+		orgMembers:    make(map[string]*types.OrganizationMember),
 	}
 }
 
-func (m *MockDatabase) Close() error { return nil }
-func (m *MockDatabase) Ping(ctx context.Context) error { return nil }
+func orgMemberKey(orgID, userID uuid.UUID) string {
+	return orgID.String() + ":" + userID.String()
+}
+
+func (m *MockDatabase) Close() error                      { return nil }
+func (m *MockDatabase) Ping(ctx context.Context) error    { return nil }
 func (m *MockDatabase) Migrate(ctx context.Context) error { return nil }
 
 func (m *MockDatabase) SaveSnapshot(ctx context.Context, snapshot *types.Snapshot) error { return nil }
-func (m *MockDatabase) GetLatestSnapshot(ctx context.Context) (*types.Snapshot, error) { return nil, nil }
-func (m *MockDatabase) GetSnapshotByID(ctx context.Context, id uuid.UUID) (*types.Snapshot, error) { return nil, nil }
-func (m *MockDatabase) GetSnapshotAt(ctx context.Context, timestamp time.Time) (*types.Snapshot, error) { return nil, nil }
-func (m *MockDatabase) DeleteOldSnapshots(ctx context.Context, olderThan time.Time) error { return nil }
+func (m *MockDatabase) GetLatestSnapshot(ctx context.Context) (*types.Snapshot, error) {
+	return nil, nil
+}
+func (m *MockDatabase) GetSnapshotByID(ctx context.Context, id uuid.UUID) (*types.Snapshot, error) {
+	return nil, nil
+}
+func (m *MockDatabase) GetSnapshotAt(ctx context.Context, timestamp time.Time) (*types.Snapshot, error) {
+	return nil, nil
+}
+func (m *MockDatabase) DeleteOldSnapshots(ctx context.Context, olderThan time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDatabase) CompactSnapshots(ctx context.Context, policy types.RetentionPolicy) (types.CompactionReport, error) {
+	return types.CompactionReport{}, nil
+}
 
 func (m *MockDatabase) SaveChange(ctx context.Context, change *types.Change) error {
 	m.mu.Lock()
@@ -59,6 +90,24 @@ func (m *MockDatabase) SaveChange(ctx context.Context, change *types.Change) err
 	return nil
 }
 
+func (m *MockDatabase) SaveChanges(ctx context.Context, changes []types.Change) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range changes {
+		m.changes[changes[i].ID] = &changes[i]
+	}
+	return nil
+}
+
+func (m *MockDatabase) SaveChangesAndEnqueueNotification(ctx context.Context, changes []types.Change, entry *types.NotificationOutboxEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range changes {
+		m.changes[changes[i].ID] = &changes[i]
+	}
+	return nil
+}
+
 func (m *MockDatabase) GetChangeByID(ctx context.Context, id uuid.UUID) (*types.Change, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -77,6 +126,53 @@ func (m *MockDatabase) GetChangesSince(ctx context.Context, since time.Time, lim
 	return result, nil
 }
 
+func (m *MockDatabase) GetChangesSincePage(ctx context.Context, since time.Time, cursor string, limit int) ([]types.Change, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var result []types.Change
+	for _, c := range m.changes {
+		if c.DetectedAt.After(since) {
+			result = append(result, *c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DetectedAt.Equal(result[j].DetectedAt) {
+			return result[i].ID.String() > result[j].ID.String()
+		}
+		return result[i].DetectedAt.After(result[j].DetectedAt)
+	})
+
+	start := 0
+	if cursor != "" {
+		c, err := db.DecodeChangesCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		for i, change := range result {
+			if change.DetectedAt.Before(c.DetectedAt) || (change.DetectedAt.Equal(c.DetectedAt) && change.ID.String() < c.ID.String()) {
+				start = i
+				break
+			}
+			start = i + 1
+		}
+	}
+	if start > len(result) {
+		start = len(result)
+	}
+	result = result[start:]
+
+	var nextCursor string
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	if len(result) == limit && len(result) > 0 {
+		last := result[len(result)-1]
+		nextCursor = db.EncodeChangesCursor(db.ChangesCursor{DetectedAt: last.DetectedAt, ID: last.ID})
+	}
+
+	return result, nextCursor, nil
+}
+
 func (m *MockDatabase) GetChangesForServer(ctx context.Context, serverName string, limit int) ([]types.Change, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -101,6 +197,377 @@ func (m *MockDatabase) GetChangeCountSince(ctx context.Context, since time.Time)
 	return count, nil
 }
 
+func (m *MockDatabase) SaveHeldNotification(ctx context.Context, held *types.HeldNotification) error {
+	return nil
+}
+func (m *MockDatabase) GetHeldNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]types.HeldNotification, error) {
+	return nil, nil
+}
+func (m *MockDatabase) DeleteHeldNotifications(ctx context.Context, subscriptionID uuid.UUID) error {
+	return nil
+}
+
+func (m *MockDatabase) UpsertCoalescedUpdate(ctx context.Context, subscriptionID uuid.UUID, coalesceWindow time.Duration, change *types.Change) error {
+	return nil
+}
+func (m *MockDatabase) GetReadyCoalescedUpdates(ctx context.Context, now time.Time) ([]types.PendingCoalescedUpdate, error) {
+	return nil, nil
+}
+func (m *MockDatabase) DeleteCoalescedUpdate(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *MockDatabase) SaveOutboxEvent(ctx context.Context, event *types.OutboxEvent) error {
+	return nil
+}
+func (m *MockDatabase) GetPendingOutboxEvents(ctx context.Context, limit int) ([]types.OutboxEvent, error) {
+	return nil, nil
+}
+func (m *MockDatabase) DeleteOutboxEvent(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+func (m *MockDatabase) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttempt time.Time, errMsg string) error {
+	return nil
+}
+
+func (m *MockDatabase) SaveNotificationOutboxEntry(ctx context.Context, entry *types.NotificationOutboxEntry) error {
+	return nil
+}
+func (m *MockDatabase) GetPendingNotificationOutboxEntries(ctx context.Context, limit int) ([]types.NotificationOutboxEntry, error) {
+	return nil, nil
+}
+func (m *MockDatabase) DeleteNotificationOutboxEntry(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+func (m *MockDatabase) MarkNotificationOutboxEntryFailed(ctx context.Context, id uuid.UUID, nextAttempt time.Time, errMsg string) error {
+	return nil
+}
+
+func (m *MockDatabase) SaveTransparencyEntry(ctx context.Context, entry *types.TransparencyEntry) error {
+	return nil
+}
+func (m *MockDatabase) GetTransparencyEntry(ctx context.Context, index int64) (*types.TransparencyEntry, error) {
+	return nil, nil
+}
+func (m *MockDatabase) GetLatestTransparencyEntry(ctx context.Context) (*types.TransparencyEntry, error) {
+	return nil, nil
+}
+func (m *MockDatabase) CountTransparencyEntries(ctx context.Context) (int64, error) { return 0, nil }
+
+func (m *MockDatabase) CreateUser(ctx context.Context, user *types.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.users[user.ID] = user
+	return nil
+}
+
+func (m *MockDatabase) GetUserByID(ctx context.Context, id uuid.UUID) (*types.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.users[id], nil
+}
+
+func (m *MockDatabase) GetUserBySubject(ctx context.Context, provider, subject string) (*types.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, u := range m.users {
+		if u.Provider == provider && u.Subject == subject {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) ListSubscriptionsForUser(ctx context.Context, userID uuid.UUID) ([]types.Subscription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var subs []types.Subscription
+	for _, sub := range m.subscriptions {
+		if sub.UserID != nil && *sub.UserID == userID {
+			subs = append(subs, *sub)
+		}
+	}
+	return subs, nil
+}
+
+func (m *MockDatabase) CreatePersonalAccessToken(ctx context.Context, token *types.PersonalAccessToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[token.ID] = token
+	return nil
+}
+
+func (m *MockDatabase) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*types.PersonalAccessToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, t := range m.tokens {
+		if t.TokenHash == tokenHash {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]types.PersonalAccessToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var tokens []types.PersonalAccessToken
+	for _, t := range m.tokens {
+		if t.UserID == userID {
+			tokens = append(tokens, *t)
+		}
+	}
+	return tokens, nil
+}
+
+func (m *MockDatabase) RevokePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[id]
+	if !ok || t.UserID != userID {
+		return fmt.Errorf("personal access token not found: %s", id)
+	}
+	now := time.Now().UTC()
+	t.RevokedAt = &now
+	return nil
+}
+
+func (m *MockDatabase) UpdatePersonalAccessTokenLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t, ok := m.tokens[id]; ok {
+		t.LastUsedAt = &at
+	}
+	return nil
+}
+
+func (m *MockDatabase) CreateSubscriptionAPIKey(ctx context.Context, key *types.SubscriptionAPIKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subAPIKeys[key.ID] = key
+	return nil
+}
+
+func (m *MockDatabase) GetSubscriptionAPIKeyByHash(ctx context.Context, keyHash string) (*types.SubscriptionAPIKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, k := range m.subAPIKeys {
+		if k.KeyHash == keyHash {
+			return k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) ListSubscriptionAPIKeys(ctx context.Context, subscriptionID uuid.UUID) ([]types.SubscriptionAPIKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var keys []types.SubscriptionAPIKey
+	for _, k := range m.subAPIKeys {
+		if k.SubscriptionID == subscriptionID {
+			keys = append(keys, *k)
+		}
+	}
+	return keys, nil
+}
+
+func (m *MockDatabase) RevokeSubscriptionAPIKey(ctx context.Context, id, subscriptionID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k, ok := m.subAPIKeys[id]
+	if !ok || k.SubscriptionID != subscriptionID {
+		return fmt.Errorf("subscription API key not found: %s", id)
+	}
+	now := time.Now().UTC()
+	k.RevokedAt = &now
+	return nil
+}
+
+func (m *MockDatabase) UpdateSubscriptionAPIKeyLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if k, ok := m.subAPIKeys[id]; ok {
+		k.LastUsedAt = &at
+	}
+	return nil
+}
+
+func (m *MockDatabase) CreateAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.auditEntries = append(m.auditEntries, *entry)
+	return nil
+}
+
+func (m *MockDatabase) ListAuditLogEntries(ctx context.Context, filter types.AuditLogFilter) ([]types.AuditLogEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var entries []types.AuditLogEntry
+	for _, e := range m.auditEntries {
+		if filter.ResourceType != "" && e.ResourceType != filter.ResourceType {
+			continue
+		}
+		if filter.ResourceID != nil && e.ResourceID != *filter.ResourceID {
+			continue
+		}
+		if filter.Action != "" && e.Action != filter.Action {
+			continue
+		}
+		if filter.ActorID != "" && e.ActorID != filter.ActorID {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (m *MockDatabase) CreateOrganization(ctx context.Context, org *types.Organization) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.orgs[org.ID] = org
+	return nil
+}
+
+func (m *MockDatabase) GetOrganizationByID(ctx context.Context, id uuid.UUID) (*types.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.orgs[id], nil
+}
+
+func (m *MockDatabase) GetOrganizationBySlug(ctx context.Context, slug string) (*types.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, o := range m.orgs {
+		if o.Slug == slug {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockDatabase) UpdateOrganization(ctx context.Context, org *types.Organization) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.orgs[org.ID]; !ok {
+		return fmt.Errorf("organization not found: %s", org.ID)
+	}
+	m.orgs[org.ID] = org
+	return nil
+}
+
+func (m *MockDatabase) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.orgs[id]; !ok {
+		return fmt.Errorf("organization not found: %s", id)
+	}
+	delete(m.orgs, id)
+	return nil
+}
+
+func (m *MockDatabase) ListOrganizationsForUser(ctx context.Context, userID uuid.UUID) ([]types.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var orgs []types.Organization
+	for _, member := range m.orgMembers {
+		if member.UserID == userID {
+			if o, ok := m.orgs[member.OrgID]; ok {
+				orgs = append(orgs, *o)
+			}
+		}
+	}
+	return orgs, nil
+}
+
+func (m *MockDatabase) CountNotificationsForOrganizationSince(ctx context.Context, orgID uuid.UUID, since time.Time) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	count := 0
+	for _, n := range m.notifications {
+		sub, ok := m.subscriptions[n.SubscriptionID]
+		if !ok || sub.OrgID == nil || *sub.OrgID != orgID {
+			continue
+		}
+		if n.CreatedAt.Before(since) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (m *MockDatabase) ListOrganizationsWithUsageWebhook(ctx context.Context) ([]types.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var orgs []types.Organization
+	for _, o := range m.orgs {
+		if o.UsageWebhookURL != "" {
+			orgs = append(orgs, *o)
+		}
+	}
+	return orgs, nil
+}
+
+func (m *MockDatabase) CountSubscriptionsForOrganization(ctx context.Context, orgID uuid.UUID) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	count := 0
+	for _, sub := range m.subscriptions {
+		if sub.OrgID != nil && *sub.OrgID == orgID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *MockDatabase) ListSubscriptionsForOrganization(ctx context.Context, orgID uuid.UUID) ([]types.Subscription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var subs []types.Subscription
+	for _, sub := range m.subscriptions {
+		if sub.OrgID != nil && *sub.OrgID == orgID {
+			subs = append(subs, *sub)
+		}
+	}
+	return subs, nil
+}
+
+func (m *MockDatabase) AddOrganizationMember(ctx context.Context, member *types.OrganizationMember) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.orgMembers[orgMemberKey(member.OrgID, member.UserID)] = member
+	return nil
+}
+
+func (m *MockDatabase) GetOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) (*types.OrganizationMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.orgMembers[orgMemberKey(orgID, userID)], nil
+}
+
+func (m *MockDatabase) ListOrganizationMembers(ctx context.Context, orgID uuid.UUID) ([]types.OrganizationMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var members []types.OrganizationMember
+	for _, member := range m.orgMembers {
+		if member.OrgID == orgID {
+			members = append(members, *member)
+		}
+	}
+	return members, nil
+}
+
+func (m *MockDatabase) RemoveOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := orgMemberKey(orgID, userID)
+	if _, ok := m.orgMembers[key]; !ok {
+		return fmt.Errorf("organization member not found")
+	}
+	delete(m.orgMembers, key)
+	return nil
+}
+
 func (m *MockDatabase) CreateSubscription(ctx context.Context, sub *types.Subscription) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -218,6 +685,18 @@ func (m *MockDatabase) SaveNotification(ctx context.Context, notification *types
 	return nil
 }
 
+func (m *MockDatabase) SaveNotifications(ctx context.Context, notifications []types.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range notifications {
+		if notifications[i].ID == uuid.Nil {
+			notifications[i].ID = uuid.New()
+		}
+		m.notifications[notifications[i].ID] = &notifications[i]
+	}
+	return nil
+}
+
 func (m *MockDatabase) UpdateNotification(ctx context.Context, notification *types.Notification) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -249,6 +728,75 @@ func (m *MockDatabase) GetNotificationsForSubscription(ctx context.Context, subs
 	return result, nil
 }
 
+func (m *MockDatabase) SearchNotifications(ctx context.Context, subscriptionID uuid.UUID, query string, limit int) ([]types.Notification, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var result []types.Notification
+	q := strings.ToLower(query)
+	for _, n := range m.notifications {
+		if n.SubscriptionID != subscriptionID {
+			continue
+		}
+		haystack := strings.ToLower(n.ServerName + " " + string(n.ChangeType) + " " + n.Error + " " + n.PayloadSnippet)
+		if strings.Contains(haystack, q) {
+			result = append(result, *n)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockDatabase) PruneOldNotifications(ctx context.Context, olderThan time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDatabase) GetSubscriptionAnalytics(ctx context.Context, subscriptionID uuid.UUID, since time.Time, bucketSize time.Duration) (*types.SubscriptionAnalytics, error) {
+	return &types.SubscriptionAnalytics{
+		SubscriptionID: subscriptionID,
+		Since:          since,
+		BucketSizeMS:   bucketSize.Milliseconds(),
+	}, nil
+}
+
+func (m *MockDatabase) PruneOldChanges(ctx context.Context, olderThan time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDatabase) PruneOldAuditLogEntries(ctx context.Context, olderThan time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDatabase) AnonymizeSubscriptionAuditLog(ctx context.Context, subscriptionID uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDatabase) VacuumTables(ctx context.Context, tables []string) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDatabase) UpsertServerEmbedding(ctx context.Context, embedding types.ServerEmbedding) error {
+	return nil
+}
+
+func (m *MockDatabase) ListServerEmbeddings(ctx context.Context) ([]types.ServerEmbedding, error) {
+	return nil, nil
+}
+
+func (m *MockDatabase) CreateWatchlist(ctx context.Context, watchlist *types.Watchlist) error {
+	return nil
+}
+
+func (m *MockDatabase) GetWatchlistByAPIKeyHash(ctx context.Context, apiKeyHash string) (*types.Watchlist, error) {
+	return nil, nil
+}
+
+func (m *MockDatabase) UpdateWatchlist(ctx context.Context, watchlist *types.Watchlist) error {
+	return nil
+}
+
+func (m *MockDatabase) WithMaintenanceLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	return true, fn(ctx)
+}
+
 func (m *MockDatabase) GetStats(ctx context.Context) (*types.StatsResponse, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -260,6 +808,11 @@ func (m *MockDatabase) GetStats(ctx context.Context) (*types.StatsResponse, erro
 	}, nil
 }
 
+func (m *MockDatabase) RecordPoll(ctx context.Context, record *types.PollRecord) error { return nil }
+func (m *MockDatabase) GetPollHistorySince(ctx context.Context, since time.Time) ([]types.PollRecord, error) {
+	return nil, nil
+}
+
 // MockCache is a mock implementation of the db.Cache interface.
 type MockCache struct {
 	mu     sync.RWMutex
@@ -275,7 +828,7 @@ func NewMockCache() *MockCache {
 	}
 }
 
-func (m *MockCache) Close() error { return nil }
+func (m *MockCache) Close() error                   { return nil }
 func (m *MockCache) Ping(ctx context.Context) error { return nil }
 
 func (m *MockCache) Get(ctx context.Context, key string) ([]byte, error) {
@@ -313,6 +866,27 @@ func (m *MockCache) IncrementRateLimit(ctx context.Context, key string, window t
 	return m.counts[key], nil
 }
 
+func (m *MockCache) SetWithNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, exists := m.data[key]; exists {
+		return false, nil
+	}
+	m.data[key] = value
+	return true, nil
+}
+
+func (m *MockCache) SlidingWindowAllow(ctx context.Context, key string, limit int64, window time.Duration) (bool, int64, time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[key]++
+	remaining := limit - m.counts[key]
+	if remaining < 0 {
+		remaining = 0
+	}
+	return m.counts[key] <= limit, remaining, time.Now().Add(window), nil
+}
+
 // MockRegistryClient is a mock implementation of the registry client.
 type MockRegistryClient struct{}
 
@@ -344,6 +918,7 @@ func setupTestServer() (*handlers.Handlers, *MockDatabase, *MockCache) {
 		Database:        db,
 		Cache:           cache,
 		SubscriptionMgr: subscriptionMgr,
+		AuditMgr:        audit.NewManager(db),
 		// Note: RegistryClient is nil - some tests may need special handling
 	})
 
@@ -362,6 +937,7 @@ func setupTestServerWithRegistry() (*handlers.Handlers, *MockDatabase, *MockCach
 		Database:        db,
 		Cache:           cache,
 		SubscriptionMgr: subscriptionMgr,
+		AuditMgr:        audit.NewManager(db),
 	})
 
 	return h, db, cache
@@ -618,13 +1194,13 @@ func TestAuthMiddleware(t *testing.T) {
 
 	// Create a subscription with a known API key
 	req := types.CreateSubscriptionRequest{
-		Name: "Test",
+		Name:    "Test",
 		Filters: types.SubscriptionFilter{},
 		Channels: []types.ChannelRequest{
 			{Type: types.ChannelWebhook, Config: types.ChannelConfig{WebhookURL: "https://example.com"}},
 		},
 	}
-	sub, apiKey, _ := subscriptionMgr.Create(context.Background(), req)
+	sub, apiKey, _ := subscriptionMgr.Create(context.Background(), req, nil, nil)
 
 	// Create a handler that requires auth
 	authMiddleware := apimiddleware.APIKeyAuthForSubscription(subscriptionMgr)
@@ -694,10 +1270,10 @@ func TestAuthMiddleware(t *testing.T) {
 // TestRateLimiting tests the rate limiting middleware.
 func TestRateLimiting(t *testing.T) {
 	cache := NewMockCache()
-	
+
 	// Create a rate-limited handler (5 requests per minute)
 	rateLimitMiddleware := apimiddleware.RateLimitByIP(cache, 5, time.Minute)
-	
+
 	callCount := 0
 	handler := rateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		callCount++
@@ -728,6 +1304,56 @@ func TestRateLimiting(t *testing.T) {
 	assert.NotEmpty(t, w.Header().Get("Retry-After"))
 }
 
+// TestIdempotencyMiddleware tests that a retried request with the same
+// Idempotency-Key replays the first response instead of running the handler
+// again, and that a different key runs it again as normal.
+func TestIdempotencyMiddleware(t *testing.T) {
+	cache := NewMockCache()
+
+	callCount := 0
+	idempotencyMiddleware := apimiddleware.Idempotency(cache)
+	handler := idempotencyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]int{"call": callCount})
+	}))
+
+	makeRequest := func(idempotencyKey string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/subscriptions", nil)
+		if idempotencyKey != "" {
+			r.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("retried request replays the first response", func(t *testing.T) {
+		first := makeRequest("retry-key-1")
+		assert.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, 1, callCount)
+
+		second := makeRequest("retry-key-1")
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.JSONEq(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, "true", second.Header().Get("Idempotency-Replayed"))
+		assert.Equal(t, 1, callCount, "handler should not run again for a replayed request")
+	})
+
+	t.Run("different key runs the handler again", func(t *testing.T) {
+		w := makeRequest("retry-key-2")
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, callCount)
+	})
+
+	t.Run("no key is unaffected", func(t *testing.T) {
+		makeRequest("")
+		makeRequest("")
+		assert.Equal(t, 4, callCount, "requests without an idempotency key should always run the handler")
+	})
+}
+
 // TestConcurrentRequests tests handling of concurrent requests.
 func TestConcurrentRequests(t *testing.T) {
 	h, _, _ := setupTestServer()
@@ -789,6 +1415,7 @@ func TestHealthEndpoint(t *testing.T) {
 		Database:        db,
 		Cache:           cache,
 		SubscriptionMgr: subscriptionMgr,
+		AuditMgr:        audit.NewManager(db),
 	})
 
 	r := httptest.NewRequest(http.MethodGet, "/health", nil)
@@ -838,12 +1465,12 @@ func TestErrorResponses(t *testing.T) {
 	h, _, _ := setupTestServer()
 
 	tests := []struct {
-		name           string
-		method         string
-		path           string
-		body           string
-		expectedCode   int
-		expectedError  string
+		name          string
+		method        string
+		path          string
+		body          string
+		expectedCode  int
+		expectedError string
 	}{
 		{
 			name:          "invalid JSON",
@@ -0,0 +1,81 @@
+// Package audit records and queries the audit log of mutating operations
+// (create/update/delete/pause/resume/rotate), so who changed what, from
+// where, and to what effect can be reconstructed after the fact.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Manager records and queries audit log entries.
+type Manager struct {
+	db db.Database
+}
+
+// NewManager creates a new audit manager.
+func NewManager(database db.Database) *Manager {
+	return &Manager{db: database}
+}
+
+// Record appends an audit log entry. before and after are marshaled to
+// JSON; either may be nil (before for a create, after for a delete).
+func (m *Manager) Record(ctx context.Context, action, resourceType string, resourceID uuid.UUID, actorType, actorID, ip string, before, after any) error {
+	entry := &types.AuditLogEntry{
+		ID:           uuid.New(),
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		ActorType:    actorType,
+		ActorID:      actorID,
+		IP:           ip,
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	if before != nil {
+		b, err := json.Marshal(before)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit log before-snapshot: %w", err)
+		}
+		entry.Before = b
+	}
+	if after != nil {
+		a, err := json.Marshal(after)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit log after-snapshot: %w", err)
+		}
+		entry.After = a
+	}
+
+	if err := m.db.CreateAuditLogEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to record audit log entry: %w", err)
+	}
+	return nil
+}
+
+// RecordAsync runs Record in the background and logs any failure, so a
+// failing audit write never delays or fails the operation it describes.
+func (m *Manager) RecordAsync(ctx context.Context, action, resourceType string, resourceID uuid.UUID, actorType, actorID, ip string, before, after any) {
+	go func() {
+		if err := m.Record(context.WithoutCancel(ctx), action, resourceType, resourceID, actorType, actorID, ip, before, after); err != nil {
+			log.Error().Err(err).Str("action", action).Str("resource_id", resourceID.String()).Msg("Failed to record audit log entry")
+		}
+	}()
+}
+
+// List returns audit log entries matching filter, newest first.
+func (m *Manager) List(ctx context.Context, filter types.AuditLogFilter) ([]types.AuditLogEntry, error) {
+	entries, err := m.db.ListAuditLogEntries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log entries: %w", err)
+	}
+	return entries, nil
+}
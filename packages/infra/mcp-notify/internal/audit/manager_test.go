@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// fakeAuditDB embeds db.Database (nil) so it satisfies the interface, and
+// overrides only the two methods Manager actually calls.
+type fakeAuditDB struct {
+	db.Database
+
+	saved     *types.AuditLogEntry
+	createErr error
+
+	listEntries []types.AuditLogEntry
+	listErr     error
+	listFilter  types.AuditLogFilter
+}
+
+func (f *fakeAuditDB) CreateAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	f.saved = entry
+	return f.createErr
+}
+
+func (f *fakeAuditDB) ListAuditLogEntries(ctx context.Context, filter types.AuditLogFilter) ([]types.AuditLogEntry, error) {
+	f.listFilter = filter
+	return f.listEntries, f.listErr
+}
+
+func TestManager_Record(t *testing.T) {
+	fake := &fakeAuditDB{}
+	mgr := NewManager(fake)
+
+	resourceID := uuid.New()
+	before := map[string]string{"name": "old"}
+	after := map[string]string{"name": "new"}
+
+	err := mgr.Record(context.Background(), "subscription.update", "subscription", resourceID, "user", "user-123", "203.0.113.1", before, after)
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.saved)
+	assert.NotEqual(t, uuid.Nil, fake.saved.ID)
+	assert.Equal(t, "subscription.update", fake.saved.Action)
+	assert.Equal(t, "subscription", fake.saved.ResourceType)
+	assert.Equal(t, resourceID, fake.saved.ResourceID)
+	assert.Equal(t, "user", fake.saved.ActorType)
+	assert.Equal(t, "user-123", fake.saved.ActorID)
+	assert.Equal(t, "203.0.113.1", fake.saved.IP)
+	assert.WithinDuration(t, time.Now(), fake.saved.CreatedAt, time.Second)
+
+	var gotBefore, gotAfter map[string]string
+	require.NoError(t, json.Unmarshal(fake.saved.Before, &gotBefore))
+	require.NoError(t, json.Unmarshal(fake.saved.After, &gotAfter))
+	assert.Equal(t, before, gotBefore)
+	assert.Equal(t, after, gotAfter)
+}
+
+func TestManager_Record_NilBeforeAndAfter(t *testing.T) {
+	fake := &fakeAuditDB{}
+	mgr := NewManager(fake)
+
+	err := mgr.Record(context.Background(), "organization.create", "organization", uuid.New(), "user", "user-123", "203.0.113.1", nil, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.saved)
+	assert.Nil(t, fake.saved.Before)
+	assert.Nil(t, fake.saved.After)
+}
+
+func TestManager_List(t *testing.T) {
+	want := []types.AuditLogEntry{{ID: uuid.New(), Action: "subscription.create"}}
+	fake := &fakeAuditDB{listEntries: want}
+	mgr := NewManager(fake)
+
+	filter := types.AuditLogFilter{ResourceType: "subscription"}
+	got, err := mgr.List(context.Background(), filter)
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+	assert.Equal(t, filter, fake.listFilter)
+}
@@ -0,0 +1,201 @@
+// Package auth provides OIDC login against any compliant provider and
+// personal access tokens for authenticating as the resulting user account.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// personalAccessTokenPrefix identifies a personal access token at a glance
+// (in logs, in a UI), the same way subscription API keys carry "mcpw_".
+const personalAccessTokenPrefix = "mcpn_pat_"
+
+// Config configures login against a single OIDC provider.
+type Config struct {
+	// IssuerURL is the provider's issuer, e.g. "https://accounts.google.com".
+	// Its "/.well-known/openid-configuration" document is fetched on
+	// NewProvider to discover the authorization, token, and userinfo
+	// endpoints.
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	Timeout time.Duration
+}
+
+// discoveryDocument is the subset of an OIDC provider's discovery document
+// this package needs.
+type discoveryDocument struct {
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// Provider is an OIDC provider configured for the authorization code flow.
+type Provider struct {
+	cfg        Config
+	discovery  discoveryDocument
+	httpClient *http.Client
+}
+
+// NewProvider discovers cfg.IssuerURL's endpoints and returns a Provider
+// ready to build login URLs and exchange authorization codes.
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	p := &Provider{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimSuffix(cfg.IssuerURL, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC discovery returned status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&p.discovery); err != nil {
+		return nil, fmt.Errorf("failed to decode OIDC discovery document: %w", err)
+	}
+
+	return p, nil
+}
+
+// AuthCodeURL builds the URL to redirect a user's browser to in order to
+// start the login flow. state should be an unguessable value the caller
+// verifies matches on callback, to prevent CSRF.
+func (p *Provider) AuthCodeURL(state string) string {
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email"}
+	}
+
+	q := url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.cfg.ClientID},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"scope":         {strings.Join(scopes, " ")},
+		"state":         {state},
+	}
+	return p.discovery.AuthorizationEndpoint + "?" + q.Encode()
+}
+
+// TokenResponse is a provider's response to an authorization code exchange.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// Exchange trades an authorization code for an access token.
+func (p *Provider) Exchange(ctx context.Context, code string) (*TokenResponse, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"client_secret": {p.cfg.ClientSecret},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	}
+
+	var tok TokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	return &tok, nil
+}
+
+// UserInfo is the identity claims returned by the provider's userinfo
+// endpoint for the user an access token belongs to.
+type UserInfo struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email"`
+}
+
+// FetchUserInfo calls the provider's userinfo endpoint with accessToken.
+// Because the provider itself validates the access token before returning
+// these claims, this avoids needing to verify an ID token's signature
+// locally.
+func (p *Provider) FetchUserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.discovery.UserinfoEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create userinfo request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo endpoint returned status %d", resp.StatusCode)
+	}
+
+	var info UserInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode userinfo response: %w", err)
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("userinfo response is missing the sub claim")
+	}
+	return &info, nil
+}
+
+// GeneratePersonalAccessToken creates a new random personal access token.
+func GeneratePersonalAccessToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return personalAccessTokenPrefix + hex.EncodeToString(b), nil
+}
+
+// HashPersonalAccessToken hashes a token for storage/lookup, the same way
+// subscription API keys are hashed.
+func HashPersonalAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// LooksLikePersonalAccessToken reports whether token carries the personal
+// access token prefix, so callers can tell it apart from a subscription API
+// key (prefixed "mcpw_") before looking it up.
+func LooksLikePersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, personalAccessTokenPrefix)
+}
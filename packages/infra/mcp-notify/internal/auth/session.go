@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionCookieName is the browser cookie holding a signed session.
+const SessionCookieName = "mcpn_session"
+
+// SessionManager issues and verifies signed browser session cookies. A
+// session is just a user ID and an expiry, HMAC-signed with signingKey so it
+// can't be forged or extended client-side, the same way webhook payloads are
+// signed in internal/notifier/webhook.
+type SessionManager struct {
+	signingKey []byte
+	ttl        time.Duration
+}
+
+// NewSessionManager returns a SessionManager that signs sessions with
+// signingKey and issues them valid for ttl.
+func NewSessionManager(signingKey []byte, ttl time.Duration) *SessionManager {
+	return &SessionManager{signingKey: signingKey, ttl: ttl}
+}
+
+// Issue returns a signed session token for userID, valid for the manager's ttl.
+func (m *SessionManager) Issue(userID uuid.UUID) string {
+	expiresAt := time.Now().Add(m.ttl).Unix()
+	payload := userID.String() + "." + strconv.FormatInt(expiresAt, 10)
+	sig := m.sign(payload)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + sig
+}
+
+// Verify checks a session token's signature and expiry, returning the user
+// ID it was issued for.
+func (m *SessionManager) Verify(token string) (uuid.UUID, error) {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return uuid.Nil, fmt.Errorf("malformed session token")
+	}
+
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("malformed session token: %w", err)
+	}
+	payload := string(payloadBytes)
+
+	if !hmac.Equal([]byte(m.sign(payload)), []byte(parts[1])) {
+		return uuid.Nil, fmt.Errorf("invalid session signature")
+	}
+
+	fields := strings.SplitN(payload, ".", 2)
+	if len(fields) != 2 {
+		return uuid.Nil, fmt.Errorf("malformed session token")
+	}
+
+	userID, err := uuid.Parse(fields[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("malformed session token: %w", err)
+	}
+
+	expiresAt, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("malformed session token: %w", err)
+	}
+	if time.Now().Unix() > expiresAt {
+		return uuid.Nil, fmt.Errorf("session token expired")
+	}
+
+	return userID, nil
+}
+
+func (m *SessionManager) sign(payload string) string {
+	h := hmac.New(sha256.New, m.signingKey)
+	h.Write([]byte(payload))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionManager_IssueVerify(t *testing.T) {
+	mgr := NewSessionManager([]byte("test-signing-key"), time.Hour)
+	userID := uuid.New()
+
+	token := mgr.Issue(userID)
+	require.NotEmpty(t, token)
+
+	gotID, err := mgr.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, userID, gotID)
+}
+
+func TestSessionManager_Verify_Expired(t *testing.T) {
+	mgr := NewSessionManager([]byte("test-signing-key"), -time.Hour)
+	token := mgr.Issue(uuid.New())
+
+	_, err := mgr.Verify(token)
+	assert.ErrorContains(t, err, "expired")
+}
+
+func TestSessionManager_Verify_TamperedSignature(t *testing.T) {
+	mgr := NewSessionManager([]byte("test-signing-key"), time.Hour)
+	token := mgr.Issue(uuid.New())
+
+	tampered := token[:len(token)-1] + "0"
+	if tampered == token {
+		tampered = token[:len(token)-1] + "1"
+	}
+
+	_, err := mgr.Verify(tampered)
+	assert.ErrorContains(t, err, "invalid session signature")
+}
+
+func TestSessionManager_Verify_WrongSigningKey(t *testing.T) {
+	issuer := NewSessionManager([]byte("key-one"), time.Hour)
+	verifier := NewSessionManager([]byte("key-two"), time.Hour)
+
+	token := issuer.Issue(uuid.New())
+
+	_, err := verifier.Verify(token)
+	assert.ErrorContains(t, err, "invalid session signature")
+}
+
+func TestSessionManager_Verify_Malformed(t *testing.T) {
+	mgr := NewSessionManager([]byte("test-signing-key"), time.Hour)
+
+	_, err := mgr.Verify("not-a-valid-token")
+	assert.ErrorContains(t, err, "malformed session token")
+}
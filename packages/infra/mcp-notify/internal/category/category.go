@@ -0,0 +1,120 @@
+// Package category derives a lightweight tag taxonomy (database, ai,
+// productivity, devops, ...) for registry servers from keyword rules
+// against their name/description and hints from their declared package
+// names, so servers can be browsed and filtered without relying on any
+// tagging by the publisher.
+package category
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// rule matches a category against keywords found in a server's name or
+// description, and package-name substrings from its declared packages
+// (e.g. an npm package named "*-postgres-mcp" hints at "database" even if
+// the description doesn't mention it).
+type rule struct {
+	category string
+	keywords []string
+	packages []string
+}
+
+var rules = []rule{
+	{
+		category: "database",
+		keywords: []string{"database", "sql", "postgres", "postgresql", "mysql", "sqlite", "mongodb", "redis", "dynamodb", "cassandra"},
+		packages: []string{"postgres", "mysql", "sqlite", "mongo", "redis", "dynamodb", "cassandra"},
+	},
+	{
+		category: "ai",
+		keywords: []string{"ai", "llm", "gpt", "openai", "anthropic", "claude", "machine learning", "embedding", "vector", "rag"},
+		packages: []string{"openai", "anthropic", "langchain", "llamaindex"},
+	},
+	{
+		category: "productivity",
+		keywords: []string{"calendar", "todo", "task", "note", "productivity", "docs", "document", "spreadsheet", "notion"},
+	},
+	{
+		category: "devops",
+		keywords: []string{"docker", "kubernetes", "k8s", "ci/cd", "deploy", "terraform", "infrastructure", "devops", "aws", "gcp", "azure", "helm"},
+		packages: []string{"kubernetes", "terraform", "docker"},
+	},
+	{
+		category: "search",
+		keywords: []string{"search", "index", "elasticsearch", "algolia", "full-text"},
+	},
+	{
+		category: "communication",
+		keywords: []string{"slack", "discord", "chat", "messaging", "email", "sms", "telegram"},
+	},
+	{
+		category: "filesystem",
+		keywords: []string{"file", "filesystem", "storage", "s3", "disk", "blob"},
+	},
+	{
+		category: "version-control",
+		keywords: []string{"git", "github", "gitlab", "version control", "bitbucket"},
+	},
+	{
+		category: "monitoring",
+		keywords: []string{"monitoring", "observability", "metrics", "logging", "tracing", "alerting"},
+	},
+	{
+		category: "finance",
+		keywords: []string{"finance", "payment", "stripe", "billing", "invoice", "crypto", "blockchain"},
+	},
+	{
+		category: "web",
+		keywords: []string{"browser", "scrape", "scraping", "web page", "html", "crawler"},
+	},
+}
+
+// Categorize returns every category whose rule matches server, derived
+// from its name, description, and declared package names. A server can
+// match more than one category. Results are sorted alphabetically.
+func Categorize(server types.Server) []string {
+	text := strings.ToLower(server.Name + " " + server.Description)
+
+	pkgNames := make([]string, len(server.Packages))
+	for i, p := range server.Packages {
+		pkgNames[i] = strings.ToLower(p.Name)
+	}
+
+	var tags []string
+	for _, r := range rules {
+		if matchesRule(r, text, pkgNames) {
+			tags = append(tags, r.category)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func matchesRule(r rule, text string, pkgNames []string) bool {
+	for _, kw := range r.keywords {
+		if strings.Contains(text, kw) {
+			return true
+		}
+	}
+	for _, hint := range r.packages {
+		for _, name := range pkgNames {
+			if strings.Contains(name, hint) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AllCategories returns every known category name, alphabetically sorted.
+func AllCategories() []string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.category
+	}
+	sort.Strings(names)
+	return names
+}
@@ -25,8 +25,58 @@ type CLIConfig struct {
 	// Saved subscriptions (name -> ID mapping for convenience)
 	Subscriptions map[string]string `yaml:"subscriptions"`
 
+	// WatchlistAPIKey is the API key returned when the CLI's watchlist was
+	// created, saved so `watchlist add/remove/status` don't need it passed
+	// on every invocation.
+	WatchlistAPIKey string `yaml:"watchlist_api_key,omitempty"`
+
 	// Watch preferences
 	DefaultWatchInterval string `yaml:"default_watch_interval"`
+
+	// Profiles maps a name (e.g. "prod", "staging", "local") to its own
+	// endpoint/API key/registry URL, so users juggling multiple
+	// deployments can switch between them with --profile instead of
+	// hand-editing this file. The top-level APIEndpoint/APIKey/
+	// RegistryURL fields above remain the fallback when no profile is
+	// active.
+	Profiles map[string]Profile `yaml:"profiles,omitempty"`
+
+	// ActiveProfile names the profile used when --profile isn't passed.
+	// Empty means fall back to the top-level fields.
+	ActiveProfile string `yaml:"active_profile,omitempty"`
+}
+
+// Profile holds the connection settings for one named deployment.
+type Profile struct {
+	APIEndpoint string `yaml:"api_endpoint,omitempty"`
+	APIKey      string `yaml:"api_key,omitempty"`
+	RegistryURL string `yaml:"registry_url,omitempty"`
+}
+
+// ResolveProfile returns the profile to use for this invocation: name if
+// given, otherwise the config's ActiveProfile. It returns ok=false if no
+// profile name applies or the named profile isn't defined, in which case
+// callers should fall back to the top-level APIEndpoint/APIKey/RegistryURL
+// fields.
+func (c *CLIConfig) ResolveProfile(name string) (Profile, bool) {
+	if name == "" {
+		name = c.ActiveProfile
+	}
+	if name == "" {
+		return Profile{}, false
+	}
+	p, ok := c.Profiles[name]
+	return p, ok
+}
+
+// UseProfile sets name as the default active profile and persists the
+// config. It errors if the profile isn't defined in c.Profiles.
+func (c *CLIConfig) UseProfile(name string) error {
+	if _, ok := c.Profiles[name]; !ok {
+		return fmt.Errorf("unknown profile: %s", name)
+	}
+	c.ActiveProfile = name
+	return SaveCLIConfig(c)
 }
 
 // DefaultCLIConfig returns default CLI configuration.
@@ -141,3 +191,9 @@ func (c *CLIConfig) RemoveSubscription(name string) error {
 	delete(c.Subscriptions, name)
 	return SaveCLIConfig(c)
 }
+
+// SaveWatchlistAPIKey saves the CLI's watchlist API key to the config.
+func (c *CLIConfig) SaveWatchlistAPIKey(apiKey string) error {
+	c.WatchlistAPIKey = apiKey
+	return SaveCLIConfig(c)
+}
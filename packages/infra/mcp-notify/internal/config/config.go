@@ -2,14 +2,20 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
+
+	"github.com/nirholas/mcp-notify/internal/secrets"
 )
 
 var configFile string
@@ -26,7 +32,16 @@ type Config struct {
 	Database      DatabaseConfig      `mapstructure:"database" validate:"required"`
 	Redis         RedisConfig         `mapstructure:"redis"`
 	Notifications NotificationsConfig `mapstructure:"notifications" validate:"required"`
+	Maintenance   MaintenanceConfig   `mapstructure:"maintenance"`
+	Organizations OrganizationsConfig `mapstructure:"organizations"`
+	Security      SecurityConfig      `mapstructure:"security"`
+	Provenance    ProvenanceConfig    `mapstructure:"provenance"`
+	Summarizer    SummarizerConfig    `mapstructure:"summarizer"`
+	Auth          AuthConfig          `mapstructure:"auth"`
 	Telemetry     TelemetryConfig     `mapstructure:"telemetry"`
+	Transparency  TransparencyConfig  `mapstructure:"transparency"`
+	EventBus      EventBusConfig      `mapstructure:"event_bus"`
+	RateLimit     RateLimitConfig     `mapstructure:"rate_limit"`
 	LogLevel      string              `mapstructure:"log_level"`
 }
 
@@ -35,6 +50,11 @@ type ServerConfig struct {
 	Host string     `mapstructure:"host" validate:"required"`
 	Port int        `mapstructure:"port" validate:"required,min=1,max=65535"`
 	CORS CORSConfig `mapstructure:"cors"`
+
+	// AdminAPIKey gates operator-only endpoints (e.g. the audit log) that
+	// have no single owning user or organization. Empty disables those
+	// endpoints entirely rather than leaving them open.
+	AdminAPIKey string `mapstructure:"admin_api_key"`
 }
 
 // CORSConfig holds CORS configuration.
@@ -53,6 +73,53 @@ type RegistryConfig struct {
 	RetryAttempts int           `mapstructure:"retry_attempts" validate:"min=0,max=10"`
 	RetryDelay    time.Duration `mapstructure:"retry_delay"`
 	UserAgent     string        `mapstructure:"user_agent"`
+
+	// MaxPollInterval caps how far the poller's adaptive scheduler may
+	// lengthen the interval during quiet periods; PollInterval is always
+	// its floor. A zero value falls back to the poller's own default cap.
+	MaxPollInterval time.Duration `mapstructure:"max_poll_interval" validate:"omitempty,min=30s"`
+
+	// VerifyChanges re-fetches a changed server (especially removals) after
+	// VerificationDelay before dispatching notifications, to rule out a
+	// transient registry listing glitch.
+	VerifyChanges     bool          `mapstructure:"verify_changes"`
+	VerificationDelay time.Duration `mapstructure:"verification_delay" validate:"omitempty,min=0"`
+
+	// ShardPrefixes, if set, splits each poll into one fetch per namespace
+	// prefix instead of a single full-listing fetch, for registries too
+	// large to list cheaply in one request. Left empty, sharding stays
+	// disabled and the poller always does a single fetch.
+	ShardPrefixes []string `mapstructure:"shard_prefixes"`
+
+	// ShardConcurrency bounds how many shard prefixes are fetched at once.
+	// Only meaningful when ShardPrefixes is set; a zero value falls back to
+	// the poller's own default.
+	ShardConcurrency int `mapstructure:"shard_concurrency" validate:"omitempty,min=1"`
+
+	// HeartbeatURL, if set, receives a GET ping after every poll (success or
+	// failure) — e.g. a healthchecks.io check URL — so an external monitor
+	// notices if this process stops polling entirely, even a crash or
+	// deadlock that leaves nothing here to raise its own alert.
+	HeartbeatURL string `mapstructure:"heartbeat_url" validate:"omitempty,url"`
+
+	// StallThreshold flags the poller as stalled once this long has passed
+	// since its last successful poll, alerting
+	// NotificationsConfig.OpsWebhookURL if set. Zero disables stall
+	// detection (the default).
+	StallThreshold time.Duration `mapstructure:"stall_threshold" validate:"omitempty,min=30s"`
+
+	// BackfillOnStartup, when true, diffs the registry against the latest
+	// stored snapshot once at startup before the regular poll loop begins,
+	// so changes that happened while the process was down are caught up
+	// instead of silently lost to the "no previous snapshot" first-poll
+	// branch. Resulting changes are marked Change.Backfilled.
+	BackfillOnStartup bool `mapstructure:"backfill_on_startup"`
+
+	// BackfillNotify controls whether backfilled changes are dispatched to
+	// subscriptions like any other change, or saved silently. Defaults to
+	// false since a long outage can otherwise produce a large burst of
+	// catch-up notifications.
+	BackfillNotify bool `mapstructure:"backfill_notify"`
 }
 
 // DatabaseConfig holds database configuration.
@@ -62,6 +129,21 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `mapstructure:"max_idle_conns" validate:"min=0"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
 	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
+
+	// ReplicaURL, when set, points heavy read-only endpoints (changes list,
+	// feeds, stats) at a separate read-replica connection pool instead of
+	// the primary, so the API can scale reads independently of the poller's
+	// writes. A query that fails against the replica automatically falls
+	// back to the primary rather than erroring out. Empty disables it and
+	// all reads use the primary, as before.
+	ReplicaURL string `mapstructure:"replica_url"`
+
+	// DevAutoMigrate makes PostgresDB.Migrate() (run automatically at server
+	// startup) create tables directly instead of applying the tracked goose
+	// migrations in internal/db/migrations. It's a convenience for local
+	// iteration and isn't itself tracked, so it should stay off outside
+	// development; use `mcp-notify migrate up` to apply tracked migrations.
+	DevAutoMigrate bool `mapstructure:"dev_auto_migrate"`
 }
 
 // RedisConfig holds Redis configuration.
@@ -74,13 +156,267 @@ type RedisConfig struct {
 	PoolSize     int           `mapstructure:"pool_size"`
 }
 
+// MaintenanceConfig holds scheduled database maintenance configuration
+// (old snapshot/notification pruning and optional VACUUM ANALYZE).
+type MaintenanceConfig struct {
+	// Schedule is a 6-field (with seconds) cron expression controlling when
+	// maintenance runs. Defaults to daily at 3:00 AM UTC.
+	Schedule string `mapstructure:"schedule"`
+
+	// SnapshotRetention and NotificationRetention bound how far back old
+	// snapshots/notifications are pruned. Defaults to 30 and 90 days.
+	SnapshotRetention     time.Duration `mapstructure:"snapshot_retention"`
+	NotificationRetention time.Duration `mapstructure:"notification_retention"`
+
+	// ChangeRetention and AuditRetention bound how far back detected changes
+	// and audit log entries are pruned. Defaults to 180 and 365 days.
+	ChangeRetention time.Duration `mapstructure:"change_retention"`
+	AuditRetention  time.Duration `mapstructure:"audit_retention"`
+
+	// CompactionFullWindow and CompactionHourlyWindow control snapshot
+	// downsampling ahead of SnapshotRetention's final cutoff: every poll is
+	// kept within CompactionFullWindow, one snapshot per hour is kept out
+	// to CompactionHourlyWindow, and one per day beyond that. Default to
+	// 24h and 7 days.
+	CompactionFullWindow   time.Duration `mapstructure:"compaction_full_window"`
+	CompactionHourlyWindow time.Duration `mapstructure:"compaction_hourly_window"`
+
+	// VacuumEnabled additionally runs VACUUM ANALYZE against the pruned
+	// tables after cleanup, to reclaim disk space immediately instead of
+	// waiting on autovacuum.
+	VacuumEnabled bool `mapstructure:"vacuum_enabled"`
+}
+
+// OrganizationsConfig holds organization quota/billing configuration.
+type OrganizationsConfig struct {
+	// UsageWebhookSchedule is a 6-field (with seconds) cron expression
+	// controlling when organizations with a configured usage webhook are
+	// sent their current usage. Defaults to daily at 4:00 AM UTC.
+	UsageWebhookSchedule string `mapstructure:"usage_webhook_schedule"`
+}
+
+// SecurityConfig holds configuration for supply-chain security scoring of
+// servers' declared packages against an OSV-compatible vulnerability
+// database.
+type SecurityConfig struct {
+	// Enabled turns on scoring every server against known vulnerabilities
+	// during each poll. Disabled by default since it adds an external API
+	// call per server on every poll cycle.
+	Enabled bool `mapstructure:"enabled"`
+
+	// OSVBaseURL is the OSV API base URL. Defaults to https://api.osv.dev.
+	OSVBaseURL string `mapstructure:"osv_base_url"`
+
+	Timeout       time.Duration `mapstructure:"timeout"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+
+	// MinScoreThreshold flags a change as suspicious (see diff.detectAnomalies)
+	// when a server's score is at or below this value after an update, or
+	// drops below it compared to the previous score.
+	MinScoreThreshold int `mapstructure:"min_score_threshold" validate:"min=0,max=100"`
+}
+
+// RateLimitConfig holds sliding-window HTTP rate limiting policy, backed by
+// the Redis cache layer so limits are enforced consistently across replicas
+// rather than per-instance.
+type RateLimitConfig struct {
+	// Enabled turns on rate limiting for the HTTP API. Disabled by default
+	// so deployments without a shared Redis aren't surprised by 429s from a
+	// per-instance NullCache fallback that can't actually enforce a limit.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Default is the policy applied to a request that no entry in Routes or
+	// APIKeys overrides.
+	Default RateLimitPolicy `mapstructure:"default"`
+
+	// Routes overrides Default for specific, named routes. The name is
+	// chosen by whoever wires the middleware onto that route (see
+	// server.go), not derived from the URL, so it stays stable across path
+	// parameter changes.
+	Routes map[string]RateLimitPolicy `mapstructure:"routes"`
+
+	// APIKeys overrides Default (and any Routes match) for specific
+	// subscriptions, keyed by subscription ID. Lets a high-volume,
+	// trusted subscription get a higher budget than the default.
+	APIKeys map[string]RateLimitPolicy `mapstructure:"api_keys"`
+}
+
+// RateLimitPolicy is a request budget over a fixed time window.
+type RateLimitPolicy struct {
+	Limit  int64         `mapstructure:"limit"`
+	Window time.Duration `mapstructure:"window"`
+}
+
+// ProvenanceConfig holds configuration for verifying Sigstore signatures and
+// SLSA provenance attestations of servers' declared npm/PyPI packages.
+type ProvenanceConfig struct {
+	// Enabled turns on provenance verification for every server's declared
+	// packages during each poll. Disabled by default since it adds an
+	// external API call per package on every poll cycle.
+	Enabled bool `mapstructure:"enabled"`
+
+	// NPMRegistryURL is the npm registry to query attestations from.
+	// Defaults to https://registry.npmjs.org.
+	NPMRegistryURL string `mapstructure:"npm_registry_url"`
+
+	// PyPIRegistryURL is the PyPI instance to query attestations from.
+	// Defaults to https://pypi.org.
+	PyPIRegistryURL string `mapstructure:"pypi_registry_url"`
+
+	Timeout       time.Duration `mapstructure:"timeout"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// SummarizerConfig holds configuration for the optional LLM-backed change
+// summarizer, which condenses a batch of field-level changes into a short
+// human-readable sentence for digests and scheduled reports. Disabled by
+// default since it requires an API key and adds an external call per batch.
+type SummarizerConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// BaseURL is an OpenAI-compatible chat completions endpoint, e.g.
+	// https://api.openai.com/v1 or a local/self-hosted equivalent.
+	BaseURL string `mapstructure:"base_url" validate:"required_if=Enabled true"`
+
+	// APIKey authenticates against BaseURL. Prefer the
+	// MCP_WATCH_SUMMARIZER_API_KEY environment variable over storing this
+	// in a checked-in config file.
+	APIKey string `mapstructure:"api_key"`
+
+	Model string `mapstructure:"model"`
+
+	Timeout       time.Duration `mapstructure:"timeout"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// AuthConfig holds configuration for optional OIDC login and the user
+// accounts, browser sessions, and personal access tokens that come with it.
+// Subscriptions work exactly as before (standing alone behind their own API
+// key) whether or not this is enabled.
+type AuthConfig struct {
+	// Enabled turns on OIDC login and the /auth and /api/v1/me routes.
+	// Disabled by default since it requires registering an OAuth client
+	// with a provider.
+	Enabled bool `mapstructure:"enabled"`
+
+	// ProviderName identifies the provider in User.Provider, e.g. "google"
+	// or "okta".
+	ProviderName string `mapstructure:"provider_name"`
+
+	// IssuerURL is the provider's OIDC issuer, used to discover its
+	// authorization, token, and userinfo endpoints.
+	IssuerURL    string `mapstructure:"issuer_url"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+
+	// RedirectURL must match the callback URL registered with the
+	// provider, e.g. "https://notify.example.com/auth/callback".
+	RedirectURL string   `mapstructure:"redirect_url"`
+	Scopes      []string `mapstructure:"scopes"`
+
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// SessionSigningKey is the secret used to sign browser session
+	// cookies. Required whenever Enabled is true.
+	SessionSigningKey string        `mapstructure:"session_signing_key"`
+	SessionDuration   time.Duration `mapstructure:"session_duration"`
+}
+
+// TransparencyConfig holds configuration for the append-only change log.
+type TransparencyConfig struct {
+	// Enabled turns on hash-chaining every detected change into the
+	// transparency log. Disabled by default since it's an opt-in
+	// auditability feature, not needed by every deployment.
+	Enabled bool `mapstructure:"enabled"`
+
+	// SigningKeySeed is a hex-encoded 32-byte Ed25519 seed used to sign each
+	// entry. Signing is skipped (entries are hash-chained only) if empty.
+	SigningKeySeed string `mapstructure:"signing_key_seed"`
+}
+
+// EventBusConfig holds configuration for publishing every detected change
+// to an external event bus, so downstream data pipelines can consume
+// registry changes without hitting the HTTP API.
+type EventBusConfig struct {
+	// Enabled turns on outbox-backed publishing of every detected change.
+	// Disabled by default since it's an opt-in integration, not needed by
+	// every deployment.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Backend selects which event bus to publish to.
+	Backend string `mapstructure:"backend" validate:"omitempty,oneof=kafka nats"`
+
+	// Topic is the Kafka topic or NATS subject changes are published to.
+	Topic string `mapstructure:"topic" validate:"required_with=Enabled"`
+
+	// FlushInterval controls how often the outbox is drained and retried.
+	// A zero value falls back to the publisher's own default.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	Kafka KafkaConfig `mapstructure:"kafka"`
+	NATS  NATSConfig  `mapstructure:"nats"`
+}
+
+// KafkaConfig holds Kafka event bus backend configuration.
+type KafkaConfig struct {
+	Brokers []string `mapstructure:"brokers"`
+}
+
+// NATSConfig holds NATS JetStream event bus backend configuration.
+type NATSConfig struct {
+	URL string `mapstructure:"url"`
+	// Stream is created (if it doesn't already exist) to persist Topic, so
+	// JetStream only acknowledges a publish once it's durably stored.
+	Stream string `mapstructure:"stream"`
+}
+
 // NotificationsConfig holds notification channel configurations.
 type NotificationsConfig struct {
-	Discord DiscordConfig `mapstructure:"discord"`
-	Slack   SlackConfig   `mapstructure:"slack"`
-	Email   EmailConfig   `mapstructure:"email"`
-	Webhook WebhookConfig `mapstructure:"webhook"`
-	RSS     RSSConfig     `mapstructure:"rss"`
+	Discord  DiscordConfig  `mapstructure:"discord"`
+	Slack    SlackConfig    `mapstructure:"slack"`
+	Telegram TelegramConfig `mapstructure:"telegram"`
+	Email    EmailConfig    `mapstructure:"email"`
+	Webhook  WebhookConfig  `mapstructure:"webhook"`
+	RSS      RSSConfig      `mapstructure:"rss"`
+
+	Alertmanager AlertmanagerConfig `mapstructure:"alertmanager"`
+	Mattermost   MattermostConfig   `mapstructure:"mattermost"`
+	RocketChat   RocketChatConfig   `mapstructure:"rocketchat"`
+	Push         PushConfig         `mapstructure:"push"`
+	Apprise      AppriseConfig      `mapstructure:"apprise"`
+	SMS          SMSConfig          `mapstructure:"sms"`
+	SNS          SNSConfig          `mapstructure:"sns"`
+	EventBridge  EventBridgeConfig  `mapstructure:"eventbridge"`
+	GoogleChat   GoogleChatConfig   `mapstructure:"googlechat"`
+	Zulip        ZulipConfig        `mapstructure:"zulip"`
+
+	// RetryQueueInterval controls how often the dispatcher re-checks the
+	// notifications table for retries that have come due.
+	RetryQueueInterval time.Duration `mapstructure:"retry_queue_interval"`
+
+	// MaxConsecutiveFailures disables a channel once it has failed this many
+	// deliveries in a row, notifying the subscription's other enabled
+	// channels so a permanently broken destination (a deleted webhook, a
+	// revoked bot token) doesn't just fail forever. Zero disables this
+	// behavior, the previous default.
+	MaxConsecutiveFailures int `mapstructure:"max_consecutive_failures"`
+
+	// OpsWebhookURL, if set, receives a JSON {"text": "..."} POST when the
+	// poller appears to have stalled (see RegistryConfig.StallThreshold) or
+	// recovers from a stall. Independent of any subscription's channels,
+	// since this alert is about the service itself, not a registry change.
+	OpsWebhookURL string `mapstructure:"ops_webhook_url" validate:"omitempty,url"`
+
+	// OutboxFlushInterval controls how often the notification outbox is
+	// drained. Each poll enqueues its dispatch-worthy changes as a single
+	// outbox row instead of dispatching them inline, so a crash between
+	// saving a change and dispatching its notification can't silently drop
+	// the notification: the row is just picked up by the next flush. Zero
+	// falls back to the poller's own default.
+	OutboxFlushInterval time.Duration `mapstructure:"outbox_flush_interval" validate:"omitempty,min=1s"`
 }
 
 // DiscordConfig holds Discord notification configuration.
@@ -89,6 +425,27 @@ type DiscordConfig struct {
 	RateLimit     string        `mapstructure:"rate_limit"` // e.g., "30/min"
 	RetryAttempts int           `mapstructure:"retry_attempts"`
 	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+
+	// Bot enables the "/mcp watch|unwatch|recent" slash commands via
+	// Discord's HTTP interactions endpoint, letting a channel self-serve
+	// subscriptions without the admin API. Disabled by default; the sender
+	// works exactly as before (webhook-only) without it.
+	Bot DiscordBotConfig `mapstructure:"bot"`
+}
+
+// DiscordBotConfig holds Discord bot (slash command) configuration.
+type DiscordBotConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// PublicKey is the application's hex-encoded Ed25519 public key, used to
+	// verify inbound interactions actually came from Discord.
+	PublicKey string `mapstructure:"public_key"`
+
+	// Token authenticates outbound calls to the Discord bot REST API, used
+	// to deliver notifications to channels bound by "/mcp watch" (which
+	// have no incoming webhook URL of their own). Override with
+	// MCP_WATCH_DISCORD_BOT_TOKEN.
+	Token string `mapstructure:"token"`
 }
 
 // SlackConfig holds Slack notification configuration.
@@ -97,14 +454,73 @@ type SlackConfig struct {
 	RateLimit     string        `mapstructure:"rate_limit"`
 	RetryAttempts int           `mapstructure:"retry_attempts"`
 	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+
+	// SigningSecret verifies inbound Block Kit button clicks on
+	// /api/v1/integrations/slack/interactions came from Slack. Required for
+	// that endpoint to accept requests; leave empty to reject all of them.
+	// Override with MCP_WATCH_SLACK_SIGNING_SECRET.
+	SigningSecret string `mapstructure:"signing_secret"`
+}
+
+// TelegramConfig holds Telegram notification configuration. Sending itself
+// needs no global config: the bot token normally comes from each channel's
+// ChannelConfig.TelegramBotToken. This only configures the optional inbound
+// bot webhook, which lets one bot serve every chat that messages it.
+type TelegramConfig struct {
+	Bot TelegramBotConfig `mapstructure:"bot"`
+}
+
+// TelegramBotConfig holds Telegram bot (inbound command) configuration.
+type TelegramBotConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// BotToken authenticates outbound notifications sent to chats linked via
+	// bot commands, which have no per-channel TelegramBotToken of their own.
+	// Override with MCP_WATCH_TELEGRAM_BOT_TOKEN.
+	BotToken string `mapstructure:"bot_token"`
+
+	// SecretToken verifies inbound updates on
+	// /api/v1/integrations/telegram/webhook came from Telegram (set via the
+	// secret_token parameter of setWebhook). Required for that endpoint to
+	// accept requests; leave empty to reject all of them. Override with
+	// MCP_WATCH_TELEGRAM_SECRET_TOKEN.
+	SecretToken string `mapstructure:"secret_token"`
 }
 
 // EmailConfig holds email notification configuration.
 type EmailConfig struct {
-	Enabled       bool       `mapstructure:"enabled"`
-	SMTP          SMTPConfig `mapstructure:"smtp"`
-	RetryAttempts int        `mapstructure:"retry_attempts"`
+	Enabled bool `mapstructure:"enabled"`
+
+	// Provider selects the delivery transport. "smtp" (the default) dials
+	// SMTP.Host directly; the others call the provider's HTTP API instead,
+	// for deployments where outbound SMTP is blocked.
+	Provider string `mapstructure:"provider" validate:"omitempty,oneof=smtp ses sendgrid mailgun"`
+
+	SMTP     SMTPConfig     `mapstructure:"smtp"`
+	SES      EmailSESConfig `mapstructure:"ses"`
+	SendGrid SendGridConfig `mapstructure:"sendgrid"`
+	Mailgun  MailgunConfig  `mapstructure:"mailgun"`
+
+	RetryAttempts int           `mapstructure:"retry_attempts"`
 	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+
+	// UnsubscribeURL is the base URL of this server's GET/POST /unsubscribe
+	// endpoint, embedded in every notification email's List-Unsubscribe
+	// header and footer link. Empty omits unsubscribe links entirely.
+	UnsubscribeURL string `mapstructure:"unsubscribe_url"`
+
+	// SecretKey signs unsubscribe tokens so a link can't be forged to
+	// unsubscribe or reconfigure someone else's channel. Override with
+	// MCP_WATCH_EMAIL_UNSUBSCRIBE_SECRET; required for UnsubscribeURL to be
+	// usable.
+	SecretKey string `mapstructure:"secret_key"`
+
+	// BounceWebhookSecret authenticates inbound bounce/complaint webhooks
+	// from SES and SendGrid, neither of which signs requests in a way this
+	// module verifies: their webhook URLs must be configured with this
+	// value as a "secret" query parameter. Mailgun instead signs its own
+	// payload with Mailgun.SigningKey. Empty rejects all three webhooks.
+	BounceWebhookSecret string `mapstructure:"bounce_webhook_secret"`
 }
 
 // SMTPConfig holds SMTP server configuration.
@@ -117,6 +533,38 @@ type SMTPConfig struct {
 	TLS      bool   `mapstructure:"tls"`
 }
 
+// EmailSESConfig holds Amazon SES API configuration, used when
+// EmailConfig.Provider is "ses". Credentials are resolved from the
+// environment via the AWS SDK's default credential chain, same as the sns
+// and eventbridge senders.
+type EmailSESConfig struct {
+	// Region overrides the region resolved from the default credential
+	// chain, if set.
+	Region string `mapstructure:"region"`
+}
+
+// SendGridConfig holds SendGrid API configuration, used when
+// EmailConfig.Provider is "sendgrid".
+type SendGridConfig struct {
+	APIKey string `mapstructure:"api_key"` // Override with MCP_WATCH_SENDGRID_API_KEY
+}
+
+// MailgunConfig holds Mailgun API configuration, used when
+// EmailConfig.Provider is "mailgun".
+type MailgunConfig struct {
+	APIKey string `mapstructure:"api_key"` // Override with MCP_WATCH_MAILGUN_API_KEY
+	Domain string `mapstructure:"domain"`
+
+	// BaseURL defaults to https://api.mailgun.net/v3; use
+	// https://api.eu.mailgun.net/v3 for domains registered in Mailgun's EU
+	// region.
+	BaseURL string `mapstructure:"base_url"`
+
+	// SigningKey verifies inbound bounce/complaint webhooks came from
+	// Mailgun. Override with MCP_WATCH_MAILGUN_SIGNING_KEY.
+	SigningKey string `mapstructure:"signing_key"`
+}
+
 // WebhookConfig holds generic webhook configuration.
 type WebhookConfig struct {
 	Enabled       bool          `mapstructure:"enabled"`
@@ -126,6 +574,110 @@ type WebhookConfig struct {
 	MaxBodySize   int64         `mapstructure:"max_body_size"` // Max response body size
 }
 
+// AlertmanagerConfig holds Prometheus Alertmanager notification configuration.
+type AlertmanagerConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Timeout       time.Duration `mapstructure:"timeout"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// SNSConfig holds AWS SNS notification configuration. Credentials are
+// resolved from the environment (env vars, shared config/credentials
+// files, or an EC2/ECS role) via the AWS SDK's default credential chain,
+// not read from this config.
+type SNSConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Region overrides the AWS region resolved from the default credential
+	// chain, if set.
+	Region        string        `mapstructure:"region"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// EventBridgeConfig holds AWS EventBridge notification configuration.
+// Credentials are resolved the same way as SNSConfig.
+type EventBridgeConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Region        string        `mapstructure:"region"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// GoogleChatConfig holds Google Chat notification configuration.
+type GoogleChatConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// ZulipConfig holds Zulip notification configuration. Site/bot credentials
+// are shared across all Zulip channels; the destination stream/topic are
+// configured per channel.
+type ZulipConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Site          string        `mapstructure:"site"`
+	BotEmail      string        `mapstructure:"bot_email"`
+	BotAPIKey     string        `mapstructure:"bot_api_key"` // Override with MCP_WATCH_ZULIP_BOT_API_KEY
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// MattermostConfig holds Mattermost notification configuration.
+type MattermostConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// RocketChatConfig holds Rocket.Chat notification configuration.
+type RocketChatConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// PushConfig holds ntfy.sh/Gotify push notification configuration.
+type PushConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// AppriseConfig holds Apprise API notification configuration.
+type AppriseConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// SMSConfig holds SMS notification configuration for critical subscriptions.
+type SMSConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Provider selects the SMS backend. "twilio" is the only built-in
+	// option today.
+	Provider string `mapstructure:"provider" validate:"omitempty,oneof=twilio"`
+
+	Twilio TwilioConfig `mapstructure:"twilio"`
+
+	// MaxPerSubscriptionPerHour strictly caps how many SMS a single
+	// subscription can trigger per hour, regardless of change volume.
+	MaxPerSubscriptionPerHour int `mapstructure:"max_per_subscription_per_hour"`
+
+	RetryAttempts int           `mapstructure:"retry_attempts"`
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+}
+
+// TwilioConfig holds Twilio account credentials shared by all SMS channels.
+// Override the token with the MCP_WATCH_TWILIO_AUTH_TOKEN environment
+// variable rather than committing it to config.
+type TwilioConfig struct {
+	AccountSID string `mapstructure:"account_sid"`
+	AuthToken  string `mapstructure:"auth_token"`
+	FromNumber string `mapstructure:"from_number"`
+}
+
 // RSSConfig holds RSS/Atom feed configuration.
 type RSSConfig struct {
 	Enabled      bool   `mapstructure:"enabled"`
@@ -133,6 +685,11 @@ type RSSConfig struct {
 	Title        string `mapstructure:"title"`
 	Description  string `mapstructure:"description"`
 	BaseURL      string `mapstructure:"base_url"`
+
+	// SigningKey, if set, is used to HMAC-SHA256 sign rendered feed bodies
+	// (see X-Feed-Signature on the feed endpoints), so a reader can verify
+	// a feed came from this server unmodified. Empty disables signing.
+	SigningKey string `mapstructure:"signing_key"`
 }
 
 // TelemetryConfig holds observability configuration.
@@ -155,8 +712,10 @@ type TracingConfig struct {
 	SampleRate  float64 `mapstructure:"sample_rate" validate:"min=0,max=1"`
 }
 
-// Load loads configuration from file and environment variables.
-func Load() (*Config, error) {
+// newViper builds a viper instance with defaults, config file search paths,
+// and environment variable binding set up, but nothing read yet. Shared by
+// Load and Watch so both resolve the config file the same way.
+func newViper() *viper.Viper {
 	v := viper.New()
 
 	// Set defaults
@@ -179,6 +738,11 @@ func Load() (*Config, error) {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
+	return v
+}
+
+// loadFromViper reads and validates a Config out of an already-configured v.
+func loadFromViper(v *viper.Viper) (*Config, error) {
 	// Read config file (if exists)
 	if err := v.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -206,6 +770,60 @@ func Load() (*Config, error) {
 	return &cfg, nil
 }
 
+// Load loads configuration from file and environment variables.
+func Load() (*Config, error) {
+	return loadFromViper(newViper())
+}
+
+// Watch loads the configuration and then keeps watching for changes to the
+// config file (via fsnotify) and SIGHUP, calling onChange with the freshly
+// reloaded config each time either fires. A reload that fails to parse or
+// validate is logged to stderr and skipped, leaving the previous config in
+// effect, so a typo in a live config file can't take the process down. It
+// returns the initial config and a stop function that ends the watch; the
+// caller is responsible for calling stop during shutdown.
+func Watch(onChange func(*Config)) (*Config, func(), error) {
+	v := newViper()
+
+	cfg, err := loadFromViper(v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reload := func(source string) {
+		newCfg, err := loadFromViper(v)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: config reload from %s failed, keeping previous config: %v\n", source, err)
+			return
+		}
+		onChange(newCfg)
+	}
+
+	v.OnConfigChange(func(fsnotify.Event) { reload("file") })
+	v.WatchConfig()
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-sighup:
+				reload("SIGHUP")
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	stop := func() {
+		signal.Stop(sighup)
+		close(done)
+	}
+
+	return cfg, stop, nil
+}
+
 func setDefaults(v *viper.Viper) {
 	// Server defaults
 	v.SetDefault("server.host", "0.0.0.0")
@@ -217,10 +835,13 @@ func setDefaults(v *viper.Viper) {
 	// Registry defaults
 	v.SetDefault("registry.url", "https://registry.modelcontextprotocol.io")
 	v.SetDefault("registry.poll_interval", "5m")
+	v.SetDefault("registry.max_poll_interval", "30m")
 	v.SetDefault("registry.timeout", "30s")
 	v.SetDefault("registry.retry_attempts", 3)
 	v.SetDefault("registry.retry_delay", "5s")
 	v.SetDefault("registry.user_agent", "MCP-Notify/1.0")
+	v.SetDefault("registry.verify_changes", false)
+	v.SetDefault("registry.verification_delay", "2m")
 
 	// Database defaults
 	v.SetDefault("database.max_connections", 25)
@@ -263,13 +884,54 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("notifications.rss.title", "MCP Registry Changes")
 	v.SetDefault("notifications.rss.description", "Recent changes in the MCP Registry")
 
+	v.SetDefault("notifications.retry_queue_interval", "30s")
+
 	// Telemetry defaults
+	v.SetDefault("security.enabled", false)
+	v.SetDefault("security.osv_base_url", "https://api.osv.dev")
+	v.SetDefault("security.timeout", "10s")
+	v.SetDefault("security.retry_attempts", 3)
+	v.SetDefault("security.retry_delay", "2s")
+	v.SetDefault("security.min_score_threshold", 70)
+
+	v.SetDefault("provenance.enabled", false)
+	v.SetDefault("provenance.npm_registry_url", "https://registry.npmjs.org")
+	v.SetDefault("provenance.pypi_registry_url", "https://pypi.org")
+	v.SetDefault("provenance.timeout", "10s")
+	v.SetDefault("provenance.retry_attempts", 3)
+	v.SetDefault("provenance.retry_delay", "2s")
+
+	v.SetDefault("summarizer.enabled", false)
+	v.SetDefault("summarizer.model", "gpt-4o-mini")
+	v.SetDefault("summarizer.timeout", "10s")
+	v.SetDefault("summarizer.retry_attempts", 2)
+	v.SetDefault("summarizer.retry_delay", "2s")
+
+	v.SetDefault("auth.enabled", false)
+	v.SetDefault("auth.provider_name", "oidc")
+	v.SetDefault("auth.scopes", []string{"openid", "email"})
+	v.SetDefault("auth.timeout", "10s")
+	v.SetDefault("auth.session_duration", "168h")
+
 	v.SetDefault("telemetry.metrics.enabled", true)
 	v.SetDefault("telemetry.metrics.port", 9090)
 	v.SetDefault("telemetry.tracing.enabled", false)
 	v.SetDefault("telemetry.tracing.service_name", "mcp-notify")
 	v.SetDefault("telemetry.tracing.sample_rate", 0.1)
 
+	// Transparency log defaults
+	v.SetDefault("transparency.enabled", false)
+
+	// Event bus defaults
+	v.SetDefault("event_bus.enabled", false)
+	v.SetDefault("event_bus.flush_interval", "5s")
+	v.SetDefault("event_bus.nats.stream", "mcp-notify-changes")
+
+	// Rate limit defaults
+	v.SetDefault("rate_limit.enabled", false)
+	v.SetDefault("rate_limit.default.limit", 100)
+	v.SetDefault("rate_limit.default.window", "1m")
+
 	// Log level default
 	v.SetDefault("log_level", "info")
 }
@@ -303,5 +965,101 @@ func overrideFromEnv(cfg Config) Config {
 		cfg.Notifications.Email.SMTP.Password = password
 	}
 
+	// Override summarizer API key from env
+	if key := os.Getenv("MCP_WATCH_SUMMARIZER_API_KEY"); key != "" {
+		cfg.Summarizer.APIKey = key
+	}
+
+	// Override Slack signing secret from env
+	if secret := os.Getenv("MCP_WATCH_SLACK_SIGNING_SECRET"); secret != "" {
+		cfg.Notifications.Slack.SigningSecret = secret
+	}
+
+	// Override Discord bot token from env
+	if token := os.Getenv("MCP_WATCH_DISCORD_BOT_TOKEN"); token != "" {
+		cfg.Notifications.Discord.Bot.Token = token
+	}
+
+	// Override email unsubscribe token secret from env
+	if secret := os.Getenv("MCP_WATCH_EMAIL_UNSUBSCRIBE_SECRET"); secret != "" {
+		cfg.Notifications.Email.SecretKey = secret
+	}
+
+	// Override SendGrid API key from env
+	if key := os.Getenv("MCP_WATCH_SENDGRID_API_KEY"); key != "" {
+		cfg.Notifications.Email.SendGrid.APIKey = key
+	}
+
+	// Override Mailgun API key and webhook signing key from env
+	if key := os.Getenv("MCP_WATCH_MAILGUN_API_KEY"); key != "" {
+		cfg.Notifications.Email.Mailgun.APIKey = key
+	}
+	if key := os.Getenv("MCP_WATCH_MAILGUN_SIGNING_KEY"); key != "" {
+		cfg.Notifications.Email.Mailgun.SigningKey = key
+	}
+
+	// Override Telegram bot token from env
+	if token := os.Getenv("MCP_WATCH_TELEGRAM_BOT_TOKEN"); token != "" {
+		cfg.Notifications.Telegram.Bot.BotToken = token
+	}
+
+	// Override Telegram bot secret token from env
+	if secret := os.Getenv("MCP_WATCH_TELEGRAM_SECRET_TOKEN"); secret != "" {
+		cfg.Notifications.Telegram.Bot.SecretToken = secret
+	}
+
+	// Override transparency log signing key seed from env
+	if seed := os.Getenv("MCP_WATCH_TRANSPARENCY_SIGNING_KEY_SEED"); seed != "" {
+		cfg.Transparency.SigningKeySeed = seed
+	}
+
+	// Override admin API key from env (for sensitive credentials)
+	if key := os.Getenv("MCP_WATCH_ADMIN_API_KEY"); key != "" {
+		cfg.Server.AdminAPIKey = key
+	}
+
 	return cfg
 }
+
+// ResolveSecretRefs resolves every secret-bearing field in cfg that holds a
+// reference (see internal/secrets.IsReference) rather than a plaintext
+// value, replacing it with the value fetched from the referenced Vault, AWS
+// Secrets Manager, or GCP Secret Manager backend. Fields already holding a
+// plaintext value (or the zero value) are left untouched. Called once after
+// Load and again on every Watch reload, so credential rotation in the
+// backing secret store takes effect without restarting the process.
+func ResolveSecretRefs(ctx context.Context, cfg *Config) error {
+	resolver := secrets.NewResolver()
+
+	fields := []*string{
+		&cfg.Server.AdminAPIKey,
+		&cfg.Summarizer.APIKey,
+		&cfg.Notifications.Slack.SigningSecret,
+		&cfg.Notifications.Discord.Bot.Token,
+		&cfg.Notifications.Email.SMTP.Password,
+		&cfg.Notifications.Email.SecretKey,
+		&cfg.Notifications.Email.BounceWebhookSecret,
+		&cfg.Notifications.Email.SendGrid.APIKey,
+		&cfg.Notifications.Email.Mailgun.APIKey,
+		&cfg.Notifications.Email.Mailgun.SigningKey,
+		&cfg.Notifications.Telegram.Bot.BotToken,
+		&cfg.Notifications.Telegram.Bot.SecretToken,
+		&cfg.Notifications.SMS.Twilio.AuthToken,
+		&cfg.Notifications.Zulip.BotAPIKey,
+		&cfg.Notifications.RSS.SigningKey,
+		&cfg.Transparency.SigningKeySeed,
+	}
+
+	for _, field := range fields {
+		if !secrets.IsReference(*field) {
+			continue
+		}
+		resolved, err := resolver.Resolve(ctx, *field)
+		if err != nil {
+			return fmt.Errorf("failed to resolve secret reference %q: %w", *field, err)
+		}
+		*field = resolved
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService is the service name API keys are stored under in the OS
+// keychain (macOS Keychain, Secret Service on Linux, Windows Credential
+// Manager).
+const keyringService = "mcp-notify-cli"
+
+// keyringAccount maps a profile name to the keychain account it's stored
+// under. The empty profile (top-level config, no --profile) uses "default",
+// the same account a profile literally named "default" is stored under, so
+// the two share one stored key.
+func keyringAccount(profile string) string {
+	if profile == "" {
+		return "default"
+	}
+	return profile
+}
+
+// SaveAPIKeyToKeyring stores an API key in the OS keychain for the given
+// profile ("" for the default account).
+func SaveAPIKeyToKeyring(profile, apiKey string) error {
+	return keyring.Set(keyringService, keyringAccount(profile), apiKey)
+}
+
+// LoadAPIKeyFromKeyring returns the API key stored in the OS keychain for
+// the given profile. It returns "", nil (not an error) when no key is
+// stored, so callers can transparently fall back to the plaintext config
+// field on a fresh install or an unsupported platform.
+func LoadAPIKeyFromKeyring(profile string) (string, error) {
+	key, err := keyring.Get(keyringService, keyringAccount(profile))
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return key, nil
+}
+
+// DeleteAPIKeyFromKeyring removes the stored API key for the given
+// profile, if any. Deleting a key that isn't present is not an error.
+func DeleteAPIKeyFromKeyring(profile string) error {
+	err := keyring.Delete(keyringService, keyringAccount(profile))
+	if err != nil && errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// hashServer returns the content hash used to address a server record in
+// server_blobs: two servers with identical data always hash the same,
+// regardless of which snapshot or poll produced them.
+func hashServer(server types.Server) (string, error) {
+	data, err := json.Marshal(server)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// saveServerBlobs hashes each server in servers, upserts any hash not
+// already present in server_blobs, and returns the name-to-hash mapping to
+// store on the snapshot row. Blobs are content-addressed, so servers
+// unchanged since a previous poll resolve to an existing row and are never
+// duplicated.
+func (db *PostgresDB) saveServerBlobs(ctx context.Context, servers map[string]types.Server) (map[string]string, error) {
+	refs := make(map[string]string, len(servers))
+
+	for name, server := range servers {
+		hash, err := hashServer(server)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash server %s: %w", name, err)
+		}
+		refs[name] = hash
+
+		data, err := json.Marshal(server)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal server %s: %w", name, err)
+		}
+		if _, err := db.pool.Exec(ctx,
+			`INSERT INTO server_blobs (hash, data) VALUES ($1, $2) ON CONFLICT (hash) DO NOTHING`,
+			hash, data,
+		); err != nil {
+			return nil, fmt.Errorf("failed to save server blob %s: %w", hash, err)
+		}
+	}
+
+	return refs, nil
+}
+
+// loadServerBlobs resolves a name-to-hash mapping into the full server
+// records it references.
+func (db *PostgresDB) loadServerBlobs(ctx context.Context, refs map[string]string) (map[string]types.Server, error) {
+	hashes := make([]string, 0, len(refs))
+	seen := make(map[string]bool, len(refs))
+	for _, hash := range refs {
+		if !seen[hash] {
+			seen[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+
+	blobs := make(map[string]types.Server, len(hashes))
+	if len(hashes) > 0 {
+		rows, err := db.pool.Query(ctx, `SELECT hash, data FROM server_blobs WHERE hash = ANY($1)`, hashes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load server blobs: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var hash string
+			var data []byte
+			if err := rows.Scan(&hash, &data); err != nil {
+				return nil, err
+			}
+			var server types.Server
+			if err := json.Unmarshal(data, &server); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal server blob %s: %w", hash, err)
+			}
+			blobs[hash] = server
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	servers := make(map[string]types.Server, len(refs))
+	for name, hash := range refs {
+		server, ok := blobs[hash]
+		if !ok {
+			return nil, fmt.Errorf("server blob %s not found", hash)
+		}
+		servers[name] = server
+	}
+
+	return servers, nil
+}
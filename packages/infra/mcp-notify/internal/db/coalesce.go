@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// UpsertCoalescedUpdate records an "updated" change against a subscription's
+// in-progress coalescing burst for that server, creating one if none is
+// pending. Each call extends last_seen_at, so a steady stream of updates
+// keeps deferring the flush until it stops.
+func (db *PostgresDB) UpsertCoalescedUpdate(ctx context.Context, subscriptionID uuid.UUID, coalesceWindow time.Duration, change *types.Change) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO pending_coalesced_updates
+			(id, subscription_id, server_name, first_change_id, latest_change_id, update_count, coalesce_window, first_seen_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, $4, 1, $5, NOW(), NOW())
+		ON CONFLICT (subscription_id, server_name) DO UPDATE SET
+			latest_change_id = $4,
+			update_count = pending_coalesced_updates.update_count + 1,
+			coalesce_window = $5,
+			last_seen_at = NOW()`,
+		uuid.New(), subscriptionID, change.ServerName, change.ID, int64(coalesceWindow),
+	)
+	return err
+}
+
+// GetReadyCoalescedUpdates returns pending coalescing bursts whose window
+// has elapsed since their last update, i.e. ready to flush as a single
+// combined notification.
+func (db *PostgresDB) GetReadyCoalescedUpdates(ctx context.Context, now time.Time) ([]types.PendingCoalescedUpdate, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, subscription_id, server_name, first_change_id, latest_change_id, update_count, coalesce_window, first_seen_at, last_seen_at
+		FROM pending_coalesced_updates
+		WHERE last_seen_at + (coalesce_window / 1000000000.0) * INTERVAL '1 second' <= $1`,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var updates []types.PendingCoalescedUpdate
+	for rows.Next() {
+		var u types.PendingCoalescedUpdate
+		var coalesceWindowNs int64
+		if err := rows.Scan(&u.ID, &u.SubscriptionID, &u.ServerName, &u.FirstChangeID, &u.LatestChangeID,
+			&u.UpdateCount, &coalesceWindowNs, &u.FirstSeenAt, &u.LastSeenAt); err != nil {
+			return nil, err
+		}
+		u.CoalesceWindow = time.Duration(coalesceWindowNs)
+		updates = append(updates, u)
+	}
+	return updates, rows.Err()
+}
+
+// DeleteCoalescedUpdate removes a pending burst once it has been flushed.
+func (db *PostgresDB) DeleteCoalescedUpdate(ctx context.Context, id uuid.UUID) error {
+	_, err := db.pool.Exec(ctx, `DELETE FROM pending_coalesced_updates WHERE id = $1`, id)
+	return err
+}
@@ -0,0 +1,280 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// snapshotDelta is the on-disk representation of a delta-encoded snapshot:
+// the servers that were added or changed relative to the base snapshot, and
+// the names of servers the base snapshot had but this one doesn't.
+type snapshotDelta struct {
+	Changed map[string]types.Server `json:"changed,omitempty"`
+	Removed []string                `json:"removed,omitempty"`
+}
+
+// computeSnapshotDelta returns the delta that turns base into full.
+func computeSnapshotDelta(base, full map[string]types.Server) (snapshotDelta, error) {
+	delta := snapshotDelta{Changed: make(map[string]types.Server)}
+
+	for name, server := range full {
+		baseServer, ok := base[name]
+		if !ok {
+			delta.Changed[name] = server
+			continue
+		}
+		baseJSON, err := json.Marshal(baseServer)
+		if err != nil {
+			return snapshotDelta{}, err
+		}
+		fullJSON, err := json.Marshal(server)
+		if err != nil {
+			return snapshotDelta{}, err
+		}
+		if string(baseJSON) != string(fullJSON) {
+			delta.Changed[name] = server
+		}
+	}
+
+	for name := range base {
+		if _, ok := full[name]; !ok {
+			delta.Removed = append(delta.Removed, name)
+		}
+	}
+
+	return delta, nil
+}
+
+// applySnapshotDelta reconstructs a full server set by applying delta on
+// top of base. base is never mutated.
+func applySnapshotDelta(base map[string]types.Server, delta snapshotDelta) map[string]types.Server {
+	full := make(map[string]types.Server, len(base)+len(delta.Changed))
+	for name, server := range base {
+		full[name] = server
+	}
+	for _, name := range delta.Removed {
+		delete(full, name)
+	}
+	for name, server := range delta.Changed {
+		full[name] = server
+	}
+	return full
+}
+
+// resolveSnapshotServers returns the fully-materialized server set for a
+// snapshot row. It handles all three representations a row can have, in
+// order of precedence: delta-encoded against a base snapshot (set by
+// compaction), content-addressed blob references (the normal write path),
+// or a full servers_data blob (legacy rows written before server_blobs).
+// Chain depth for delta-encoded rows is bounded by how many compaction runs
+// have touched this snapshot's lineage since the last full snapshot in it.
+func (db *PostgresDB) resolveSnapshotServers(ctx context.Context, serversData, deltaData, blobRefsData []byte, baseSnapshotID *uuid.UUID) (map[string]types.Server, error) {
+	if baseSnapshotID != nil {
+		var delta snapshotDelta
+		if err := json.Unmarshal(deltaData, &delta); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal snapshot delta: %w", err)
+		}
+
+		base, err := db.GetSnapshotByID(ctx, *baseSnapshotID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve base snapshot: %w", err)
+		}
+		if base == nil {
+			return nil, fmt.Errorf("base snapshot %s not found", baseSnapshotID)
+		}
+
+		return applySnapshotDelta(base.Servers, delta), nil
+	}
+
+	if blobRefsData != nil {
+		var refs map[string]string
+		if err := json.Unmarshal(blobRefsData, &refs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal blob refs: %w", err)
+		}
+		return db.loadServerBlobs(ctx, refs)
+	}
+
+	var servers map[string]types.Server
+	if err := json.Unmarshal(serversData, &servers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal servers: %w", err)
+	}
+	return servers, nil
+}
+
+// snapshotRow is the subset of a snapshots row CompactSnapshots needs to
+// decide what to keep and how to encode it.
+type snapshotRow struct {
+	id        uuid.UUID
+	timestamp time.Time
+}
+
+// CompactSnapshots downsamples poll history: within policy.FullWindow every
+// snapshot is left untouched; between FullWindow and HourlyWindow only the
+// latest snapshot per hour is kept; beyond HourlyWindow only the latest
+// snapshot per day is kept. Kept snapshots outside the full-resolution
+// window are delta-encoded against the previous kept snapshot to avoid
+// storing another full servers_data blob.
+func (db *PostgresDB) CompactSnapshots(ctx context.Context, policy types.RetentionPolicy) (types.CompactionReport, error) {
+	var report types.CompactionReport
+	now := time.Now()
+	fullCutoff := now.Add(-policy.FullWindow)
+	hourlyCutoff := now.Add(-policy.HourlyWindow)
+
+	// Process oldest-first so the delta chain always extends forward from
+	// whatever was already compacted.
+	if err := db.compactBucket(ctx, time.Time{}, hourlyCutoff, "day", &report); err != nil {
+		return report, fmt.Errorf("failed to compact daily buckets: %w", err)
+	}
+	if err := db.compactBucket(ctx, hourlyCutoff, fullCutoff, "hour", &report); err != nil {
+		return report, fmt.Errorf("failed to compact hourly buckets: %w", err)
+	}
+
+	return report, nil
+}
+
+// compactBucket downsamples snapshots in [start, end) (a zero start means
+// "no lower bound") to one per bucket ("hour" or "day"), keeping the latest
+// snapshot in each bucket and deleting the rest. Already-compacted
+// snapshots (compacted_at IS NOT NULL) are left alone, so repeated runs
+// only touch newly-eligible snapshots.
+func (db *PostgresDB) compactBucket(ctx context.Context, start, end time.Time, bucket string, report *types.CompactionReport) error {
+	query := `SELECT id, timestamp FROM snapshots WHERE timestamp < $1 AND compacted_at IS NULL`
+	args := []any{end}
+	if !start.IsZero() {
+		query += ` AND timestamp >= $2`
+		args = append(args, start)
+	}
+	query += ` ORDER BY timestamp ASC`
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	var candidates []snapshotRow
+	for rows.Next() {
+		var row snapshotRow
+		if err := rows.Scan(&row.id, &row.timestamp); err != nil {
+			rows.Close()
+			return err
+		}
+		candidates = append(candidates, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	prevKeptID, err := db.lastCompactedSnapshotBefore(ctx, candidates[0].timestamp)
+	if err != nil {
+		return err
+	}
+
+	buckets := make(map[string][]snapshotRow)
+	var order []string
+	for _, row := range candidates {
+		key := bucketKey(row.timestamp, bucket)
+		if _, ok := buckets[key]; !ok {
+			order = append(order, key)
+		}
+		buckets[key] = append(buckets[key], row)
+	}
+
+	for _, key := range order {
+		rowsInBucket := buckets[key]
+		kept := rowsInBucket[len(rowsInBucket)-1]
+
+		for _, row := range rowsInBucket[:len(rowsInBucket)-1] {
+			if _, err := db.pool.Exec(ctx, `DELETE FROM snapshots WHERE id = $1`, row.id); err != nil {
+				return fmt.Errorf("failed to delete redundant snapshot %s: %w", row.id, err)
+			}
+			report.SnapshotsRemoved++
+		}
+
+		if prevKeptID != nil {
+			if err := db.deltaEncodeSnapshot(ctx, kept.id, *prevKeptID); err != nil {
+				return fmt.Errorf("failed to delta-encode snapshot %s: %w", kept.id, err)
+			}
+			report.SnapshotsDeltaEncoded++
+		} else if _, err := db.pool.Exec(ctx, `UPDATE snapshots SET compacted_at = NOW() WHERE id = $1`, kept.id); err != nil {
+			return fmt.Errorf("failed to mark snapshot %s compacted: %w", kept.id, err)
+		}
+
+		id := kept.id
+		prevKeptID = &id
+	}
+
+	return nil
+}
+
+// deltaEncodeSnapshot rewrites snapshot id to store its delta against base
+// instead of a full servers_data blob.
+func (db *PostgresDB) deltaEncodeSnapshot(ctx context.Context, id, base uuid.UUID) error {
+	snapshot, err := db.GetSnapshotByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot %s not found", id)
+	}
+
+	baseSnapshot, err := db.GetSnapshotByID(ctx, base)
+	if err != nil {
+		return err
+	}
+	if baseSnapshot == nil {
+		return fmt.Errorf("base snapshot %s not found", base)
+	}
+
+	delta, err := computeSnapshotDelta(baseSnapshot.Servers, snapshot.Servers)
+	if err != nil {
+		return err
+	}
+
+	deltaData, err := json.Marshal(delta)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.pool.Exec(ctx,
+		`UPDATE snapshots SET servers_data = NULL, blob_refs = NULL, base_snapshot_id = $1, delta_data = $2, compacted_at = NOW() WHERE id = $3`,
+		base, deltaData, id,
+	)
+	return err
+}
+
+// lastCompactedSnapshotBefore returns the ID of the most recently timestamped
+// already-compacted snapshot before t, or nil if there isn't one.
+func (db *PostgresDB) lastCompactedSnapshotBefore(ctx context.Context, t time.Time) (*uuid.UUID, error) {
+	var id uuid.UUID
+	err := db.pool.QueryRow(ctx,
+		`SELECT id FROM snapshots WHERE timestamp < $1 AND compacted_at IS NOT NULL ORDER BY timestamp DESC LIMIT 1`, t,
+	).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// bucketKey returns the bucket a timestamp falls into for downsampling
+// purposes: the hour or day it occurred in, UTC.
+func bucketKey(t time.Time, bucket string) string {
+	t = t.UTC()
+	if bucket == "day" {
+		return t.Format("2006-01-02")
+	}
+	return t.Format("2006-01-02T15")
+}
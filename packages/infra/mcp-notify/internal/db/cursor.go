@@ -0,0 +1,50 @@
+package db
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangesCursor is an opaque keyset pagination cursor for GetChangesSincePage.
+// It pins both the detected_at timestamp and the change ID of the last row
+// seen, so pagination stays stable even when multiple changes share the same
+// detected_at value.
+type ChangesCursor struct {
+	DetectedAt time.Time
+	ID         uuid.UUID
+}
+
+// EncodeChangesCursor returns the opaque token clients pass back as ?cursor=.
+func EncodeChangesCursor(c ChangesCursor) string {
+	raw := fmt.Sprintf("%s|%s", c.DetectedAt.Format(time.RFC3339Nano), c.ID.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeChangesCursor parses a token produced by EncodeChangesCursor.
+func DecodeChangesCursor(token string) (ChangesCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ChangesCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return ChangesCursor{}, fmt.Errorf("invalid cursor: malformed token")
+	}
+
+	detectedAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return ChangesCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return ChangesCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	return ChangesCursor{DetectedAt: detectedAt, ID: id}, nil
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// UpsertServerEmbedding stores or replaces a server's semantic-search
+// embedding.
+func (db *PostgresDB) UpsertServerEmbedding(ctx context.Context, embedding types.ServerEmbedding) error {
+	data, err := json.Marshal(embedding.Embedding)
+	if err != nil {
+		return fmt.Errorf("failed to marshal embedding for %s: %w", embedding.ServerName, err)
+	}
+
+	if _, err := db.pool.Exec(ctx,
+		`INSERT INTO server_embeddings (server_name, content_hash, embedding, updated_at)
+		 VALUES ($1, $2, $3, NOW())
+		 ON CONFLICT (server_name) DO UPDATE SET
+		   content_hash = EXCLUDED.content_hash,
+		   embedding = EXCLUDED.embedding,
+		   updated_at = EXCLUDED.updated_at`,
+		embedding.ServerName, embedding.ContentHash, data,
+	); err != nil {
+		return fmt.Errorf("failed to upsert embedding for %s: %w", embedding.ServerName, err)
+	}
+	return nil
+}
+
+// ListServerEmbeddings returns every cached server embedding.
+func (db *PostgresDB) ListServerEmbeddings(ctx context.Context) ([]types.ServerEmbedding, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT server_name, content_hash, embedding, updated_at FROM server_embeddings`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list server embeddings: %w", err)
+	}
+	defer rows.Close()
+
+	var embeddings []types.ServerEmbedding
+	for rows.Next() {
+		var e types.ServerEmbedding
+		var data []byte
+		if err := rows.Scan(&e.ServerName, &e.ContentHash, &data, &e.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan server embedding: %w", err)
+		}
+		if err := json.Unmarshal(data, &e.Embedding); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal embedding for %s: %w", e.ServerName, err)
+		}
+		embeddings = append(embeddings, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate server embeddings: %w", err)
+	}
+	return embeddings, nil
+}
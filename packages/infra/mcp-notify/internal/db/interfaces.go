@@ -21,14 +21,37 @@ type Database interface {
 	GetLatestSnapshot(ctx context.Context) (*types.Snapshot, error)
 	GetSnapshotByID(ctx context.Context, id uuid.UUID) (*types.Snapshot, error)
 	GetSnapshotAt(ctx context.Context, timestamp time.Time) (*types.Snapshot, error)
-	DeleteOldSnapshots(ctx context.Context, olderThan time.Time) error
+	DeleteOldSnapshots(ctx context.Context, olderThan time.Time) (int64, error)
+	// CompactSnapshots downsamples poll history according to policy,
+	// deleting redundant snapshots within each hour/day bucket and
+	// delta-encoding the ones that are kept. It is idempotent: snapshots
+	// already compacted are not reprocessed.
+	CompactSnapshots(ctx context.Context, policy types.RetentionPolicy) (types.CompactionReport, error)
 
 	// Changes
 	SaveChange(ctx context.Context, change *types.Change) error
+	// SaveChanges inserts multiple changes in a single round trip, for the
+	// per-poll write path where hundreds of changes can land at once.
+	// Existing IDs are skipped rather than erroring, matching SaveChange's
+	// own idempotency.
+	SaveChanges(ctx context.Context, changes []types.Change) error
+	// SaveChangesAndEnqueueNotification saves changes and, if entry is
+	// non-nil, inserts its notification outbox row in the same database
+	// transaction. Callers on the poll path should prefer this over calling
+	// SaveChanges and SaveNotificationOutboxEntry separately: since both
+	// writes commit or roll back together, a crash between them can no
+	// longer persist a change whose notification is never enqueued.
+	SaveChangesAndEnqueueNotification(ctx context.Context, changes []types.Change, entry *types.NotificationOutboxEntry) error
 	GetChangeByID(ctx context.Context, id uuid.UUID) (*types.Change, error)
 	GetChangesSince(ctx context.Context, since time.Time, limit int) ([]types.Change, error)
+	// GetChangesSincePage retrieves at most limit changes at or after since,
+	// starting after cursor (empty for the first page). It returns the page
+	// and the cursor for the next page, which is empty once there are no
+	// more results.
+	GetChangesSincePage(ctx context.Context, since time.Time, cursor string, limit int) ([]types.Change, string, error)
 	GetChangesForServer(ctx context.Context, serverName string, limit int) ([]types.Change, error)
 	GetChangeCountSince(ctx context.Context, since time.Time) (int, error)
+	PruneOldChanges(ctx context.Context, olderThan time.Time) (int64, error)
 
 	// Subscriptions
 	CreateSubscription(ctx context.Context, sub *types.Subscription) error
@@ -48,12 +71,125 @@ type Database interface {
 
 	// Notifications
 	SaveNotification(ctx context.Context, notification *types.Notification) error
+	// SaveNotifications inserts multiple pending notifications in a single
+	// round trip, for batch dispatch paths like DispatchBatchByChannel.
+	SaveNotifications(ctx context.Context, notifications []types.Notification) error
 	UpdateNotification(ctx context.Context, notification *types.Notification) error
 	GetPendingNotifications(ctx context.Context, limit int) ([]types.Notification, error)
 	GetNotificationsForSubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]types.Notification, error)
+	SearchNotifications(ctx context.Context, subscriptionID uuid.UUID, query string, limit int) ([]types.Notification, error)
+	PruneOldNotifications(ctx context.Context, olderThan time.Time) (int64, error)
+	// GetSubscriptionAnalytics returns time-bucketed delivery counts,
+	// per-channel success rates and median latency, and the noisiest
+	// servers for a subscription since the given time.
+	GetSubscriptionAnalytics(ctx context.Context, subscriptionID uuid.UUID, since time.Time, bucketSize time.Duration) (*types.SubscriptionAnalytics, error)
+
+	// Held notifications (delivery-schedule quiet-hours queue)
+	SaveHeldNotification(ctx context.Context, held *types.HeldNotification) error
+	GetHeldNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]types.HeldNotification, error)
+	DeleteHeldNotifications(ctx context.Context, subscriptionID uuid.UUID) error
+
+	// Coalesced updates (debounces rapid successive updates to one server
+	// into a single notification, per subscription.CoalesceWindow)
+	UpsertCoalescedUpdate(ctx context.Context, subscriptionID uuid.UUID, coalesceWindow time.Duration, change *types.Change) error
+	GetReadyCoalescedUpdates(ctx context.Context, now time.Time) ([]types.PendingCoalescedUpdate, error)
+	DeleteCoalescedUpdate(ctx context.Context, id uuid.UUID) error
+
+	// Event outbox (at-least-once delivery of changes to an external event bus)
+	SaveOutboxEvent(ctx context.Context, event *types.OutboxEvent) error
+	GetPendingOutboxEvents(ctx context.Context, limit int) ([]types.OutboxEvent, error)
+	DeleteOutboxEvent(ctx context.Context, id uuid.UUID) error
+	MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttempt time.Time, errMsg string) error
+
+	// Notification outbox (at-least-once dispatch of changes to subscriptions)
+	SaveNotificationOutboxEntry(ctx context.Context, entry *types.NotificationOutboxEntry) error
+	GetPendingNotificationOutboxEntries(ctx context.Context, limit int) ([]types.NotificationOutboxEntry, error)
+	DeleteNotificationOutboxEntry(ctx context.Context, id uuid.UUID) error
+	MarkNotificationOutboxEntryFailed(ctx context.Context, id uuid.UUID, nextAttempt time.Time, errMsg string) error
+
+	// Transparency log
+	SaveTransparencyEntry(ctx context.Context, entry *types.TransparencyEntry) error
+	GetTransparencyEntry(ctx context.Context, index int64) (*types.TransparencyEntry, error)
+	GetLatestTransparencyEntry(ctx context.Context) (*types.TransparencyEntry, error)
+	CountTransparencyEntries(ctx context.Context) (int64, error)
+
+	// Users (OIDC-backed accounts) and their personal access tokens
+	CreateUser(ctx context.Context, user *types.User) error
+	GetUserByID(ctx context.Context, id uuid.UUID) (*types.User, error)
+	GetUserBySubject(ctx context.Context, provider, subject string) (*types.User, error)
+	ListSubscriptionsForUser(ctx context.Context, userID uuid.UUID) ([]types.Subscription, error)
+
+	CreatePersonalAccessToken(ctx context.Context, token *types.PersonalAccessToken) error
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*types.PersonalAccessToken, error)
+	ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]types.PersonalAccessToken, error)
+	RevokePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) error
+	UpdatePersonalAccessTokenLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// Subscription API keys: additional, scoped credentials for a
+	// subscription beyond its original API key
+	CreateSubscriptionAPIKey(ctx context.Context, key *types.SubscriptionAPIKey) error
+	GetSubscriptionAPIKeyByHash(ctx context.Context, keyHash string) (*types.SubscriptionAPIKey, error)
+	ListSubscriptionAPIKeys(ctx context.Context, subscriptionID uuid.UUID) ([]types.SubscriptionAPIKey, error)
+	RevokeSubscriptionAPIKey(ctx context.Context, id, subscriptionID uuid.UUID) error
+	UpdateSubscriptionAPIKeyLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// Audit log of mutating operations
+	CreateAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error
+	ListAuditLogEntries(ctx context.Context, filter types.AuditLogFilter) ([]types.AuditLogEntry, error)
+	PruneOldAuditLogEntries(ctx context.Context, olderThan time.Time) (int64, error)
+	// AnonymizeSubscriptionAuditLog redacts any email address found in the
+	// before/after snapshots of a subscription's audit log entries, so
+	// deleting the subscription doesn't leave its channels' email addresses
+	// recoverable from audit history indefinitely. Returns the number of
+	// entries updated.
+	AnonymizeSubscriptionAuditLog(ctx context.Context, subscriptionID uuid.UUID) (int64, error)
+
+	// Organizations (team-owned subscriptions) and their memberships
+	CreateOrganization(ctx context.Context, org *types.Organization) error
+	GetOrganizationByID(ctx context.Context, id uuid.UUID) (*types.Organization, error)
+	GetOrganizationBySlug(ctx context.Context, slug string) (*types.Organization, error)
+	UpdateOrganization(ctx context.Context, org *types.Organization) error
+	DeleteOrganization(ctx context.Context, id uuid.UUID) error
+	ListOrganizationsForUser(ctx context.Context, userID uuid.UUID) ([]types.Organization, error)
+	CountSubscriptionsForOrganization(ctx context.Context, orgID uuid.UUID) (int, error)
+	ListSubscriptionsForOrganization(ctx context.Context, orgID uuid.UUID) ([]types.Subscription, error)
+	// CountNotificationsForOrganizationSince counts notifications sent by any
+	// of orgID's subscriptions since the given time, for enforcing
+	// Organization.MaxNotificationsPerMonth and reporting usage.
+	CountNotificationsForOrganizationSince(ctx context.Context, orgID uuid.UUID, since time.Time) (int, error)
+	// ListOrganizationsWithUsageWebhook returns every organization that has
+	// UsageWebhookURL set, for the usage webhook scheduler to iterate over.
+	ListOrganizationsWithUsageWebhook(ctx context.Context) ([]types.Organization, error)
+
+	AddOrganizationMember(ctx context.Context, member *types.OrganizationMember) error
+	GetOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) (*types.OrganizationMember, error)
+	ListOrganizationMembers(ctx context.Context, orgID uuid.UUID) ([]types.OrganizationMember, error)
+	RemoveOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) error
 
 	// Stats
 	GetStats(ctx context.Context) (*types.StatsResponse, error)
+
+	// Poll history (monitoring gap detection)
+	RecordPoll(ctx context.Context, record *types.PollRecord) error
+	GetPollHistorySince(ctx context.Context, since time.Time) ([]types.PollRecord, error)
+
+	// Semantic search embeddings
+	UpsertServerEmbedding(ctx context.Context, embedding types.ServerEmbedding) error
+	ListServerEmbeddings(ctx context.Context) ([]types.ServerEmbedding, error)
+
+	// Watchlists
+	CreateWatchlist(ctx context.Context, watchlist *types.Watchlist) error
+	GetWatchlistByAPIKeyHash(ctx context.Context, apiKeyHash string) (*types.Watchlist, error)
+	UpdateWatchlist(ctx context.Context, watchlist *types.Watchlist) error
+
+	// Maintenance
+	VacuumTables(ctx context.Context, tables []string) (int64, error)
+
+	// WithMaintenanceLock runs fn while holding a named advisory lock,
+	// returning false without running fn if another instance already holds
+	// it. Used to keep scheduled maintenance from overlapping the poller or
+	// another replica's maintenance run.
+	WithMaintenanceLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
 }
 
 // Cache defines the interface for caching operations.
@@ -66,10 +202,23 @@ type Cache interface {
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 
+	// SetWithNX sets key to value only if it doesn't already exist, for
+	// distributed locking (e.g. idempotency-key request de-duplication).
+	SetWithNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+
 	// Snapshot caching
 	GetCachedSnapshot(ctx context.Context) (*types.Snapshot, error)
 	SetCachedSnapshot(ctx context.Context, snapshot *types.Snapshot, ttl time.Duration) error
 
 	// Rate limiting
 	IncrementRateLimit(ctx context.Context, key string, window time.Duration) (int64, error)
+
+	// SlidingWindowAllow checks key against a sliding-window rate limit of
+	// limit requests per window, using a Redis sorted set so the count
+	// reflects a true rolling window (unlike IncrementRateLimit's naive
+	// fixed-window counter) and stays consistent across replicas. It
+	// returns whether the request is allowed, the number of requests
+	// remaining in the current window, and when the oldest request in the
+	// window will expire.
+	SlidingWindowAllow(ctx context.Context, key string, limit int64, window time.Duration) (allowed bool, remaining int64, resetAt time.Time, err error)
 }
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+// migrationsDir is the directory within embedMigrations goose reads
+// migration files from.
+const migrationsDir = "migrations"
+
+// openGooseDB opens a database/sql connection for goose, which drives
+// migrations through database/sql rather than pgx's native pool interface.
+func openGooseDB(ctx context.Context, url string) (*sql.DB, error) {
+	sqlDB, err := sql.Open("pgx", url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database connection: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return sqlDB, nil
+}
+
+// gooseSession opens a goose-ready connection to url and configures the base
+// filesystem and dialect shared by every migrate subcommand.
+func gooseSession(ctx context.Context, url string) (*sql.DB, error) {
+	sqlDB, err := openGooseDB(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	goose.SetBaseFS(embedMigrations)
+	if err := goose.SetDialect("postgres"); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to set goose dialect: %w", err)
+	}
+
+	return sqlDB, nil
+}
+
+// MigrateUp applies every pending goose migration embedded in migrations/,
+// for `mcp-notify migrate up` and the server's own startup migration step.
+func MigrateUp(ctx context.Context, url string) error {
+	sqlDB, err := gooseSession(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	return goose.UpContext(ctx, sqlDB, migrationsDir)
+}
+
+// MigrateDown rolls back the most recently applied goose migration, for
+// `mcp-notify migrate down`.
+func MigrateDown(ctx context.Context, url string) error {
+	sqlDB, err := gooseSession(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	return goose.DownContext(ctx, sqlDB, migrationsDir)
+}
+
+// MigrateStatus reports the applied/pending state of every embedded
+// migration, for `mcp-notify migrate status`.
+func MigrateStatus(ctx context.Context, url string) error {
+	sqlDB, err := gooseSession(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	return goose.StatusContext(ctx, sqlDB, migrationsDir)
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// SaveNotificationOutboxEntry queues entry for at-least-once dispatch to
+// subscriptions. The caller is expected to have already saved the
+// underlying Changes; SaveNotificationOutboxEntry does not itself fail the
+// poll if it errors, since a failed enqueue just means the entry is
+// retried the next time the caller's own save-failure handling runs.
+func (db *PostgresDB) SaveNotificationOutboxEntry(ctx context.Context, entry *types.NotificationOutboxEntry) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO notification_outbox (id, payload, next_attempt)
+		VALUES ($1, $2, $3)`,
+		entry.ID, entry.Payload, entry.NextAttempt,
+	)
+	return err
+}
+
+// GetPendingNotificationOutboxEntries retrieves up to limit entries due for
+// dispatch (next_attempt at or before now), oldest first.
+func (db *PostgresDB) GetPendingNotificationOutboxEntries(ctx context.Context, limit int) ([]types.NotificationOutboxEntry, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, payload, attempts, next_attempt, error, created_at
+		FROM notification_outbox WHERE next_attempt <= NOW() ORDER BY created_at ASC LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []types.NotificationOutboxEntry
+	for rows.Next() {
+		var entry types.NotificationOutboxEntry
+		if err := rows.Scan(&entry.ID, &entry.Payload, &entry.Attempts, &entry.NextAttempt, &entry.Error, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// DeleteNotificationOutboxEntry removes an entry once it has been
+// dispatched successfully.
+func (db *PostgresDB) DeleteNotificationOutboxEntry(ctx context.Context, id uuid.UUID) error {
+	_, err := db.pool.Exec(ctx, `DELETE FROM notification_outbox WHERE id = $1`, id)
+	return err
+}
+
+// MarkNotificationOutboxEntryFailed records a failed dispatch attempt and
+// schedules the next retry, leaving the row in place so dispatch is
+// retried instead of lost.
+func (db *PostgresDB) MarkNotificationOutboxEntryFailed(ctx context.Context, id uuid.UUID, nextAttempt time.Time, errMsg string) error {
+	_, err := db.pool.Exec(ctx,
+		`UPDATE notification_outbox SET attempts = attempts + 1, next_attempt = $1, error = $2 WHERE id = $3`,
+		nextAttempt, errMsg, id,
+	)
+	return err
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// SaveOutboxEvent queues event for at-least-once delivery to the configured
+// event bus. The caller is expected to have already saved the underlying
+// Change; SaveOutboxEvent does not itself fail the poll if it errors, since
+// event bus publishing is a best-effort side channel, not a source of truth.
+func (db *PostgresDB) SaveOutboxEvent(ctx context.Context, event *types.OutboxEvent) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO event_outbox (id, change_id, topic, payload, next_attempt)
+		VALUES ($1, $2, $3, $4, $5)`,
+		event.ID, event.ChangeID, event.Topic, event.Payload, event.NextAttempt,
+	)
+	return err
+}
+
+// GetPendingOutboxEvents retrieves up to limit events due for delivery
+// (next_attempt at or before now), oldest first.
+func (db *PostgresDB) GetPendingOutboxEvents(ctx context.Context, limit int) ([]types.OutboxEvent, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, change_id, topic, payload, attempts, next_attempt, error, created_at
+		FROM event_outbox WHERE next_attempt <= NOW() ORDER BY created_at ASC LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []types.OutboxEvent
+	for rows.Next() {
+		var event types.OutboxEvent
+		if err := rows.Scan(&event.ID, &event.ChangeID, &event.Topic, &event.Payload,
+			&event.Attempts, &event.NextAttempt, &event.Error, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// DeleteOutboxEvent removes an event once it has been published successfully.
+func (db *PostgresDB) DeleteOutboxEvent(ctx context.Context, id uuid.UUID) error {
+	_, err := db.pool.Exec(ctx, `DELETE FROM event_outbox WHERE id = $1`, id)
+	return err
+}
+
+// MarkOutboxEventFailed records a failed publish attempt and schedules the
+// next retry, leaving the row in place so delivery is retried instead of
+// lost.
+func (db *PostgresDB) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttempt time.Time, errMsg string) error {
+	_, err := db.pool.Exec(ctx,
+		`UPDATE event_outbox SET attempts = attempts + 1, next_attempt = $1, error = $2 WHERE id = $3`,
+		nextAttempt, errMsg, id,
+	)
+	return err
+}
@@ -2,16 +2,17 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"embed"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/pressly/goose/v3"
 	"github.com/rs/zerolog/log"
 
 	"github.com/nirholas/mcp-notify/internal/config"
@@ -24,11 +25,46 @@ var embedMigrations embed.FS
 // PostgresDB implements the Database interface using PostgreSQL.
 type PostgresDB struct {
 	pool *pgxpool.Pool
+
+	// replicaPool is the read-replica pool, or nil when DatabaseConfig.ReplicaURL
+	// is unset and all reads use pool instead.
+	replicaPool *pgxpool.Pool
+
+	// url is the primary connection string, kept for Migrate() to open a
+	// separate database/sql connection for goose.
+	url string
+	// devAutoMigrate mirrors DatabaseConfig.DevAutoMigrate.
+	devAutoMigrate bool
 }
 
-// New creates a new PostgreSQL database connection.
+// New creates a new PostgreSQL database connection, along with a read-replica
+// connection pool when cfg.ReplicaURL is set.
 func New(ctx context.Context, cfg config.DatabaseConfig) (*PostgresDB, error) {
-	poolConfig, err := pgxpool.ParseConfig(cfg.URL)
+	pool, err := newPool(ctx, cfg.URL, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+	}
+
+	log.Info().Msg("Connected to PostgreSQL database")
+
+	db := &PostgresDB{pool: pool, url: cfg.URL, devAutoMigrate: cfg.DevAutoMigrate}
+
+	if cfg.ReplicaURL != "" {
+		replicaPool, err := newPool(ctx, cfg.ReplicaURL, cfg)
+		if err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("failed to create read replica connection pool: %w", err)
+		}
+		log.Info().Msg("Connected to PostgreSQL read replica")
+		db.replicaPool = replicaPool
+	}
+
+	return db, nil
+}
+
+// newPool opens and pings a connection pool for url, sized per cfg.
+func newPool(ctx context.Context, url string, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse database URL: %w", err)
 	}
@@ -40,23 +76,40 @@ func New(ctx context.Context, cfg config.DatabaseConfig) (*PostgresDB, error) {
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+		return nil, err
 	}
 
-	// Test connection
 	if err := pool.Ping(ctx); err != nil {
 		pool.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Info().Msg("Connected to PostgreSQL database")
-
-	return &PostgresDB{pool: pool}, nil
+	return pool, nil
 }
 
-// Close closes the database connection pool.
+// Close closes the database connection pool(s).
 func (db *PostgresDB) Close() error {
 	db.pool.Close()
+	if db.replicaPool != nil {
+		db.replicaPool.Close()
+	}
+	return nil
+}
+
+// withReadFallback runs fn against the read-replica pool when one is
+// configured, retrying against the primary pool if fn returns an error, so a
+// lagging or unreachable replica degrades to the primary instead of failing
+// the request. Without a configured replica, fn just runs against the
+// primary. fn must be safe to call twice (e.g. reset any slice it appends
+// to before returning an error).
+func (db *PostgresDB) withReadFallback(ctx context.Context, fn func(pool *pgxpool.Pool) error) error {
+	if db.replicaPool == nil {
+		return fn(db.pool)
+	}
+	if err := fn(db.replicaPool); err != nil {
+		log.Warn().Err(err).Msg("Read replica query failed, falling back to primary")
+		return fn(db.pool)
+	}
 	return nil
 }
 
@@ -65,30 +118,25 @@ func (db *PostgresDB) Ping(ctx context.Context) error {
 	return db.pool.Ping(ctx)
 }
 
-// Migrate runs database migrations.
+// Migrate runs database migrations at startup. By default this applies the
+// tracked goose migrations embedded in migrations/ against the primary
+// connection, the same as running `mcp-notify migrate up`. Setting
+// DatabaseConfig.DevAutoMigrate instead runs ensureTables() directly, which
+// is faster for local iteration but untracked and unsuitable for a real
+// deployment.
 func (db *PostgresDB) Migrate(ctx context.Context) error {
-	goose.SetBaseFS(embedMigrations)
-
-	conn, err := db.pool.Acquire(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to acquire connection: %w", err)
+	if db.devAutoMigrate {
+		log.Warn().Msg("dev_auto_migrate enabled: ensuring tables directly instead of running tracked goose migrations")
+		if err := db.ensureTables(ctx); err != nil {
+			return fmt.Errorf("failed to ensure tables: %w", err)
+		}
+		return nil
 	}
-	defer conn.Release()
-
-	// Get underlying *sql.DB for goose
-	// Note: This requires converting pgx connection to database/sql
-	// For now, we'll use pgx directly for migrations
 
 	log.Info().Msg("Running database migrations...")
-
-	// Note: goose migrations are available in internal/db/migrations/ for production deployments.
-	// This method uses ensureTables() for development simplicity.
-	// For production, run migrations separately via: goose -dir internal/db/migrations postgres "$DATABASE_URL" up
-
-	if err := db.ensureTables(ctx); err != nil {
-		return fmt.Errorf("failed to ensure tables: %w", err)
+	if err := MigrateUp(ctx, db.url); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
 	}
-
 	log.Info().Msg("Database migrations completed")
 	return nil
 }
@@ -104,9 +152,21 @@ func (db *PostgresDB) ensureTables(ctx context.Context) error {
 			server_count INTEGER NOT NULL,
 			hash TEXT NOT NULL,
 			servers_data JSONB,
+			base_snapshot_id UUID REFERENCES snapshots(id) ON DELETE SET NULL,
+			delta_data JSONB,
+			compacted_at TIMESTAMPTZ,
+			blob_refs JSONB,
 			created_at TIMESTAMPTZ DEFAULT NOW()
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_snapshots_timestamp ON snapshots(timestamp DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_snapshots_base_snapshot ON snapshots(base_snapshot_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_snapshots_compacted_at ON snapshots(compacted_at)`,
+
+		`CREATE TABLE IF NOT EXISTS server_blobs (
+			hash TEXT PRIMARY KEY,
+			data JSONB NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
 
 		`CREATE TABLE IF NOT EXISTS changes (
 			id UUID PRIMARY KEY,
@@ -118,28 +178,126 @@ func (db *PostgresDB) ensureTables(ctx context.Context) error {
 			field_changes JSONB,
 			server_data JSONB,
 			previous_server_data JSONB,
+			severity TEXT NOT NULL DEFAULT 'minor',
 			detected_at TIMESTAMPTZ NOT NULL,
+			confirmed BOOLEAN NOT NULL DEFAULT true,
+			suspicious BOOLEAN NOT NULL DEFAULT false,
+			suspicious_reasons JSONB,
 			created_at TIMESTAMPTZ DEFAULT NOW()
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_changes_detected_at ON changes(detected_at DESC)`,
 		`CREATE INDEX IF NOT EXISTS idx_changes_server_name ON changes(server_name)`,
+		// Change IDs are now derived deterministically from
+		// (registry, server, version transition, snapshot pair), so a
+		// re-poll or federation peer observing the same transition produces
+		// the same logical row instead of a duplicate.
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_changes_dedup ON changes(snapshot_id, server_name, change_type)`,
+
+		`CREATE TABLE IF NOT EXISTS users (
+			id UUID PRIMARY KEY,
+			email TEXT NOT NULL,
+			provider TEXT NOT NULL,
+			subject TEXT NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_provider_subject ON users(provider, subject)`,
+
+		`CREATE TABLE IF NOT EXISTS personal_access_tokens (
+			id UUID PRIMARY KEY,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			name TEXT NOT NULL,
+			token_hash TEXT NOT NULL,
+			token_hint TEXT NOT NULL,
+			scopes JSONB NOT NULL DEFAULT '[]',
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			last_used_at TIMESTAMPTZ,
+			expires_at TIMESTAMPTZ,
+			revoked_at TIMESTAMPTZ
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_pat_user ON personal_access_tokens(user_id)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_pat_token_hash ON personal_access_tokens(token_hash)`,
+
+		`CREATE TABLE IF NOT EXISTS organizations (
+			id UUID PRIMARY KEY,
+			name TEXT NOT NULL,
+			slug TEXT NOT NULL,
+			tier TEXT NOT NULL DEFAULT 'free',
+			max_subscriptions INTEGER NOT NULL DEFAULT 0,
+			max_notifications_per_month INTEGER NOT NULL DEFAULT 0,
+			max_channels_per_subscription INTEGER NOT NULL DEFAULT 0,
+			usage_webhook_url TEXT,
+			usage_webhook_secret TEXT,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			updated_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_slug ON organizations(slug)`,
+
+		`CREATE TABLE IF NOT EXISTS organization_members (
+			org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+			user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			role TEXT NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			PRIMARY KEY (org_id, user_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_organization_members_user ON organization_members(user_id)`,
 
 		`CREATE TABLE IF NOT EXISTS subscriptions (
 			id UUID PRIMARY KEY,
 			name TEXT NOT NULL,
 			description TEXT,
 			filters JSONB NOT NULL DEFAULT '{}',
+			routes JSONB NOT NULL DEFAULT '[]',
+			delivery_schedule JSONB NOT NULL DEFAULT '{}',
+			scheduled_report JSONB NOT NULL DEFAULT '{}',
 			status TEXT NOT NULL DEFAULT 'active',
 			api_key_hash TEXT,
 			api_key_hint TEXT,
 			notification_count INTEGER DEFAULT 0,
 			last_reset TIMESTAMPTZ DEFAULT NOW(),
 			last_notified TIMESTAMPTZ,
+			warm_up_until TIMESTAMPTZ,
+			warm_up_summary_sent BOOLEAN NOT NULL DEFAULT false,
+			coalesce_window BIGINT NOT NULL DEFAULT 0,
+			user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+			org_id UUID REFERENCES organizations(id) ON DELETE SET NULL,
 			created_at TIMESTAMPTZ DEFAULT NOW(),
 			updated_at TIMESTAMPTZ DEFAULT NOW()
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_subscriptions_status ON subscriptions(status)`,
 		`CREATE INDEX IF NOT EXISTS idx_subscriptions_api_key ON subscriptions(api_key_hash)`,
+		`CREATE INDEX IF NOT EXISTS idx_subscriptions_user ON subscriptions(user_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_subscriptions_org ON subscriptions(org_id)`,
+
+		`CREATE TABLE IF NOT EXISTS subscription_api_keys (
+			id UUID PRIMARY KEY,
+			subscription_id UUID NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+			name TEXT NOT NULL,
+			key_hash TEXT NOT NULL,
+			key_hint TEXT NOT NULL,
+			scopes JSONB NOT NULL DEFAULT '[]',
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			last_used_at TIMESTAMPTZ,
+			expires_at TIMESTAMPTZ,
+			revoked_at TIMESTAMPTZ
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_subscription_api_keys_subscription ON subscription_api_keys(subscription_id)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_subscription_api_keys_hash ON subscription_api_keys(key_hash)`,
+
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id UUID PRIMARY KEY,
+			action TEXT NOT NULL,
+			resource_type TEXT NOT NULL,
+			resource_id UUID NOT NULL,
+			actor_type TEXT NOT NULL,
+			actor_id TEXT,
+			ip TEXT,
+			before_snapshot JSONB,
+			after_snapshot JSONB,
+			created_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_resource ON audit_log(resource_type, resource_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_created ON audit_log(created_at DESC)`,
 
 		`CREATE TABLE IF NOT EXISTS channels (
 			id UUID PRIMARY KEY,
@@ -166,10 +324,79 @@ func (db *PostgresDB) ensureTables(ctx context.Context) error {
 			next_retry TIMESTAMPTZ,
 			sent_at TIMESTAMPTZ,
 			error TEXT,
-			created_at TIMESTAMPTZ DEFAULT NOW()
+			created_at TIMESTAMPTZ DEFAULT NOW(),
+			server_name TEXT NOT NULL DEFAULT '',
+			change_type TEXT NOT NULL DEFAULT '',
+			payload_snippet TEXT NOT NULL DEFAULT ''
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications(status)`,
 		`CREATE INDEX IF NOT EXISTS idx_notifications_subscription ON notifications(subscription_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_notifications_search ON notifications USING GIN (
+			to_tsvector('english', server_name || ' ' || change_type || ' ' || COALESCE(error, '') || ' ' || payload_snippet)
+		)`,
+
+		`CREATE TABLE IF NOT EXISTS held_notifications (
+			id UUID PRIMARY KEY,
+			subscription_id UUID NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+			change_id UUID NOT NULL REFERENCES changes(id),
+			created_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_held_notifications_subscription ON held_notifications(subscription_id)`,
+
+		`CREATE TABLE IF NOT EXISTS pending_coalesced_updates (
+			id UUID PRIMARY KEY,
+			subscription_id UUID NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+			server_name TEXT NOT NULL,
+			first_change_id UUID NOT NULL REFERENCES changes(id),
+			latest_change_id UUID NOT NULL REFERENCES changes(id),
+			update_count INTEGER NOT NULL DEFAULT 1,
+			coalesce_window BIGINT NOT NULL,
+			first_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_pending_coalesced_updates_sub_server ON pending_coalesced_updates(subscription_id, server_name)`,
+
+		`CREATE TABLE IF NOT EXISTS transparency_log (
+			index BIGINT PRIMARY KEY,
+			change_id UUID NOT NULL REFERENCES changes(id),
+			prev_hash TEXT NOT NULL,
+			entry_hash TEXT NOT NULL,
+			signature TEXT,
+			created_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+
+		`CREATE TABLE IF NOT EXISTS event_outbox (
+			id UUID PRIMARY KEY,
+			change_id UUID NOT NULL REFERENCES changes(id) ON DELETE CASCADE,
+			topic TEXT NOT NULL,
+			payload JSONB NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			error TEXT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_event_outbox_next_attempt ON event_outbox(next_attempt)`,
+
+		`CREATE TABLE IF NOT EXISTS notification_outbox (
+			id UUID PRIMARY KEY,
+			payload JSONB NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			error TEXT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_notification_outbox_next_attempt ON notification_outbox(next_attempt)`,
+
+		`CREATE TABLE IF NOT EXISTS poll_history (
+			id UUID PRIMARY KEY,
+			started_at TIMESTAMPTZ NOT NULL,
+			completed_at TIMESTAMPTZ NOT NULL,
+			success BOOLEAN NOT NULL,
+			error TEXT,
+			server_count INTEGER DEFAULT 0,
+			created_at TIMESTAMPTZ DEFAULT NOW()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_poll_history_completed_at ON poll_history(completed_at DESC)`,
 	}
 
 	for _, query := range queries {
@@ -181,17 +408,23 @@ func (db *PostgresDB) ensureTables(ctx context.Context) error {
 	return nil
 }
 
-// SaveSnapshot saves a snapshot to the database.
+// SaveSnapshot saves a snapshot to the database. Server records are stored
+// content-addressed in server_blobs rather than embedded in the snapshot
+// row, so servers unchanged since a previous poll are never duplicated.
 func (db *PostgresDB) SaveSnapshot(ctx context.Context, snapshot *types.Snapshot) error {
-	serversData, err := json.Marshal(snapshot.Servers)
+	refs, err := db.saveServerBlobs(ctx, snapshot.Servers)
+	if err != nil {
+		return fmt.Errorf("failed to save server blobs: %w", err)
+	}
+	blobRefs, err := json.Marshal(refs)
 	if err != nil {
-		return fmt.Errorf("failed to marshal servers: %w", err)
+		return fmt.Errorf("failed to marshal blob refs: %w", err)
 	}
 
 	_, err = db.pool.Exec(ctx,
-		`INSERT INTO snapshots (id, timestamp, server_count, hash, servers_data)
+		`INSERT INTO snapshots (id, timestamp, server_count, hash, blob_refs)
 		VALUES ($1, $2, $3, $4, $5)`,
-		snapshot.ID, snapshot.Timestamp, snapshot.ServerCount, snapshot.Hash, serversData,
+		snapshot.ID, snapshot.Timestamp, snapshot.ServerCount, snapshot.Hash, blobRefs,
 	)
 	return err
 }
@@ -199,12 +432,13 @@ func (db *PostgresDB) SaveSnapshot(ctx context.Context, snapshot *types.Snapshot
 // GetLatestSnapshot retrieves the most recent snapshot.
 func (db *PostgresDB) GetLatestSnapshot(ctx context.Context) (*types.Snapshot, error) {
 	var snapshot types.Snapshot
-	var serversData []byte
+	var serversData, deltaData, blobRefsData []byte
+	var baseSnapshotID *uuid.UUID
 
 	err := db.pool.QueryRow(ctx,
-		`SELECT id, timestamp, server_count, hash, servers_data
+		`SELECT id, timestamp, server_count, hash, servers_data, base_snapshot_id, delta_data, blob_refs
 		FROM snapshots ORDER BY timestamp DESC LIMIT 1`,
-	).Scan(&snapshot.ID, &snapshot.Timestamp, &snapshot.ServerCount, &snapshot.Hash, &serversData)
+	).Scan(&snapshot.ID, &snapshot.Timestamp, &snapshot.ServerCount, &snapshot.Hash, &serversData, &baseSnapshotID, &deltaData, &blobRefsData)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
@@ -213,9 +447,11 @@ func (db *PostgresDB) GetLatestSnapshot(ctx context.Context) (*types.Snapshot, e
 		return nil, err
 	}
 
-	if err := json.Unmarshal(serversData, &snapshot.Servers); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal servers: %w", err)
+	servers, err := db.resolveSnapshotServers(ctx, serversData, deltaData, blobRefsData, baseSnapshotID)
+	if err != nil {
+		return nil, err
 	}
+	snapshot.Servers = servers
 
 	return &snapshot, nil
 }
@@ -223,178 +459,1350 @@ func (db *PostgresDB) GetLatestSnapshot(ctx context.Context) (*types.Snapshot, e
 // GetSnapshotByID retrieves a snapshot by ID.
 func (db *PostgresDB) GetSnapshotByID(ctx context.Context, id uuid.UUID) (*types.Snapshot, error) {
 	var snapshot types.Snapshot
-	var serversData []byte
+	var serversData, deltaData, blobRefsData []byte
+	var baseSnapshotID *uuid.UUID
 
 	err := db.pool.QueryRow(ctx,
-		`SELECT id, timestamp, server_count, hash, servers_data
+		`SELECT id, timestamp, server_count, hash, servers_data, base_snapshot_id, delta_data, blob_refs
 		FROM snapshots WHERE id = $1`, id,
-	).Scan(&snapshot.ID, &snapshot.Timestamp, &snapshot.ServerCount, &snapshot.Hash, &serversData)
+	).Scan(&snapshot.ID, &snapshot.Timestamp, &snapshot.ServerCount, &snapshot.Hash, &serversData, &baseSnapshotID, &deltaData, &blobRefsData)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := db.resolveSnapshotServers(ctx, serversData, deltaData, blobRefsData, baseSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Servers = servers
+
+	return &snapshot, nil
+}
+
+// GetSnapshotAt retrieves the snapshot closest to the given timestamp.
+func (db *PostgresDB) GetSnapshotAt(ctx context.Context, timestamp time.Time) (*types.Snapshot, error) {
+	var snapshot types.Snapshot
+	var serversData, deltaData, blobRefsData []byte
+	var baseSnapshotID *uuid.UUID
+
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, timestamp, server_count, hash, servers_data, base_snapshot_id, delta_data, blob_refs
+		FROM snapshots WHERE timestamp <= $1 ORDER BY timestamp DESC LIMIT 1`, timestamp,
+	).Scan(&snapshot.ID, &snapshot.Timestamp, &snapshot.ServerCount, &snapshot.Hash, &serversData, &baseSnapshotID, &deltaData, &blobRefsData)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := db.resolveSnapshotServers(ctx, serversData, deltaData, blobRefsData, baseSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Servers = servers
+
+	return &snapshot, nil
+}
+
+// DeleteOldSnapshots removes snapshots older than the given time, returning
+// the number of rows deleted.
+func (db *PostgresDB) DeleteOldSnapshots(ctx context.Context, olderThan time.Time) (int64, error) {
+	result, err := db.pool.Exec(ctx,
+		`DELETE FROM snapshots WHERE timestamp < $1`, olderThan,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old snapshots: %w", err)
+	}
+	return result.RowsAffected(), nil
+}
+
+// SaveChange saves a change to the database.
+func (db *PostgresDB) SaveChange(ctx context.Context, change *types.Change) error {
+	fieldChanges, _ := json.Marshal(change.FieldChanges)
+	serverData, _ := json.Marshal(change.Server)
+	prevServerData, _ := json.Marshal(change.PreviousServer)
+	suspiciousReasons, _ := json.Marshal(change.SuspiciousReasons)
+
+	// ON CONFLICT DO NOTHING makes this idempotent: change.ID is now derived
+	// deterministically (see diff.Engine.deriveChangeID), so replaying the
+	// same poll re-saves the identical row instead of erroring or
+	// duplicating it.
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO changes (id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (id) DO NOTHING`,
+		change.ID, change.SnapshotID, change.ServerName, change.ChangeType,
+		change.PreviousVersion, change.NewVersion, fieldChanges, serverData, prevServerData, change.Severity, change.DetectedAt, change.Confirmed,
+		change.Suspicious, suspiciousReasons, change.Backfilled,
+	)
+	return err
+}
+
+// SaveChanges saves multiple changes in a single round trip using a batched
+// pipeline of the same statement SaveChange issues, so a poll that finds
+// hundreds of changes doesn't pay hundreds of network round trips.
+func (db *PostgresDB) SaveChanges(ctx context.Context, changes []types.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for i := range changes {
+		change := &changes[i]
+		fieldChanges, _ := json.Marshal(change.FieldChanges)
+		serverData, _ := json.Marshal(change.Server)
+		prevServerData, _ := json.Marshal(change.PreviousServer)
+		suspiciousReasons, _ := json.Marshal(change.SuspiciousReasons)
+
+		batch.Queue(
+			`INSERT INTO changes (id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			ON CONFLICT (id) DO NOTHING`,
+			change.ID, change.SnapshotID, change.ServerName, change.ChangeType,
+			change.PreviousVersion, change.NewVersion, fieldChanges, serverData, prevServerData, change.Severity, change.DetectedAt, change.Confirmed,
+			change.Suspicious, suspiciousReasons, change.Backfilled,
+		)
+	}
+
+	results := db.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for i := range changes {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to save change %d of %d: %w", i+1, len(changes), err)
+		}
+	}
+	return results.Close()
+}
+
+// SaveChangesAndEnqueueNotification saves changes and, when entry is
+// non-nil, inserts its notification outbox row in the same transaction, so
+// a crash between the two never leaves a saved change whose notification
+// is never enqueued (see the Database interface doc comment).
+func (db *PostgresDB) SaveChangesAndEnqueueNotification(ctx context.Context, changes []types.Change, entry *types.NotificationOutboxEntry) error {
+	if len(changes) == 0 && entry == nil {
+		return nil
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
+
+	if len(changes) > 0 {
+		batch := &pgx.Batch{}
+		for i := range changes {
+			change := &changes[i]
+			fieldChanges, _ := json.Marshal(change.FieldChanges)
+			serverData, _ := json.Marshal(change.Server)
+			prevServerData, _ := json.Marshal(change.PreviousServer)
+			suspiciousReasons, _ := json.Marshal(change.SuspiciousReasons)
+
+			batch.Queue(
+				`INSERT INTO changes (id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+				ON CONFLICT (id) DO NOTHING`,
+				change.ID, change.SnapshotID, change.ServerName, change.ChangeType,
+				change.PreviousVersion, change.NewVersion, fieldChanges, serverData, prevServerData, change.Severity, change.DetectedAt, change.Confirmed,
+				change.Suspicious, suspiciousReasons, change.Backfilled,
+			)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for i := range changes {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return fmt.Errorf("failed to save change %d of %d: %w", i+1, len(changes), err)
+			}
+		}
+		if err := results.Close(); err != nil {
+			return fmt.Errorf("failed to save changes: %w", err)
+		}
+	}
+
+	if entry != nil {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO notification_outbox (id, payload, next_attempt)
+			VALUES ($1, $2, $3)`,
+			entry.ID, entry.Payload, entry.NextAttempt,
+		); err != nil {
+			return fmt.Errorf("failed to enqueue notification outbox entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetChangeByID retrieves a change by ID.
+func (db *PostgresDB) GetChangeByID(ctx context.Context, id uuid.UUID) (*types.Change, error) {
+	var change types.Change
+	var fieldChanges, serverData, prevServerData, suspiciousReasons []byte
+
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled
+		FROM changes WHERE id = $1`, id,
+	).Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
+		&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.Severity, &change.DetectedAt, &change.Confirmed, &change.Suspicious, &suspiciousReasons, &change.Backfilled)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
-		return nil, err
+		return nil, err
+	}
+
+	json.Unmarshal(fieldChanges, &change.FieldChanges)
+	json.Unmarshal(serverData, &change.Server)
+	json.Unmarshal(prevServerData, &change.PreviousServer)
+	json.Unmarshal(suspiciousReasons, &change.SuspiciousReasons)
+
+	return &change, nil
+}
+
+// GetChangesSince retrieves changes since the given timestamp.
+func (db *PostgresDB) GetChangesSince(ctx context.Context, since time.Time, limit int) ([]types.Change, error) {
+	var changes []types.Change
+	err := db.withReadFallback(ctx, func(pool *pgxpool.Pool) error {
+		changes = nil
+		rows, err := pool.Query(ctx,
+			`SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled
+			FROM changes WHERE detected_at >= $1 ORDER BY detected_at DESC LIMIT $2`, since, limit,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var change types.Change
+			var fieldChanges, serverData, prevServerData, suspiciousReasons []byte
+
+			if err := rows.Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
+				&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.Severity, &change.DetectedAt, &change.Confirmed, &change.Suspicious, &suspiciousReasons, &change.Backfilled); err != nil {
+				return err
+			}
+
+			json.Unmarshal(fieldChanges, &change.FieldChanges)
+			json.Unmarshal(serverData, &change.Server)
+			json.Unmarshal(prevServerData, &change.PreviousServer)
+			json.Unmarshal(suspiciousReasons, &change.SuspiciousReasons)
+
+			changes = append(changes, change)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetChangesSincePage retrieves a keyset-paginated page of changes at or
+// after since. Pages are ordered by detected_at descending, tie-broken by id
+// descending, so the (detected_at, id) pair from the last row of a page can
+// be encoded as a cursor and used to fetch the next page without skipping or
+// duplicating rows when several changes share the same detected_at.
+func (db *PostgresDB) GetChangesSincePage(ctx context.Context, since time.Time, cursor string, limit int) ([]types.Change, string, error) {
+	query := `SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled
+		FROM changes WHERE detected_at >= $1`
+	args := []any{since}
+
+	if cursor != "" {
+		c, err := DecodeChangesCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		query += ` AND (detected_at, id) < ($2, $3)`
+		args = append(args, c.DetectedAt, c.ID)
+	}
+
+	query += fmt.Sprintf(` ORDER BY detected_at DESC, id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, limit)
+
+	var changes []types.Change
+	err := db.withReadFallback(ctx, func(pool *pgxpool.Pool) error {
+		changes = nil
+		rows, err := pool.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var change types.Change
+			var fieldChanges, serverData, prevServerData, suspiciousReasons []byte
+
+			if err := rows.Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
+				&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.Severity, &change.DetectedAt, &change.Confirmed, &change.Suspicious, &suspiciousReasons, &change.Backfilled); err != nil {
+				return err
+			}
+
+			json.Unmarshal(fieldChanges, &change.FieldChanges)
+			json.Unmarshal(serverData, &change.Server)
+			json.Unmarshal(prevServerData, &change.PreviousServer)
+			json.Unmarshal(suspiciousReasons, &change.SuspiciousReasons)
+
+			changes = append(changes, change)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(changes) == limit {
+		last := changes[len(changes)-1]
+		nextCursor = EncodeChangesCursor(ChangesCursor{DetectedAt: last.DetectedAt, ID: last.ID})
+	}
+
+	return changes, nextCursor, nil
+}
+
+// GetChangesForServer retrieves changes for a specific server.
+func (db *PostgresDB) GetChangesForServer(ctx context.Context, serverName string, limit int) ([]types.Change, error) {
+	var changes []types.Change
+	err := db.withReadFallback(ctx, func(pool *pgxpool.Pool) error {
+		changes = nil
+		rows, err := pool.Query(ctx,
+			`SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, severity, detected_at, confirmed, suspicious, suspicious_reasons, backfilled
+			FROM changes WHERE server_name = $1 ORDER BY detected_at DESC LIMIT $2`, serverName, limit,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var change types.Change
+			var fieldChanges, serverData, prevServerData, suspiciousReasons []byte
+
+			if err := rows.Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
+				&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.Severity, &change.DetectedAt, &change.Confirmed, &change.Suspicious, &suspiciousReasons, &change.Backfilled); err != nil {
+				return err
+			}
+
+			json.Unmarshal(fieldChanges, &change.FieldChanges)
+			json.Unmarshal(serverData, &change.Server)
+			json.Unmarshal(prevServerData, &change.PreviousServer)
+			json.Unmarshal(suspiciousReasons, &change.SuspiciousReasons)
+
+			changes = append(changes, change)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetChangeCountSince returns the count of changes since the given timestamp.
+func (db *PostgresDB) GetChangeCountSince(ctx context.Context, since time.Time) (int, error) {
+	var count int
+	err := db.withReadFallback(ctx, func(pool *pgxpool.Pool) error {
+		return pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM changes WHERE detected_at >= $1`, since,
+		).Scan(&count)
+	})
+	return count, err
+}
+
+// PruneOldChanges removes changes detected before the given time, returning
+// the number of rows deleted. Notifications referencing a pruned change have
+// their change_id set to NULL rather than being deleted themselves (see the
+// changes FK in the notifications table).
+func (db *PostgresDB) PruneOldChanges(ctx context.Context, olderThan time.Time) (int64, error) {
+	result, err := db.pool.Exec(ctx,
+		`DELETE FROM changes WHERE detected_at < $1`, olderThan,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune old changes: %w", err)
+	}
+	return result.RowsAffected(), nil
+}
+
+// SaveHeldNotification records a change whose dispatch was deferred because
+// its subscription's delivery window was closed.
+func (db *PostgresDB) SaveHeldNotification(ctx context.Context, held *types.HeldNotification) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO held_notifications (id, subscription_id, change_id, created_at)
+		VALUES ($1, $2, $3, $4)`,
+		held.ID, held.SubscriptionID, held.ChangeID, held.CreatedAt,
+	)
+	return err
+}
+
+// GetHeldNotifications returns a subscription's held notifications, oldest
+// first, so a batch flush delivers them in detection order.
+func (db *PostgresDB) GetHeldNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]types.HeldNotification, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, subscription_id, change_id, created_at
+		FROM held_notifications WHERE subscription_id = $1 ORDER BY created_at ASC`, subscriptionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query held notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var held []types.HeldNotification
+	for rows.Next() {
+		var h types.HeldNotification
+		if err := rows.Scan(&h.ID, &h.SubscriptionID, &h.ChangeID, &h.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan held notification: %w", err)
+		}
+		held = append(held, h)
+	}
+	return held, rows.Err()
+}
+
+// DeleteHeldNotifications clears a subscription's held notifications after
+// they've been flushed.
+func (db *PostgresDB) DeleteHeldNotifications(ctx context.Context, subscriptionID uuid.UUID) error {
+	_, err := db.pool.Exec(ctx, `DELETE FROM held_notifications WHERE subscription_id = $1`, subscriptionID)
+	return err
+}
+
+// SaveTransparencyEntry appends an entry to the transparency log. index is
+// assigned by the caller (internal/transparency serializes appends), so
+// this is a plain insert rather than an upsert.
+func (db *PostgresDB) SaveTransparencyEntry(ctx context.Context, entry *types.TransparencyEntry) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO transparency_log (index, change_id, prev_hash, entry_hash, signature)
+		VALUES ($1, $2, $3, $4, $5)`,
+		entry.Index, entry.ChangeID, entry.PrevHash, entry.EntryHash, entry.Signature,
+	)
+	return err
+}
+
+// GetTransparencyEntry retrieves the entry at the given index.
+func (db *PostgresDB) GetTransparencyEntry(ctx context.Context, index int64) (*types.TransparencyEntry, error) {
+	var entry types.TransparencyEntry
+	err := db.pool.QueryRow(ctx,
+		`SELECT index, change_id, prev_hash, entry_hash, signature, created_at
+		FROM transparency_log WHERE index = $1`, index,
+	).Scan(&entry.Index, &entry.ChangeID, &entry.PrevHash, &entry.EntryHash, &entry.Signature, &entry.CreatedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetLatestTransparencyEntry retrieves the most recently appended entry, or
+// nil if the log is empty.
+func (db *PostgresDB) GetLatestTransparencyEntry(ctx context.Context) (*types.TransparencyEntry, error) {
+	var entry types.TransparencyEntry
+	err := db.pool.QueryRow(ctx,
+		`SELECT index, change_id, prev_hash, entry_hash, signature, created_at
+		FROM transparency_log ORDER BY index DESC LIMIT 1`,
+	).Scan(&entry.Index, &entry.ChangeID, &entry.PrevHash, &entry.EntryHash, &entry.Signature, &entry.CreatedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// CountTransparencyEntries returns the number of entries in the log.
+func (db *PostgresDB) CountTransparencyEntries(ctx context.Context) (int64, error) {
+	var count int64
+	err := db.pool.QueryRow(ctx, `SELECT COUNT(*) FROM transparency_log`).Scan(&count)
+	return count, err
+}
+
+// User accounts and personal access tokens
+
+// CreateUser inserts a new user account.
+func (db *PostgresDB) CreateUser(ctx context.Context, user *types.User) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO users (id, email, provider, subject, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		user.ID, user.Email, user.Provider, user.Subject, user.CreatedAt, user.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+	return nil
+}
+
+// GetUserByID retrieves a user by ID.
+func (db *PostgresDB) GetUserByID(ctx context.Context, id uuid.UUID) (*types.User, error) {
+	var user types.User
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, email, provider, subject, created_at, updated_at FROM users WHERE id = $1`, id,
+	).Scan(&user.ID, &user.Email, &user.Provider, &user.Subject, &user.CreatedAt, &user.UpdatedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return &user, nil
+}
+
+// GetUserBySubject retrieves a user by the (issuer, subject) pair identifying
+// their account at an OIDC provider.
+func (db *PostgresDB) GetUserBySubject(ctx context.Context, provider, subject string) (*types.User, error) {
+	var user types.User
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, email, provider, subject, created_at, updated_at FROM users WHERE provider = $1 AND subject = $2`,
+		provider, subject,
+	).Scan(&user.ID, &user.Email, &user.Provider, &user.Subject, &user.CreatedAt, &user.UpdatedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by subject: %w", err)
+	}
+	return &user, nil
+}
+
+// ListSubscriptionsForUser retrieves all subscriptions owned by a user, with channels.
+func (db *PostgresDB) ListSubscriptionsForUser(ctx context.Context, userID uuid.UUID) ([]types.Subscription, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint,
+		        notification_count, last_reset, last_notified, warm_up_until, warm_up_summary_sent, coalesce_window, user_id, org_id, created_at, updated_at
+		FROM subscriptions WHERE user_id = $1 ORDER BY created_at DESC`, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subscriptions for user: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []types.Subscription
+	for rows.Next() {
+		var sub types.Subscription
+		var filters, routes, deliverySchedule, scheduledReport, allowedCIDRs []byte
+		var lastNotified *time.Time
+		var coalesceWindowNs int64
+
+		if err := rows.Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &routes, &deliverySchedule, &scheduledReport, &allowedCIDRs, &sub.Status,
+			&sub.APIKey, &sub.APIKeyHint, &sub.NotificationCount, &sub.LastReset,
+			&lastNotified, &sub.WarmUpUntil, &sub.WarmUpSummarySent, &coalesceWindowNs, &sub.UserID, &sub.OrgID, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+
+		if err := json.Unmarshal(filters, &sub.Filters); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal filters: %w", err)
+		}
+		if err := json.Unmarshal(routes, &sub.Routes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal routes: %w", err)
+		}
+		if err := json.Unmarshal(allowedCIDRs, &sub.AllowedCIDRs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal allowed_cidrs: %w", err)
+		}
+		if err := json.Unmarshal(deliverySchedule, &sub.DeliverySchedule); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal delivery schedule: %w", err)
+		}
+		if err := json.Unmarshal(scheduledReport, &sub.ScheduledReport); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scheduled report: %w", err)
+		}
+		sub.LastNotified = lastNotified
+		sub.CoalesceWindow = time.Duration(coalesceWindowNs)
+
+		channels, err := db.GetChannelsForSubscription(ctx, sub.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get channels for subscription %s: %w", sub.ID, err)
+		}
+		sub.Channels = channels
+
+		subscriptions = append(subscriptions, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// CreatePersonalAccessToken inserts a new personal access token.
+func (db *PostgresDB) CreatePersonalAccessToken(ctx context.Context, token *types.PersonalAccessToken) error {
+	scopes, err := json.Marshal(token.Scopes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scopes: %w", err)
+	}
+
+	_, err = db.pool.Exec(ctx,
+		`INSERT INTO personal_access_tokens (id, user_id, name, token_hash, token_hint, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		token.ID, token.UserID, token.Name, token.TokenHash, token.TokenHint, scopes, token.CreatedAt, token.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create personal access token: %w", err)
+	}
+	return nil
+}
+
+// GetPersonalAccessTokenByHash retrieves a personal access token by its hash.
+func (db *PostgresDB) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*types.PersonalAccessToken, error) {
+	var tok types.PersonalAccessToken
+	var scopes []byte
+
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, user_id, name, token_hash, token_hint, scopes, created_at, last_used_at, expires_at, revoked_at
+		FROM personal_access_tokens WHERE token_hash = $1`, tokenHash,
+	).Scan(&tok.ID, &tok.UserID, &tok.Name, &tok.TokenHash, &tok.TokenHint, &scopes,
+		&tok.CreatedAt, &tok.LastUsedAt, &tok.ExpiresAt, &tok.RevokedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal access token: %w", err)
+	}
+	if err := json.Unmarshal(scopes, &tok.Scopes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scopes: %w", err)
+	}
+	return &tok, nil
+}
+
+// ListPersonalAccessTokens retrieves all personal access tokens for a user,
+// including revoked ones, newest first.
+func (db *PostgresDB) ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]types.PersonalAccessToken, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, user_id, name, token_hash, token_hint, scopes, created_at, last_used_at, expires_at, revoked_at
+		FROM personal_access_tokens WHERE user_id = $1 ORDER BY created_at DESC`, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query personal access tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []types.PersonalAccessToken
+	for rows.Next() {
+		var tok types.PersonalAccessToken
+		var scopes []byte
+		if err := rows.Scan(&tok.ID, &tok.UserID, &tok.Name, &tok.TokenHash, &tok.TokenHint, &scopes,
+			&tok.CreatedAt, &tok.LastUsedAt, &tok.ExpiresAt, &tok.RevokedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan personal access token: %w", err)
+		}
+		if err := json.Unmarshal(scopes, &tok.Scopes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scopes: %w", err)
+		}
+		tokens = append(tokens, tok)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating personal access tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// RevokePersonalAccessToken marks a token revoked. id must belong to userID,
+// so a user cannot revoke another user's token.
+func (db *PostgresDB) RevokePersonalAccessToken(ctx context.Context, id, userID uuid.UUID) error {
+	result, err := db.pool.Exec(ctx,
+		`UPDATE personal_access_tokens SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`,
+		id, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke personal access token: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("personal access token not found: %s", id)
+	}
+	return nil
+}
+
+// UpdatePersonalAccessTokenLastUsed records when a token was last used to
+// authenticate a request.
+func (db *PostgresDB) UpdatePersonalAccessTokenLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := db.pool.Exec(ctx,
+		`UPDATE personal_access_tokens SET last_used_at = $2 WHERE id = $1`, id, at,
+	)
+	return err
+}
+
+// CreateSubscriptionAPIKey inserts a new subscription API key.
+func (db *PostgresDB) CreateSubscriptionAPIKey(ctx context.Context, key *types.SubscriptionAPIKey) error {
+	scopes, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scopes: %w", err)
+	}
+
+	_, err = db.pool.Exec(ctx,
+		`INSERT INTO subscription_api_keys (id, subscription_id, name, key_hash, key_hint, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		key.ID, key.SubscriptionID, key.Name, key.KeyHash, key.KeyHint, scopes, key.CreatedAt, key.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription API key: %w", err)
+	}
+	return nil
+}
+
+// GetSubscriptionAPIKeyByHash retrieves a subscription API key by its hash.
+func (db *PostgresDB) GetSubscriptionAPIKeyByHash(ctx context.Context, keyHash string) (*types.SubscriptionAPIKey, error) {
+	var key types.SubscriptionAPIKey
+	var scopes []byte
+
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, subscription_id, name, key_hash, key_hint, scopes, created_at, last_used_at, expires_at, revoked_at
+		FROM subscription_api_keys WHERE key_hash = $1`, keyHash,
+	).Scan(&key.ID, &key.SubscriptionID, &key.Name, &key.KeyHash, &key.KeyHint, &scopes,
+		&key.CreatedAt, &key.LastUsedAt, &key.ExpiresAt, &key.RevokedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription API key: %w", err)
+	}
+	if err := json.Unmarshal(scopes, &key.Scopes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scopes: %w", err)
+	}
+	return &key, nil
+}
+
+// ListSubscriptionAPIKeys retrieves all API keys for a subscription,
+// including revoked ones, newest first.
+func (db *PostgresDB) ListSubscriptionAPIKeys(ctx context.Context, subscriptionID uuid.UUID) ([]types.SubscriptionAPIKey, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, subscription_id, name, key_hash, key_hint, scopes, created_at, last_used_at, expires_at, revoked_at
+		FROM subscription_api_keys WHERE subscription_id = $1 ORDER BY created_at DESC`, subscriptionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subscription API keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []types.SubscriptionAPIKey
+	for rows.Next() {
+		var key types.SubscriptionAPIKey
+		var scopes []byte
+		if err := rows.Scan(&key.ID, &key.SubscriptionID, &key.Name, &key.KeyHash, &key.KeyHint, &scopes,
+			&key.CreatedAt, &key.LastUsedAt, &key.ExpiresAt, &key.RevokedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription API key: %w", err)
+		}
+		if err := json.Unmarshal(scopes, &key.Scopes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scopes: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating subscription API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeSubscriptionAPIKey marks a key revoked. id must belong to
+// subscriptionID, so one subscription cannot revoke another's key.
+func (db *PostgresDB) RevokeSubscriptionAPIKey(ctx context.Context, id, subscriptionID uuid.UUID) error {
+	result, err := db.pool.Exec(ctx,
+		`UPDATE subscription_api_keys SET revoked_at = NOW() WHERE id = $1 AND subscription_id = $2 AND revoked_at IS NULL`,
+		id, subscriptionID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke subscription API key: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("subscription API key not found: %s", id)
+	}
+	return nil
+}
+
+// UpdateSubscriptionAPIKeyLastUsed records when a key was last used to
+// authenticate a request.
+func (db *PostgresDB) UpdateSubscriptionAPIKeyLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := db.pool.Exec(ctx,
+		`UPDATE subscription_api_keys SET last_used_at = $2 WHERE id = $1`, id, at,
+	)
+	return err
+}
+
+// CreateAuditLogEntry inserts a new audit log entry.
+func (db *PostgresDB) CreateAuditLogEntry(ctx context.Context, entry *types.AuditLogEntry) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO audit_log (id, action, resource_type, resource_id, actor_type, actor_id, ip, before_snapshot, after_snapshot, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		entry.ID, entry.Action, entry.ResourceType, entry.ResourceID, entry.ActorType, entry.ActorID, entry.IP,
+		nullableJSON(entry.Before), nullableJSON(entry.After), entry.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log entry: %w", err)
+	}
+	return nil
+}
+
+// nullableJSON returns nil for an empty json.RawMessage, so an omitted
+// before/after snapshot is stored as SQL NULL instead of the JSON literal
+// "null".
+func nullableJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	return raw
+}
+
+// nullableString returns nil for an empty string, so an unset optional
+// field (e.g. an organization's usage webhook URL) is stored as SQL NULL
+// instead of an empty string.
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// ListAuditLogEntries retrieves audit log entries matching filter, newest
+// first.
+func (db *PostgresDB) ListAuditLogEntries(ctx context.Context, filter types.AuditLogFilter) ([]types.AuditLogEntry, error) {
+	query := `SELECT id, action, resource_type, resource_id, actor_type, actor_id, ip, before_snapshot, after_snapshot, created_at
+		FROM audit_log WHERE 1=1`
+	var args []any
+
+	if filter.ResourceType != "" {
+		args = append(args, filter.ResourceType)
+		query += fmt.Sprintf(" AND resource_type = $%d", len(args))
+	}
+	if filter.ResourceID != nil {
+		args = append(args, *filter.ResourceID)
+		query += fmt.Sprintf(" AND resource_id = $%d", len(args))
+	}
+	if filter.Action != "" {
+		args = append(args, filter.Action)
+		query += fmt.Sprintf(" AND action = $%d", len(args))
+	}
+	if filter.ActorID != "" {
+		args = append(args, filter.ActorID)
+		query += fmt.Sprintf(" AND actor_id = $%d", len(args))
+	}
+	if filter.Since != nil {
+		args = append(args, *filter.Since)
+		query += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if filter.Until != nil {
+		args = append(args, *filter.Until)
+		query += fmt.Sprintf(" AND created_at <= $%d", len(args))
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	limit := filter.Limit
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" LIMIT $%d", len(args))
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []types.AuditLogEntry
+	for rows.Next() {
+		var entry types.AuditLogEntry
+		var before, after []byte
+		if err := rows.Scan(&entry.ID, &entry.Action, &entry.ResourceType, &entry.ResourceID, &entry.ActorType,
+			&entry.ActorID, &entry.IP, &before, &after, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit log entry: %w", err)
+		}
+		entry.Before = before
+		entry.After = after
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit log: %w", err)
+	}
+
+	return entries, nil
+}
+
+// PruneOldAuditLogEntries removes audit log entries created before the given
+// time, returning the number of rows deleted.
+func (db *PostgresDB) PruneOldAuditLogEntries(ctx context.Context, olderThan time.Time) (int64, error) {
+	result, err := db.pool.Exec(ctx,
+		`DELETE FROM audit_log WHERE created_at < $1`, olderThan,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune old audit log entries: %w", err)
+	}
+	return result.RowsAffected(), nil
+}
+
+// emailPattern matches an email-shaped substring anywhere in a string, used
+// to redact email addresses embedded in arbitrary audit log JSON snapshots.
+var emailPattern = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+
+// AnonymizeSubscriptionAuditLog redacts any email address found in the
+// before/after snapshots of a subscription's audit log entries, so deleting
+// the subscription doesn't leave its channels' email addresses recoverable
+// from audit history indefinitely. Returns the number of entries updated.
+func (db *PostgresDB) AnonymizeSubscriptionAuditLog(ctx context.Context, subscriptionID uuid.UUID) (int64, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, before_snapshot, after_snapshot FROM audit_log WHERE resource_type = 'subscription' AND resource_id = $1`,
+		subscriptionID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query subscription audit log entries: %w", err)
+	}
+
+	type auditSnapshot struct {
+		id            uuid.UUID
+		before, after []byte
+	}
+	var toRedact []auditSnapshot
+	for rows.Next() {
+		var s auditSnapshot
+		if err := rows.Scan(&s.id, &s.before, &s.after); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan audit log entry: %w", err)
+		}
+		toRedact = append(toRedact, s)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return 0, fmt.Errorf("error iterating subscription audit log entries: %w", err)
+	}
+	rows.Close()
+
+	var anonymized int64
+	for _, s := range toRedact {
+		before, err := redactEmails(s.before)
+		if err != nil {
+			return anonymized, fmt.Errorf("failed to redact audit log entry %s: %w", s.id, err)
+		}
+		after, err := redactEmails(s.after)
+		if err != nil {
+			return anonymized, fmt.Errorf("failed to redact audit log entry %s: %w", s.id, err)
+		}
+		if bytes.Equal(before, s.before) && bytes.Equal(after, s.after) {
+			continue
+		}
+		if _, err := db.pool.Exec(ctx,
+			`UPDATE audit_log SET before_snapshot = $2, after_snapshot = $3 WHERE id = $1`,
+			s.id, nullableJSON(before), nullableJSON(after),
+		); err != nil {
+			return anonymized, fmt.Errorf("failed to update audit log entry %s: %w", s.id, err)
+		}
+		anonymized++
+	}
+	return anonymized, nil
+}
+
+// redactEmails replaces every email-shaped string found anywhere in raw
+// (however deeply nested) with "[redacted]", returning raw unchanged if it
+// contains no email addresses.
+func redactEmails(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || !emailPattern.Match(raw) {
+		return raw, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	redacted, changed := redactEmailsValue(value)
+	if !changed {
+		return raw, nil
+	}
+
+	out, err := json.Marshal(redacted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal redacted snapshot: %w", err)
+	}
+	return out, nil
+}
+
+// redactEmailsValue recursively walks a value decoded from JSON (string,
+// map[string]any, []any, or a JSON scalar), replacing any email-shaped
+// string with "[redacted]". It reports whether it changed anything.
+func redactEmailsValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		if emailPattern.MatchString(v) {
+			return "[redacted]", true
+		}
+		return v, false
+	case map[string]any:
+		changed := false
+		for k, item := range v {
+			if newItem, itemChanged := redactEmailsValue(item); itemChanged {
+				v[k] = newItem
+				changed = true
+			}
+		}
+		return v, changed
+	case []any:
+		changed := false
+		for i, item := range v {
+			if newItem, itemChanged := redactEmailsValue(item); itemChanged {
+				v[i] = newItem
+				changed = true
+			}
+		}
+		return v, changed
+	default:
+		return v, false
+	}
+}
+
+// Organizations and their memberships
+
+// CreateOrganization inserts a new organization.
+func (db *PostgresDB) CreateOrganization(ctx context.Context, org *types.Organization) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO organizations (id, name, slug, tier, max_subscriptions, max_notifications_per_month, max_channels_per_subscription, usage_webhook_url, usage_webhook_secret, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		org.ID, org.Name, org.Slug, org.Tier, org.MaxSubscriptions, org.MaxNotificationsPerMonth, org.MaxChannelsPerSubscription,
+		nullableString(org.UsageWebhookURL), nullableString(org.UsageWebhookSecret), org.CreatedAt, org.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+	return nil
+}
+
+// organizationColumns lists organizations' columns in the order every
+// SELECT below scans them in.
+const organizationColumns = `id, name, slug, tier, max_subscriptions, max_notifications_per_month, max_channels_per_subscription, usage_webhook_url, usage_webhook_secret, created_at, updated_at`
+
+// scanOrganization scans a single organizationColumns row.
+func scanOrganization(row pgx.Row, org *types.Organization) error {
+	var usageWebhookURL, usageWebhookSecret *string
+	if err := row.Scan(
+		&org.ID, &org.Name, &org.Slug, &org.Tier, &org.MaxSubscriptions, &org.MaxNotificationsPerMonth, &org.MaxChannelsPerSubscription,
+		&usageWebhookURL, &usageWebhookSecret, &org.CreatedAt, &org.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if usageWebhookURL != nil {
+		org.UsageWebhookURL = *usageWebhookURL
+	}
+	if usageWebhookSecret != nil {
+		org.UsageWebhookSecret = *usageWebhookSecret
+	}
+	return nil
+}
+
+// GetOrganizationByID retrieves an organization by ID.
+func (db *PostgresDB) GetOrganizationByID(ctx context.Context, id uuid.UUID) (*types.Organization, error) {
+	var org types.Organization
+	err := scanOrganization(db.pool.QueryRow(ctx,
+		`SELECT `+organizationColumns+` FROM organizations WHERE id = $1`, id,
+	), &org)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return &org, nil
+}
+
+// GetOrganizationBySlug retrieves an organization by its unique slug.
+func (db *PostgresDB) GetOrganizationBySlug(ctx context.Context, slug string) (*types.Organization, error) {
+	var org types.Organization
+	err := scanOrganization(db.pool.QueryRow(ctx,
+		`SELECT `+organizationColumns+` FROM organizations WHERE slug = $1`, slug,
+	), &org)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization by slug: %w", err)
+	}
+	return &org, nil
+}
+
+// UpdateOrganization updates an organization's fields.
+func (db *PostgresDB) UpdateOrganization(ctx context.Context, org *types.Organization) error {
+	org.UpdatedAt = time.Now().UTC()
+
+	result, err := db.pool.Exec(ctx,
+		`UPDATE organizations SET name = $2, tier = $3, max_subscriptions = $4, max_notifications_per_month = $5,
+			max_channels_per_subscription = $6, usage_webhook_url = $7, usage_webhook_secret = $8, updated_at = $9
+		WHERE id = $1`,
+		org.ID, org.Name, org.Tier, org.MaxSubscriptions, org.MaxNotificationsPerMonth,
+		org.MaxChannelsPerSubscription, nullableString(org.UsageWebhookURL), nullableString(org.UsageWebhookSecret), org.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("organization not found: %s", org.ID)
+	}
+	return nil
+}
+
+// DeleteOrganization deletes an organization by ID. Its subscriptions are
+// kept, orphaned (org_id set to NULL by the foreign key), rather than
+// deleted along with it.
+func (db *PostgresDB) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	result, err := db.pool.Exec(ctx, `DELETE FROM organizations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("organization not found: %s", id)
+	}
+	return nil
+}
+
+// ListOrganizationsForUser retrieves every organization userID is a member
+// of, newest first.
+func (db *PostgresDB) ListOrganizationsForUser(ctx context.Context, userID uuid.UUID) ([]types.Organization, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT o.id, o.name, o.slug, o.tier, o.max_subscriptions, o.max_notifications_per_month, o.max_channels_per_subscription,
+		        o.usage_webhook_url, o.usage_webhook_secret, o.created_at, o.updated_at
+		FROM organizations o
+		JOIN organization_members m ON m.org_id = o.id
+		WHERE m.user_id = $1
+		ORDER BY o.created_at DESC`, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query organizations for user: %w", err)
+	}
+	defer rows.Close()
+
+	var orgs []types.Organization
+	for rows.Next() {
+		var org types.Organization
+		if err := scanOrganization(rows, &org); err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		orgs = append(orgs, org)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organizations: %w", err)
+	}
+
+	return orgs, nil
+}
+
+// CountNotificationsForOrganizationSince counts notifications sent for
+// subscriptions owned by orgID since a given time, for enforcing
+// Organization.MaxNotificationsPerMonth.
+func (db *PostgresDB) CountNotificationsForOrganizationSince(ctx context.Context, orgID uuid.UUID, since time.Time) (int, error) {
+	var count int
+	err := db.pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM notifications n
+		JOIN subscriptions s ON s.id = n.subscription_id
+		WHERE s.org_id = $1 AND n.created_at >= $2`, orgID, since,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notifications for organization: %w", err)
+	}
+	return count, nil
+}
+
+// ListOrganizationsWithUsageWebhook retrieves every organization that has
+// configured a usage webhook, for the usage webhook scheduler to iterate.
+func (db *PostgresDB) ListOrganizationsWithUsageWebhook(ctx context.Context) ([]types.Organization, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT `+organizationColumns+` FROM organizations WHERE usage_webhook_url IS NOT NULL AND usage_webhook_url != ''`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query organizations with usage webhook: %w", err)
+	}
+	defer rows.Close()
+
+	var orgs []types.Organization
+	for rows.Next() {
+		var org types.Organization
+		if err := scanOrganization(rows, &org); err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		orgs = append(orgs, org)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organizations: %w", err)
+	}
+
+	return orgs, nil
+}
+
+// CountSubscriptionsForOrganization counts how many subscriptions an
+// organization owns, for enforcing Organization.MaxSubscriptions.
+func (db *PostgresDB) CountSubscriptionsForOrganization(ctx context.Context, orgID uuid.UUID) (int, error) {
+	var count int
+	if err := db.pool.QueryRow(ctx, `SELECT COUNT(*) FROM subscriptions WHERE org_id = $1`, orgID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count subscriptions for organization: %w", err)
+	}
+	return count, nil
+}
+
+// ListSubscriptionsForOrganization retrieves all subscriptions owned by an
+// organization, with channels.
+func (db *PostgresDB) ListSubscriptionsForOrganization(ctx context.Context, orgID uuid.UUID) ([]types.Subscription, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint,
+		        notification_count, last_reset, last_notified, warm_up_until, warm_up_summary_sent, coalesce_window, user_id, org_id, created_at, updated_at
+		FROM subscriptions WHERE org_id = $1 ORDER BY created_at DESC`, orgID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subscriptions for organization: %w", err)
 	}
+	defer rows.Close()
 
-	if err := json.Unmarshal(serversData, &snapshot.Servers); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal servers: %w", err)
-	}
+	var subscriptions []types.Subscription
+	for rows.Next() {
+		var sub types.Subscription
+		var filters, routes, deliverySchedule, scheduledReport, allowedCIDRs []byte
+		var lastNotified *time.Time
+		var coalesceWindowNs int64
 
-	return &snapshot, nil
-}
+		if err := rows.Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &routes, &deliverySchedule, &scheduledReport, &allowedCIDRs, &sub.Status,
+			&sub.APIKey, &sub.APIKeyHint, &sub.NotificationCount, &sub.LastReset,
+			&lastNotified, &sub.WarmUpUntil, &sub.WarmUpSummarySent, &coalesceWindowNs, &sub.UserID, &sub.OrgID, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription: %w", err)
+		}
 
-// GetSnapshotAt retrieves the snapshot closest to the given timestamp.
-func (db *PostgresDB) GetSnapshotAt(ctx context.Context, timestamp time.Time) (*types.Snapshot, error) {
-	var snapshot types.Snapshot
-	var serversData []byte
+		if err := json.Unmarshal(filters, &sub.Filters); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal filters: %w", err)
+		}
+		if err := json.Unmarshal(routes, &sub.Routes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal routes: %w", err)
+		}
+		if err := json.Unmarshal(allowedCIDRs, &sub.AllowedCIDRs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal allowed_cidrs: %w", err)
+		}
+		if err := json.Unmarshal(deliverySchedule, &sub.DeliverySchedule); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal delivery schedule: %w", err)
+		}
+		if err := json.Unmarshal(scheduledReport, &sub.ScheduledReport); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scheduled report: %w", err)
+		}
+		sub.LastNotified = lastNotified
+		sub.CoalesceWindow = time.Duration(coalesceWindowNs)
 
-	err := db.pool.QueryRow(ctx,
-		`SELECT id, timestamp, server_count, hash, servers_data
-		FROM snapshots WHERE timestamp <= $1 ORDER BY timestamp DESC LIMIT 1`, timestamp,
-	).Scan(&snapshot.ID, &snapshot.Timestamp, &snapshot.ServerCount, &snapshot.Hash, &serversData)
+		channels, err := db.GetChannelsForSubscription(ctx, sub.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get channels for subscription %s: %w", sub.ID, err)
+		}
+		sub.Channels = channels
 
-	if err == pgx.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, err
+		subscriptions = append(subscriptions, sub)
 	}
 
-	if err := json.Unmarshal(serversData, &snapshot.Servers); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal servers: %w", err)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating subscriptions: %w", err)
 	}
 
-	return &snapshot, nil
-}
-
-// DeleteOldSnapshots removes snapshots older than the given time.
-func (db *PostgresDB) DeleteOldSnapshots(ctx context.Context, olderThan time.Time) error {
-	_, err := db.pool.Exec(ctx,
-		`DELETE FROM snapshots WHERE timestamp < $1`, olderThan,
-	)
-	return err
+	return subscriptions, nil
 }
 
-// SaveChange saves a change to the database.
-func (db *PostgresDB) SaveChange(ctx context.Context, change *types.Change) error {
-	fieldChanges, _ := json.Marshal(change.FieldChanges)
-	serverData, _ := json.Marshal(change.Server)
-	prevServerData, _ := json.Marshal(change.PreviousServer)
-
+// AddOrganizationMember adds a user to an organization with a role,
+// or updates their role if they're already a member.
+func (db *PostgresDB) AddOrganizationMember(ctx context.Context, member *types.OrganizationMember) error {
 	_, err := db.pool.Exec(ctx,
-		`INSERT INTO changes (id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, detected_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
-		change.ID, change.SnapshotID, change.ServerName, change.ChangeType,
-		change.PreviousVersion, change.NewVersion, fieldChanges, serverData, prevServerData, change.DetectedAt,
+		`INSERT INTO organization_members (org_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (org_id, user_id) DO UPDATE SET role = EXCLUDED.role`,
+		member.OrgID, member.UserID, member.Role, member.CreatedAt,
 	)
-	return err
+	if err != nil {
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return nil
 }
 
-// GetChangeByID retrieves a change by ID.
-func (db *PostgresDB) GetChangeByID(ctx context.Context, id uuid.UUID) (*types.Change, error) {
-	var change types.Change
-	var fieldChanges, serverData, prevServerData []byte
-
+// GetOrganizationMember retrieves a single member of an organization, or nil
+// if userID does not belong to it.
+func (db *PostgresDB) GetOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) (*types.OrganizationMember, error) {
+	var member types.OrganizationMember
 	err := db.pool.QueryRow(ctx,
-		`SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, detected_at
-		FROM changes WHERE id = $1`, id,
-	).Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
-		&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.DetectedAt)
+		`SELECT org_id, user_id, role, created_at FROM organization_members WHERE org_id = $1 AND user_id = $2`,
+		orgID, userID,
+	).Scan(&member.OrgID, &member.UserID, &member.Role, &member.CreatedAt)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
 	}
-
-	json.Unmarshal(fieldChanges, &change.FieldChanges)
-	json.Unmarshal(serverData, &change.Server)
-	json.Unmarshal(prevServerData, &change.PreviousServer)
-
-	return &change, nil
+	return &member, nil
 }
 
-// GetChangesSince retrieves changes since the given timestamp.
-func (db *PostgresDB) GetChangesSince(ctx context.Context, since time.Time, limit int) ([]types.Change, error) {
+// ListOrganizationMembers retrieves every member of an organization.
+func (db *PostgresDB) ListOrganizationMembers(ctx context.Context, orgID uuid.UUID) ([]types.OrganizationMember, error) {
 	rows, err := db.pool.Query(ctx,
-		`SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, detected_at
-		FROM changes WHERE detected_at >= $1 ORDER BY detected_at DESC LIMIT $2`, since, limit,
+		`SELECT org_id, user_id, role, created_at FROM organization_members WHERE org_id = $1 ORDER BY created_at ASC`, orgID,
 	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to query organization members: %w", err)
 	}
 	defer rows.Close()
 
-	var changes []types.Change
+	var members []types.OrganizationMember
 	for rows.Next() {
-		var change types.Change
-		var fieldChanges, serverData, prevServerData []byte
-
-		if err := rows.Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
-			&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.DetectedAt); err != nil {
-			return nil, err
+		var member types.OrganizationMember
+		if err := rows.Scan(&member.OrgID, &member.UserID, &member.Role, &member.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
 		}
+		members = append(members, member)
+	}
 
-		json.Unmarshal(fieldChanges, &change.FieldChanges)
-		json.Unmarshal(serverData, &change.Server)
-		json.Unmarshal(prevServerData, &change.PreviousServer)
-
-		changes = append(changes, change)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organization members: %w", err)
 	}
 
-	return changes, nil
+	return members, nil
 }
 
-// GetChangesForServer retrieves changes for a specific server.
-func (db *PostgresDB) GetChangesForServer(ctx context.Context, serverName string, limit int) ([]types.Change, error) {
-	rows, err := db.pool.Query(ctx,
-		`SELECT id, snapshot_id, server_name, change_type, previous_version, new_version, field_changes, server_data, previous_server_data, detected_at
-		FROM changes WHERE server_name = $1 ORDER BY detected_at DESC LIMIT $2`, serverName, limit,
+// RemoveOrganizationMember removes a user from an organization.
+func (db *PostgresDB) RemoveOrganizationMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	result, err := db.pool.Exec(ctx,
+		`DELETE FROM organization_members WHERE org_id = $1 AND user_id = $2`, orgID, userID,
 	)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to remove organization member: %w", err)
 	}
-	defer rows.Close()
-
-	var changes []types.Change
-	for rows.Next() {
-		var change types.Change
-		var fieldChanges, serverData, prevServerData []byte
-
-		if err := rows.Scan(&change.ID, &change.SnapshotID, &change.ServerName, &change.ChangeType,
-			&change.PreviousVersion, &change.NewVersion, &fieldChanges, &serverData, &prevServerData, &change.DetectedAt); err != nil {
-			return nil, err
-		}
-
-		json.Unmarshal(fieldChanges, &change.FieldChanges)
-		json.Unmarshal(serverData, &change.Server)
-		json.Unmarshal(prevServerData, &change.PreviousServer)
-
-		changes = append(changes, change)
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("organization member not found")
 	}
-
-	return changes, nil
-}
-
-// GetChangeCountSince returns the count of changes since the given timestamp.
-func (db *PostgresDB) GetChangeCountSince(ctx context.Context, since time.Time) (int, error) {
-	var count int
-	err := db.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM changes WHERE detected_at >= $1`, since,
-	).Scan(&count)
-	return count, err
+	return nil
 }
 
 // Subscription CRUD operations
 
 func (db *PostgresDB) CreateSubscription(ctx context.Context, sub *types.Subscription) error {
 	filters, _ := json.Marshal(sub.Filters)
+	routes, _ := json.Marshal(sub.Routes)
+	deliverySchedule, _ := json.Marshal(sub.DeliverySchedule)
+	scheduledReport, _ := json.Marshal(sub.ScheduledReport)
+	allowedCIDRs, _ := json.Marshal(sub.AllowedCIDRs)
 	_, err := db.pool.Exec(ctx,
-		`INSERT INTO subscriptions (id, name, description, filters, status, api_key_hash, api_key_hint, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
-		sub.ID, sub.Name, sub.Description, filters, sub.Status, sub.APIKey, sub.APIKeyHint, sub.CreatedAt, sub.UpdatedAt,
+		`INSERT INTO subscriptions (id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint, warm_up_until, coalesce_window, user_id, org_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`,
+		sub.ID, sub.Name, sub.Description, filters, routes, deliverySchedule, scheduledReport, allowedCIDRs, sub.Status, sub.APIKey, sub.APIKeyHint, sub.WarmUpUntil, int64(sub.CoalesceWindow), sub.UserID, sub.OrgID, sub.CreatedAt, sub.UpdatedAt,
 	)
 	return err
 }
@@ -402,16 +1810,17 @@ func (db *PostgresDB) CreateSubscription(ctx context.Context, sub *types.Subscri
 // GetSubscriptionByID retrieves a subscription by ID with its channels.
 func (db *PostgresDB) GetSubscriptionByID(ctx context.Context, id uuid.UUID) (*types.Subscription, error) {
 	var sub types.Subscription
-	var filters []byte
+	var filters, routes, deliverySchedule, scheduledReport, allowedCIDRs []byte
 	var lastNotified *time.Time
+	var coalesceWindowNs int64
 
 	err := db.pool.QueryRow(ctx,
-		`SELECT id, name, description, filters, status, api_key_hash, api_key_hint, 
-		        notification_count, last_reset, last_notified, created_at, updated_at
+		`SELECT id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint,
+		        notification_count, last_reset, last_notified, warm_up_until, warm_up_summary_sent, coalesce_window, user_id, org_id, created_at, updated_at
 		FROM subscriptions WHERE id = $1`, id,
-	).Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &sub.Status,
+	).Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &routes, &deliverySchedule, &scheduledReport, &allowedCIDRs, &sub.Status,
 		&sub.APIKey, &sub.APIKeyHint, &sub.NotificationCount, &sub.LastReset,
-		&lastNotified, &sub.CreatedAt, &sub.UpdatedAt)
+		&lastNotified, &sub.WarmUpUntil, &sub.WarmUpSummarySent, &coalesceWindowNs, &sub.UserID, &sub.OrgID, &sub.CreatedAt, &sub.UpdatedAt)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
@@ -423,7 +1832,20 @@ func (db *PostgresDB) GetSubscriptionByID(ctx context.Context, id uuid.UUID) (*t
 	if err := json.Unmarshal(filters, &sub.Filters); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal filters: %w", err)
 	}
+	if err := json.Unmarshal(routes, &sub.Routes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal routes: %w", err)
+	}
+	if err := json.Unmarshal(allowedCIDRs, &sub.AllowedCIDRs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal allowed_cidrs: %w", err)
+	}
+	if err := json.Unmarshal(deliverySchedule, &sub.DeliverySchedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delivery schedule: %w", err)
+	}
+	if err := json.Unmarshal(scheduledReport, &sub.ScheduledReport); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scheduled report: %w", err)
+	}
 	sub.LastNotified = lastNotified
+	sub.CoalesceWindow = time.Duration(coalesceWindowNs)
 
 	// Load channels
 	channels, err := db.GetChannelsForSubscription(ctx, id)
@@ -438,16 +1860,17 @@ func (db *PostgresDB) GetSubscriptionByID(ctx context.Context, id uuid.UUID) (*t
 // GetSubscriptionByAPIKey retrieves a subscription by hashed API key.
 func (db *PostgresDB) GetSubscriptionByAPIKey(ctx context.Context, apiKeyHash string) (*types.Subscription, error) {
 	var sub types.Subscription
-	var filters []byte
+	var filters, routes, deliverySchedule, scheduledReport, allowedCIDRs []byte
 	var lastNotified *time.Time
+	var coalesceWindowNs int64
 
 	err := db.pool.QueryRow(ctx,
-		`SELECT id, name, description, filters, status, api_key_hash, api_key_hint,
-		        notification_count, last_reset, last_notified, created_at, updated_at
+		`SELECT id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint,
+		        notification_count, last_reset, last_notified, warm_up_until, warm_up_summary_sent, coalesce_window, user_id, org_id, created_at, updated_at
 		FROM subscriptions WHERE api_key_hash = $1`, apiKeyHash,
-	).Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &sub.Status,
+	).Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &routes, &deliverySchedule, &scheduledReport, &allowedCIDRs, &sub.Status,
 		&sub.APIKey, &sub.APIKeyHint, &sub.NotificationCount, &sub.LastReset,
-		&lastNotified, &sub.CreatedAt, &sub.UpdatedAt)
+		&lastNotified, &sub.WarmUpUntil, &sub.WarmUpSummarySent, &coalesceWindowNs, &sub.UserID, &sub.OrgID, &sub.CreatedAt, &sub.UpdatedAt)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
@@ -459,7 +1882,20 @@ func (db *PostgresDB) GetSubscriptionByAPIKey(ctx context.Context, apiKeyHash st
 	if err := json.Unmarshal(filters, &sub.Filters); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal filters: %w", err)
 	}
+	if err := json.Unmarshal(routes, &sub.Routes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal routes: %w", err)
+	}
+	if err := json.Unmarshal(allowedCIDRs, &sub.AllowedCIDRs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal allowed_cidrs: %w", err)
+	}
+	if err := json.Unmarshal(deliverySchedule, &sub.DeliverySchedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delivery schedule: %w", err)
+	}
+	if err := json.Unmarshal(scheduledReport, &sub.ScheduledReport); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scheduled report: %w", err)
+	}
 	sub.LastNotified = lastNotified
+	sub.CoalesceWindow = time.Duration(coalesceWindowNs)
 
 	// Load channels
 	channels, err := db.GetChannelsForSubscription(ctx, sub.ID)
@@ -474,8 +1910,8 @@ func (db *PostgresDB) GetSubscriptionByAPIKey(ctx context.Context, apiKeyHash st
 // GetActiveSubscriptions retrieves all active subscriptions with their channels.
 func (db *PostgresDB) GetActiveSubscriptions(ctx context.Context) ([]types.Subscription, error) {
 	rows, err := db.pool.Query(ctx,
-		`SELECT id, name, description, filters, status, api_key_hash, api_key_hint,
-		        notification_count, last_reset, last_notified, created_at, updated_at
+		`SELECT id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint,
+		        notification_count, last_reset, last_notified, warm_up_until, warm_up_summary_sent, coalesce_window, user_id, org_id, created_at, updated_at
 		FROM subscriptions WHERE status = 'active'`,
 	)
 	if err != nil {
@@ -486,19 +1922,33 @@ func (db *PostgresDB) GetActiveSubscriptions(ctx context.Context) ([]types.Subsc
 	var subscriptions []types.Subscription
 	for rows.Next() {
 		var sub types.Subscription
-		var filters []byte
+		var filters, routes, deliverySchedule, scheduledReport, allowedCIDRs []byte
 		var lastNotified *time.Time
+		var coalesceWindowNs int64
 
-		if err := rows.Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &sub.Status,
+		if err := rows.Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &routes, &deliverySchedule, &scheduledReport, &allowedCIDRs, &sub.Status,
 			&sub.APIKey, &sub.APIKeyHint, &sub.NotificationCount, &sub.LastReset,
-			&lastNotified, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+			&lastNotified, &sub.WarmUpUntil, &sub.WarmUpSummarySent, &coalesceWindowNs, &sub.UserID, &sub.OrgID, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan subscription: %w", err)
 		}
 
 		if err := json.Unmarshal(filters, &sub.Filters); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal filters: %w", err)
 		}
+		if err := json.Unmarshal(routes, &sub.Routes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal routes: %w", err)
+		}
+		if err := json.Unmarshal(allowedCIDRs, &sub.AllowedCIDRs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal allowed_cidrs: %w", err)
+		}
+		if err := json.Unmarshal(deliverySchedule, &sub.DeliverySchedule); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal delivery schedule: %w", err)
+		}
+		if err := json.Unmarshal(scheduledReport, &sub.ScheduledReport); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scheduled report: %w", err)
+		}
 		sub.LastNotified = lastNotified
+		sub.CoalesceWindow = time.Duration(coalesceWindowNs)
 
 		// Load channels for each subscription
 		channels, err := db.GetChannelsForSubscription(ctx, sub.ID)
@@ -523,16 +1973,34 @@ func (db *PostgresDB) UpdateSubscription(ctx context.Context, sub *types.Subscri
 	if err != nil {
 		return fmt.Errorf("failed to marshal filters: %w", err)
 	}
+	routes, err := json.Marshal(sub.Routes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal routes: %w", err)
+	}
+	deliverySchedule, err := json.Marshal(sub.DeliverySchedule)
+	if err != nil {
+		return fmt.Errorf("failed to marshal delivery schedule: %w", err)
+	}
+	scheduledReport, err := json.Marshal(sub.ScheduledReport)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduled report: %w", err)
+	}
+	allowedCIDRs, err := json.Marshal(sub.AllowedCIDRs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal allowed_cidrs: %w", err)
+	}
 
 	sub.UpdatedAt = time.Now().UTC()
 
 	result, err := db.pool.Exec(ctx,
-		`UPDATE subscriptions SET 
-			name = $2, description = $3, filters = $4, status = $5,
-			notification_count = $6, last_reset = $7, last_notified = $8, updated_at = $9
+		`UPDATE subscriptions SET
+			name = $2, description = $3, filters = $4, routes = $5, delivery_schedule = $6, scheduled_report = $7, allowed_cidrs = $8, status = $9,
+			notification_count = $10, last_reset = $11, last_notified = $12,
+			warm_up_until = $13, warm_up_summary_sent = $14, coalesce_window = $15, updated_at = $16
 		WHERE id = $1`,
-		sub.ID, sub.Name, sub.Description, filters, sub.Status,
-		sub.NotificationCount, sub.LastReset, sub.LastNotified, sub.UpdatedAt,
+		sub.ID, sub.Name, sub.Description, filters, routes, deliverySchedule, scheduledReport, allowedCIDRs, sub.Status,
+		sub.NotificationCount, sub.LastReset, sub.LastNotified,
+		sub.WarmUpUntil, sub.WarmUpSummarySent, int64(sub.CoalesceWindow), sub.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update subscription: %w", err)
@@ -567,8 +2035,8 @@ func (db *PostgresDB) ListSubscriptions(ctx context.Context, limit, offset int)
 
 	// Get paginated results
 	rows, err := db.pool.Query(ctx,
-		`SELECT id, name, description, filters, status, api_key_hash, api_key_hint,
-		        notification_count, last_reset, last_notified, created_at, updated_at
+		`SELECT id, name, description, filters, routes, delivery_schedule, scheduled_report, allowed_cidrs, status, api_key_hash, api_key_hint,
+		        notification_count, last_reset, last_notified, warm_up_until, warm_up_summary_sent, coalesce_window, user_id, org_id, created_at, updated_at
 		FROM subscriptions ORDER BY created_at DESC LIMIT $1 OFFSET $2`, limit, offset,
 	)
 	if err != nil {
@@ -579,19 +2047,33 @@ func (db *PostgresDB) ListSubscriptions(ctx context.Context, limit, offset int)
 	var subscriptions []types.Subscription
 	for rows.Next() {
 		var sub types.Subscription
-		var filters []byte
+		var filters, routes, deliverySchedule, scheduledReport, allowedCIDRs []byte
 		var lastNotified *time.Time
+		var coalesceWindowNs int64
 
-		if err := rows.Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &sub.Status,
+		if err := rows.Scan(&sub.ID, &sub.Name, &sub.Description, &filters, &routes, &deliverySchedule, &scheduledReport, &allowedCIDRs, &sub.Status,
 			&sub.APIKey, &sub.APIKeyHint, &sub.NotificationCount, &sub.LastReset,
-			&lastNotified, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+			&lastNotified, &sub.WarmUpUntil, &sub.WarmUpSummarySent, &coalesceWindowNs, &sub.UserID, &sub.OrgID, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan subscription: %w", err)
 		}
 
 		if err := json.Unmarshal(filters, &sub.Filters); err != nil {
 			return nil, 0, fmt.Errorf("failed to unmarshal filters: %w", err)
 		}
+		if err := json.Unmarshal(routes, &sub.Routes); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal routes: %w", err)
+		}
+		if err := json.Unmarshal(allowedCIDRs, &sub.AllowedCIDRs); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal allowed_cidrs: %w", err)
+		}
+		if err := json.Unmarshal(deliverySchedule, &sub.DeliverySchedule); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal delivery schedule: %w", err)
+		}
+		if err := json.Unmarshal(scheduledReport, &sub.ScheduledReport); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal scheduled report: %w", err)
+		}
 		sub.LastNotified = lastNotified
+		sub.CoalesceWindow = time.Duration(coalesceWindowNs)
 
 		// Load channels
 		channels, err := db.GetChannelsForSubscription(ctx, sub.ID)
@@ -637,10 +2119,10 @@ func (db *PostgresDB) GetChannelByID(ctx context.Context, id uuid.UUID) (*types.
 
 	err := db.pool.QueryRow(ctx,
 		`SELECT id, subscription_id, type, config, enabled, success_count, failure_count,
-		        last_success, last_failure, last_error, created_at
+		        consecutive_failures, last_success, last_failure, last_error, created_at
 		FROM channels WHERE id = $1`, id,
 	).Scan(&channel.ID, &channel.SubscriptionID, &channel.Type, &configData, &channel.Enabled,
-		&channel.SuccessCount, &channel.FailureCount, &lastSuccess, &lastFailure, &lastError, &channel.CreatedAt)
+		&channel.SuccessCount, &channel.FailureCount, &channel.ConsecutiveFailures, &lastSuccess, &lastFailure, &lastError, &channel.CreatedAt)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
@@ -665,7 +2147,7 @@ func (db *PostgresDB) GetChannelByID(ctx context.Context, id uuid.UUID) (*types.
 func (db *PostgresDB) GetChannelsForSubscription(ctx context.Context, subscriptionID uuid.UUID) ([]types.Channel, error) {
 	rows, err := db.pool.Query(ctx,
 		`SELECT id, subscription_id, type, config, enabled, success_count, failure_count,
-		        last_success, last_failure, last_error, created_at
+		        consecutive_failures, last_success, last_failure, last_error, created_at
 		FROM channels WHERE subscription_id = $1`, subscriptionID,
 	)
 	if err != nil {
@@ -681,7 +2163,7 @@ func (db *PostgresDB) GetChannelsForSubscription(ctx context.Context, subscripti
 		var lastError *string
 
 		if err := rows.Scan(&channel.ID, &channel.SubscriptionID, &channel.Type, &configData, &channel.Enabled,
-			&channel.SuccessCount, &channel.FailureCount, &lastSuccess, &lastFailure, &lastError, &channel.CreatedAt); err != nil {
+			&channel.SuccessCount, &channel.FailureCount, &channel.ConsecutiveFailures, &lastSuccess, &lastFailure, &lastError, &channel.CreatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan channel: %w", err)
 		}
 
@@ -714,10 +2196,10 @@ func (db *PostgresDB) UpdateChannel(ctx context.Context, channel *types.Channel)
 	result, err := db.pool.Exec(ctx,
 		`UPDATE channels SET
 			type = $2, config = $3, enabled = $4, success_count = $5, failure_count = $6,
-			last_success = $7, last_failure = $8, last_error = $9
+			consecutive_failures = $7, last_success = $8, last_failure = $9, last_error = $10
 		WHERE id = $1`,
 		channel.ID, channel.Type, configData, channel.Enabled, channel.SuccessCount, channel.FailureCount,
-		channel.LastSuccess, channel.LastFailure, channel.LastError,
+		channel.ConsecutiveFailures, channel.LastSuccess, channel.LastFailure, channel.LastError,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update channel: %w", err)
@@ -745,11 +2227,12 @@ func (db *PostgresDB) DeleteChannel(ctx context.Context, id uuid.UUID) error {
 // SaveNotification saves a notification record.
 func (db *PostgresDB) SaveNotification(ctx context.Context, notification *types.Notification) error {
 	_, err := db.pool.Exec(ctx,
-		`INSERT INTO notifications (id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		`INSERT INTO notifications (id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at, server_name, change_type, payload_snippet)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
 		notification.ID, notification.SubscriptionID, notification.ChannelID, notification.ChangeID,
 		notification.Status, notification.Attempts, notification.NextRetry, notification.SentAt,
-		notification.Error, notification.CreatedAt,
+		notification.Error, notification.CreatedAt, notification.ServerName, notification.ChangeType,
+		notification.PayloadSnippet,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to save notification: %w", err)
@@ -757,6 +2240,38 @@ func (db *PostgresDB) SaveNotification(ctx context.Context, notification *types.
 	return nil
 }
 
+// SaveNotifications saves multiple pending notification records in a single
+// round trip using a batched pipeline of the same statement SaveNotification
+// issues, for batch dispatch paths like DispatchBatchByChannel.
+func (db *PostgresDB) SaveNotifications(ctx context.Context, notifications []types.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for i := range notifications {
+		notification := &notifications[i]
+		batch.Queue(
+			`INSERT INTO notifications (id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at, server_name, change_type, payload_snippet)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			notification.ID, notification.SubscriptionID, notification.ChannelID, notification.ChangeID,
+			notification.Status, notification.Attempts, notification.NextRetry, notification.SentAt,
+			notification.Error, notification.CreatedAt, notification.ServerName, notification.ChangeType,
+			notification.PayloadSnippet,
+		)
+	}
+
+	results := db.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for i := range notifications {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to save notification %d of %d: %w", i+1, len(notifications), err)
+		}
+	}
+	return results.Close()
+}
+
 // UpdateNotification updates a notification's status, attempts, and error.
 func (db *PostgresDB) UpdateNotification(ctx context.Context, notification *types.Notification) error {
 	result, err := db.pool.Exec(ctx,
@@ -780,7 +2295,7 @@ func (db *PostgresDB) UpdateNotification(ctx context.Context, notification *type
 // GetPendingNotifications retrieves pending notifications for retry processing.
 func (db *PostgresDB) GetPendingNotifications(ctx context.Context, limit int) ([]types.Notification, error) {
 	rows, err := db.pool.Query(ctx,
-		`SELECT id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at
+		`SELECT id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at, server_name, change_type, payload_snippet
 		FROM notifications
 		WHERE status = 'pending' AND (next_retry IS NULL OR next_retry <= NOW())
 		ORDER BY created_at ASC LIMIT $1`, limit,
@@ -790,28 +2305,9 @@ func (db *PostgresDB) GetPendingNotifications(ctx context.Context, limit int) ([
 	}
 	defer rows.Close()
 
-	var notifications []types.Notification
-	for rows.Next() {
-		var n types.Notification
-		var nextRetry, sentAt *time.Time
-		var errStr *string
-
-		if err := rows.Scan(&n.ID, &n.SubscriptionID, &n.ChannelID, &n.ChangeID, &n.Status,
-			&n.Attempts, &nextRetry, &sentAt, &errStr, &n.CreatedAt); err != nil {
-			return nil, fmt.Errorf("failed to scan notification: %w", err)
-		}
-
-		n.NextRetry = nextRetry
-		n.SentAt = sentAt
-		if errStr != nil {
-			n.Error = *errStr
-		}
-
-		notifications = append(notifications, n)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating notifications: %w", err)
+	notifications, err := scanNotifications(rows)
+	if err != nil {
+		return nil, err
 	}
 
 	return notifications, nil
@@ -820,7 +2316,7 @@ func (db *PostgresDB) GetPendingNotifications(ctx context.Context, limit int) ([
 // GetNotificationsForSubscription retrieves notification history for a subscription.
 func (db *PostgresDB) GetNotificationsForSubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]types.Notification, error) {
 	rows, err := db.pool.Query(ctx,
-		`SELECT id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at
+		`SELECT id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at, server_name, change_type, payload_snippet
 		FROM notifications
 		WHERE subscription_id = $1
 		ORDER BY created_at DESC LIMIT $2`, subscriptionID, limit,
@@ -830,6 +2326,43 @@ func (db *PostgresDB) GetNotificationsForSubscription(ctx context.Context, subsc
 	}
 	defer rows.Close()
 
+	notifications, err := scanNotifications(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// SearchNotifications performs a full-text search over a subscription's
+// notification history, matching against the server name, change type,
+// error text, and rendered payload snippet, so a user can answer "did I
+// ever get notified about server X" without trawling chat history.
+func (db *PostgresDB) SearchNotifications(ctx context.Context, subscriptionID uuid.UUID, query string, limit int) ([]types.Notification, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, subscription_id, channel_id, change_id, status, attempts, next_retry, sent_at, error, created_at, server_name, change_type, payload_snippet
+		FROM notifications
+		WHERE subscription_id = $1
+		AND to_tsvector('english', server_name || ' ' || change_type || ' ' || COALESCE(error, '') || ' ' || payload_snippet)
+			@@ plainto_tsquery('english', $2)
+		ORDER BY created_at DESC LIMIT $3`, subscriptionID, query, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications, err := scanNotifications(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// scanNotifications scans rows produced by any of the notification queries
+// above; they all select the same column set in the same order.
+func scanNotifications(rows pgx.Rows) ([]types.Notification, error) {
 	var notifications []types.Notification
 	for rows.Next() {
 		var n types.Notification
@@ -837,7 +2370,8 @@ func (db *PostgresDB) GetNotificationsForSubscription(ctx context.Context, subsc
 		var errStr *string
 
 		if err := rows.Scan(&n.ID, &n.SubscriptionID, &n.ChannelID, &n.ChangeID, &n.Status,
-			&n.Attempts, &nextRetry, &sentAt, &errStr, &n.CreatedAt); err != nil {
+			&n.Attempts, &nextRetry, &sentAt, &errStr, &n.CreatedAt, &n.ServerName, &n.ChangeType,
+			&n.PayloadSnippet); err != nil {
 			return nil, fmt.Errorf("failed to scan notification: %w", err)
 		}
 
@@ -861,60 +2395,310 @@ func (db *PostgresDB) GetNotificationsForSubscription(ctx context.Context, subsc
 func (db *PostgresDB) GetStats(ctx context.Context) (*types.StatsResponse, error) {
 	stats := &types.StatsResponse{}
 
-	// Get subscription counts
-	err := db.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM subscriptions`,
-	).Scan(&stats.TotalSubscriptions)
+	err := db.withReadFallback(ctx, func(pool *pgxpool.Pool) error {
+		// Get subscription counts
+		if err := pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM subscriptions`,
+		).Scan(&stats.TotalSubscriptions); err != nil {
+			return fmt.Errorf("failed to count subscriptions: %w", err)
+		}
+
+		if err := pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM subscriptions WHERE status = 'active'`,
+		).Scan(&stats.ActiveSubscriptions); err != nil {
+			return fmt.Errorf("failed to count active subscriptions: %w", err)
+		}
+
+		// Get change counts
+		if err := pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM changes`,
+		).Scan(&stats.TotalChanges); err != nil {
+			return fmt.Errorf("failed to count changes: %w", err)
+		}
+
+		twentyFourHoursAgo := time.Now().UTC().Add(-24 * time.Hour)
+		if err := pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM changes WHERE detected_at >= $1`, twentyFourHoursAgo,
+		).Scan(&stats.ChangesLast24h); err != nil {
+			return fmt.Errorf("failed to count recent changes: %w", err)
+		}
+
+		// Get notification count
+		if err := pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM notifications`,
+		).Scan(&stats.TotalNotifications); err != nil {
+			return fmt.Errorf("failed to count notifications: %w", err)
+		}
+
+		// Get last poll time and server count from latest snapshot
+		var lastPollTime *time.Time
+		var serverCount *int
+		err := pool.QueryRow(ctx,
+			`SELECT timestamp, server_count FROM snapshots ORDER BY timestamp DESC LIMIT 1`,
+		).Scan(&lastPollTime, &serverCount)
+		if err != nil && err != pgx.ErrNoRows {
+			return fmt.Errorf("failed to get latest snapshot: %w", err)
+		}
+		stats.LastPollTime = time.Time{}
+		stats.ServerCount = 0
+		if lastPollTime != nil {
+			stats.LastPollTime = *lastPollTime
+		}
+		if serverCount != nil {
+			stats.ServerCount = *serverCount
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to count subscriptions: %w", err)
+		return nil, err
 	}
 
-	err = db.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM subscriptions WHERE status = 'active'`,
-	).Scan(&stats.ActiveSubscriptions)
+	return stats, nil
+}
+
+// RecordPoll saves a poll attempt so gaps in monitoring coverage can later be
+// computed from consecutive records.
+func (db *PostgresDB) RecordPoll(ctx context.Context, record *types.PollRecord) error {
+	_, err := db.pool.Exec(ctx,
+		`INSERT INTO poll_history (id, started_at, completed_at, success, error, server_count)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		record.ID, record.StartedAt, record.CompletedAt, record.Success, record.Error, record.ServerCount,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count active subscriptions: %w", err)
+		return fmt.Errorf("failed to record poll: %w", err)
 	}
+	return nil
+}
 
-	// Get change counts
-	err = db.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM changes`,
-	).Scan(&stats.TotalChanges)
+// GetPollHistorySince retrieves poll attempts since the given timestamp,
+// ordered oldest first so callers can walk consecutive records to find gaps.
+func (db *PostgresDB) GetPollHistorySince(ctx context.Context, since time.Time) ([]types.PollRecord, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT id, started_at, completed_at, success, error, server_count
+		FROM poll_history WHERE completed_at >= $1 ORDER BY completed_at ASC`,
+		since,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count changes: %w", err)
+		return nil, fmt.Errorf("failed to query poll history: %w", err)
+	}
+	defer rows.Close()
+
+	var records []types.PollRecord
+	for rows.Next() {
+		var record types.PollRecord
+		var pollErr *string
+		if err := rows.Scan(&record.ID, &record.StartedAt, &record.CompletedAt, &record.Success, &pollErr, &record.ServerCount); err != nil {
+			return nil, fmt.Errorf("failed to scan poll record: %w", err)
+		}
+		if pollErr != nil {
+			record.Error = *pollErr
+		}
+		records = append(records, record)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate poll history: %w", err)
+	}
+
+	return records, nil
+}
 
-	twentyFourHoursAgo := time.Now().UTC().Add(-24 * time.Hour)
-	err = db.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM changes WHERE detected_at >= $1`, twentyFourHoursAgo,
-	).Scan(&stats.ChangesLast24h)
+// PruneOldNotifications removes notifications in a terminal state (sent or
+// dead_letter) older than the given time, returning the number of rows
+// deleted. Pending notifications are left alone regardless of age, since
+// they may still be retried.
+func (db *PostgresDB) PruneOldNotifications(ctx context.Context, olderThan time.Time) (int64, error) {
+	result, err := db.pool.Exec(ctx,
+		`DELETE FROM notifications WHERE status IN ('sent', 'dead_letter') AND created_at < $1`,
+		olderThan,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count recent changes: %w", err)
+		return 0, fmt.Errorf("failed to prune old notifications: %w", err)
 	}
+	return result.RowsAffected(), nil
+}
+
+// GetSubscriptionAnalytics returns time-bucketed delivery counts, per-channel
+// success rates and median latency, and the noisiest servers for a
+// subscription since the given time, all computed by aggregate SQL so the
+// endpoint stays cheap regardless of how much notification history the
+// subscription has accumulated.
+func (db *PostgresDB) GetSubscriptionAnalytics(ctx context.Context, subscriptionID uuid.UUID, since time.Time, bucketSize time.Duration) (*types.SubscriptionAnalytics, error) {
+	analytics := &types.SubscriptionAnalytics{
+		SubscriptionID: subscriptionID,
+		Since:          since,
+		BucketSizeMS:   bucketSize.Milliseconds(),
+	}
+
+	err := db.withReadFallback(ctx, func(pool *pgxpool.Pool) error {
+		analytics.Buckets = nil
+		analytics.ChannelStats = nil
+		analytics.NoisyServers = nil
+
+		bucketSeconds := bucketSize.Seconds()
+
+		bucketRows, err := pool.Query(ctx,
+			`SELECT to_timestamp(floor(extract(epoch from created_at) / $3) * $3) AS bucket_start,
+				COUNT(*) FILTER (WHERE status = 'sent') AS sent,
+				COUNT(*) FILTER (WHERE status = 'dead_letter') AS failed,
+				COUNT(*) FILTER (WHERE status = 'pending') AS pending
+			FROM notifications
+			WHERE subscription_id = $1 AND created_at >= $2
+			GROUP BY bucket_start
+			ORDER BY bucket_start`,
+			subscriptionID, since, bucketSeconds,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to query delivery buckets: %w", err)
+		}
+		for bucketRows.Next() {
+			var b types.AnalyticsBucket
+			if err := bucketRows.Scan(&b.BucketStart, &b.Sent, &b.Failed, &b.Pending); err != nil {
+				bucketRows.Close()
+				return fmt.Errorf("failed to scan delivery bucket: %w", err)
+			}
+			analytics.Buckets = append(analytics.Buckets, b)
+		}
+		if err := bucketRows.Err(); err != nil {
+			bucketRows.Close()
+			return fmt.Errorf("error iterating delivery buckets: %w", err)
+		}
+		bucketRows.Close()
+
+		channelRows, err := pool.Query(ctx,
+			`SELECT n.channel_id, c.type,
+				COUNT(*) FILTER (WHERE n.status = 'sent') AS sent,
+				COUNT(*) FILTER (WHERE n.status = 'dead_letter') AS failed,
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch from (n.sent_at - n.created_at)) * 1000)
+					FILTER (WHERE n.status = 'sent' AND n.sent_at IS NOT NULL) AS median_latency_ms
+			FROM notifications n
+			JOIN channels c ON c.id = n.channel_id
+			WHERE n.subscription_id = $1 AND n.created_at >= $2
+			GROUP BY n.channel_id, c.type`,
+			subscriptionID, since,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to query channel analytics: %w", err)
+		}
+		for channelRows.Next() {
+			var c types.ChannelAnalytics
+			var medianLatencyMS *float64
+			if err := channelRows.Scan(&c.ChannelID, &c.ChannelType, &c.Sent, &c.Failed, &medianLatencyMS); err != nil {
+				channelRows.Close()
+				return fmt.Errorf("failed to scan channel analytics: %w", err)
+			}
+			if c.Sent+c.Failed > 0 {
+				c.SuccessRate = float64(c.Sent) / float64(c.Sent+c.Failed)
+			}
+			if medianLatencyMS != nil {
+				c.MedianLatencyMS = int64(*medianLatencyMS)
+			} else {
+				c.MedianLatencyMS = -1
+			}
+			analytics.ChannelStats = append(analytics.ChannelStats, c)
+		}
+		if err := channelRows.Err(); err != nil {
+			channelRows.Close()
+			return fmt.Errorf("error iterating channel analytics: %w", err)
+		}
+		channelRows.Close()
+
+		serverRows, err := pool.Query(ctx,
+			`SELECT server_name, COUNT(*) AS notification_count
+			FROM notifications
+			WHERE subscription_id = $1 AND created_at >= $2 AND server_name != ''
+			GROUP BY server_name
+			ORDER BY notification_count DESC
+			LIMIT 10`,
+			subscriptionID, since,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to query noisy servers: %w", err)
+		}
+		for serverRows.Next() {
+			var s types.NoisyServer
+			if err := serverRows.Scan(&s.ServerName, &s.NotificationCount); err != nil {
+				serverRows.Close()
+				return fmt.Errorf("failed to scan noisy server: %w", err)
+			}
+			analytics.NoisyServers = append(analytics.NoisyServers, s)
+		}
+		if err := serverRows.Err(); err != nil {
+			serverRows.Close()
+			return fmt.Errorf("error iterating noisy servers: %w", err)
+		}
+		serverRows.Close()
 
-	// Get notification count
-	err = db.pool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM notifications`,
-	).Scan(&stats.TotalNotifications)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to count notifications: %w", err)
+		return nil, err
+	}
+
+	return analytics, nil
+}
+
+// VacuumTables runs VACUUM ANALYZE against each of the given tables,
+// returning the approximate number of bytes reclaimed (measured via
+// pg_total_relation_size before and after). tables must be trusted, fixed
+// table names; VACUUM does not support query parameters.
+func (db *PostgresDB) VacuumTables(ctx context.Context, tables []string) (int64, error) {
+	var reclaimed int64
+	for _, table := range tables {
+		before, err := db.tableSizeBytes(ctx, table)
+		if err != nil {
+			return reclaimed, fmt.Errorf("failed to measure size of %s before vacuum: %w", table, err)
+		}
+
+		if _, err := db.pool.Exec(ctx, fmt.Sprintf("VACUUM ANALYZE %s", table)); err != nil {
+			return reclaimed, fmt.Errorf("failed to vacuum table %s: %w", table, err)
+		}
+
+		after, err := db.tableSizeBytes(ctx, table)
+		if err != nil {
+			return reclaimed, fmt.Errorf("failed to measure size of %s after vacuum: %w", table, err)
+		}
+
+		if before > after {
+			reclaimed += before - after
+		}
+	}
+	return reclaimed, nil
+}
+
+// tableSizeBytes returns a table's total on-disk size, including indexes.
+func (db *PostgresDB) tableSizeBytes(ctx context.Context, table string) (int64, error) {
+	var size int64
+	if err := db.pool.QueryRow(ctx, `SELECT pg_total_relation_size($1)`, table).Scan(&size); err != nil {
+		return 0, err
 	}
+	return size, nil
+}
 
-	// Get last poll time and server count from latest snapshot
-	var lastPollTime *time.Time
-	var serverCount *int
-	err = db.pool.QueryRow(ctx,
-		`SELECT timestamp, server_count FROM snapshots ORDER BY timestamp DESC LIMIT 1`,
-	).Scan(&lastPollTime, &serverCount)
-	if err != nil && err != pgx.ErrNoRows {
-		return nil, fmt.Errorf("failed to get latest snapshot: %w", err)
+// WithMaintenanceLock runs fn while holding a Postgres advisory lock named
+// name, acquired and released on the same connection since advisory locks
+// are session-scoped. Returns false without running fn if the lock is
+// already held elsewhere, so overlapping schedulers (e.g. a maintenance job
+// and the poller, or two replicas' maintenance jobs) never run at once.
+func (db *PostgresDB) WithMaintenanceLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	conn, err := db.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
 	}
-	if lastPollTime != nil {
-		stats.LastPollTime = *lastPollTime
+	defer conn.Release()
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, name).Scan(&acquired); err != nil {
+		return false, fmt.Errorf("failed to acquire advisory lock %s: %w", name, err)
 	}
-	if serverCount != nil {
-		stats.ServerCount = *serverCount
+	if !acquired {
+		return false, nil
 	}
+	defer func() {
+		if _, err := conn.Exec(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, name); err != nil {
+			log.Error().Err(err).Str("lock", name).Msg("Failed to release advisory lock")
+		}
+	}()
 
-	return stats, nil
+	return true, fn(ctx)
 }
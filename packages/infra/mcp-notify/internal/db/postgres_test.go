@@ -134,8 +134,9 @@ func TestPostgresDB_Snapshot(t *testing.T) {
 	assert.Nil(t, nonExistent)
 
 	// Delete old snapshots
-	err = db.DeleteOldSnapshots(ctx, time.Now().Add(time.Hour))
+	deletedCount, err := db.DeleteOldSnapshots(ctx, time.Now().Add(time.Hour))
 	require.NoError(t, err)
+	assert.Equal(t, int64(1), deletedCount)
 
 	// Verify deleted
 	deleted, err := db.GetLatestSnapshot(ctx)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 
@@ -16,8 +17,9 @@ import (
 
 const (
 	// Default cache key prefixes
-	snapshotCacheKey = "mcp:snapshot:latest"
-	rateLimitPrefix  = "mcp:ratelimit:"
+	snapshotCacheKey    = "mcp:snapshot:latest"
+	rateLimitPrefix     = "mcp:ratelimit:"
+	slidingWindowPrefix = "mcp:ratelimit:sliding:"
 )
 
 // RedisCache implements the Cache interface using Redis.
@@ -171,6 +173,66 @@ func (c *RedisCache) IncrementRateLimit(ctx context.Context, key string, window
 	return result, nil
 }
 
+// slidingWindowScript implements a sliding-window-log rate limiter using a
+// Redis sorted set: each request is a member scored by its own timestamp, so
+// ZREMRANGEBYSCORE can evict everything older than the window on every call
+// before counting. Unlike IncrementRateLimit's fixed-window counter, this
+// never lets a burst straddling a window boundary through at up to 2x limit.
+var slidingWindowScript = redis.NewScript(`
+	local key = KEYS[1]
+	local now = tonumber(ARGV[1])
+	local window = tonumber(ARGV[2])
+	local limit = tonumber(ARGV[3])
+	local member = ARGV[4]
+
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+	local count = redis.call('ZCARD', key)
+
+	local allowed = 0
+	if count < limit then
+		redis.call('ZADD', key, now, member)
+		redis.call('PEXPIRE', key, window)
+		allowed = 1
+		count = count + 1
+	end
+
+	local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+	local resetAt = now + window
+	if #oldest > 0 then
+		resetAt = tonumber(oldest[2]) + window
+	end
+
+	local remaining = limit - count
+	if remaining < 0 then
+		remaining = 0
+	end
+
+	return {allowed, remaining, resetAt}
+`)
+
+// SlidingWindowAllow checks and records a request against a sliding-window
+// rate limit, see the Cache interface for details.
+func (c *RedisCache) SlidingWindowAllow(ctx context.Context, key string, limit int64, window time.Duration) (bool, int64, time.Time, error) {
+	fullKey := slidingWindowPrefix + key
+	now := time.Now()
+	member := fmt.Sprintf("%d-%s", now.UnixNano(), uuid.New().String())
+
+	result, err := slidingWindowScript.Run(ctx, c.client, []string{fullKey},
+		now.UnixMilli(), window.Milliseconds(), limit, member).Slice()
+	if err != nil {
+		return false, 0, time.Time{}, fmt.Errorf("failed to check sliding window rate limit for %s: %w", key, err)
+	}
+	if len(result) != 3 {
+		return false, 0, time.Time{}, fmt.Errorf("unexpected sliding window script result for %s", key)
+	}
+
+	allowed, _ := result[0].(int64)
+	remaining, _ := result[1].(int64)
+	resetAtMs, _ := result[2].(int64)
+
+	return allowed == 1, remaining, time.UnixMilli(resetAtMs), nil
+}
+
 // GetRateLimitCount returns the current count for a rate limit key without incrementing.
 func (c *RedisCache) GetRateLimitCount(ctx context.Context, key string) (int64, error) {
 	fullKey := rateLimitPrefix + key
@@ -271,3 +333,14 @@ func (c *NullCache) SetCachedSnapshot(ctx context.Context, snapshot *types.Snaps
 func (c *NullCache) IncrementRateLimit(ctx context.Context, key string, window time.Duration) (int64, error) {
 	return 0, nil
 }
+
+// SlidingWindowAllow always allows the request (no rate limiting).
+func (c *NullCache) SlidingWindowAllow(ctx context.Context, key string, limit int64, window time.Duration) (bool, int64, time.Time, error) {
+	return true, limit, time.Now().Add(window), nil
+}
+
+// SetWithNX always reports success, since there's no shared state to lock
+// against; every caller "wins" the lock.
+func (c *NullCache) SetWithNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	return true, nil
+}
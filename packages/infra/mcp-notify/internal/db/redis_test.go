@@ -237,6 +237,43 @@ func TestRedisCache_RateLimit(t *testing.T) {
 	assert.Equal(t, int64(1), count)
 }
 
+func TestRedisCache_SlidingWindowAllow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	key := "user:456:api"
+	window := time.Second
+	limit := int64(3)
+
+	for i := int64(1); i <= limit; i++ {
+		allowed, remaining, resetAt, err := cache.SlidingWindowAllow(ctx, key, limit, window)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, limit-i, remaining)
+		assert.WithinDuration(t, time.Now().Add(window), resetAt, 500*time.Millisecond)
+	}
+
+	// Over the limit, still inside the window.
+	allowed, remaining, _, err := cache.SlidingWindowAllow(ctx, key, limit, window)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, int64(0), remaining)
+
+	// Wait for the window to fully roll off, request should be allowed again.
+	time.Sleep(1100 * time.Millisecond)
+
+	allowed, remaining, _, err = cache.SlidingWindowAllow(ctx, key, limit, window)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, limit-1, remaining)
+}
+
 func TestRedisCache_RateLimitConcurrent(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
@@ -439,4 +476,11 @@ func TestNullCache(t *testing.T) {
 	count, err := cache.IncrementRateLimit(ctx, "key", time.Minute)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), count)
+
+	// Sliding window always allows, with the full limit remaining.
+	allowed, remaining, resetAt, err := cache.SlidingWindowAllow(ctx, "key", 5, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, int64(5), remaining)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), resetAt, time.Second)
 }
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// CreateWatchlist persists a new watchlist.
+func (db *PostgresDB) CreateWatchlist(ctx context.Context, watchlist *types.Watchlist) error {
+	serverNames, err := json.Marshal(watchlist.ServerNames)
+	if err != nil {
+		return fmt.Errorf("failed to marshal watchlist server names: %w", err)
+	}
+
+	if _, err := db.pool.Exec(ctx,
+		`INSERT INTO watchlists (id, api_key_hash, server_names, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5)`,
+		watchlist.ID, watchlist.APIKeyHash, serverNames, watchlist.CreatedAt, watchlist.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create watchlist: %w", err)
+	}
+	return nil
+}
+
+// GetWatchlistByAPIKeyHash retrieves a watchlist by its API key hash, or nil
+// if no watchlist matches.
+func (db *PostgresDB) GetWatchlistByAPIKeyHash(ctx context.Context, apiKeyHash string) (*types.Watchlist, error) {
+	var w types.Watchlist
+	var serverNames []byte
+
+	err := db.pool.QueryRow(ctx,
+		`SELECT id, api_key_hash, server_names, created_at, updated_at
+		 FROM watchlists WHERE api_key_hash = $1`, apiKeyHash,
+	).Scan(&w.ID, &w.APIKeyHash, &serverNames, &w.CreatedAt, &w.UpdatedAt)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watchlist: %w", err)
+	}
+	if err := json.Unmarshal(serverNames, &w.ServerNames); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal watchlist server names: %w", err)
+	}
+	return &w, nil
+}
+
+// UpdateWatchlist replaces a watchlist's watched server names.
+func (db *PostgresDB) UpdateWatchlist(ctx context.Context, watchlist *types.Watchlist) error {
+	serverNames, err := json.Marshal(watchlist.ServerNames)
+	if err != nil {
+		return fmt.Errorf("failed to marshal watchlist server names: %w", err)
+	}
+
+	if _, err := db.pool.Exec(ctx,
+		`UPDATE watchlists SET server_names = $1, updated_at = $2 WHERE id = $3`,
+		serverNames, watchlist.UpdatedAt, watchlist.ID,
+	); err != nil {
+		return fmt.Errorf("failed to update watchlist: %w", err)
+	}
+	return nil
+}
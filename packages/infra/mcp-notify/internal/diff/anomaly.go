@@ -0,0 +1,190 @@
+package diff
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/nirholas/mcp-notify/internal/semver"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// majorVersionJumpThreshold is how many major versions a single update may
+// skip before it's flagged as implausible. A legitimate project rarely jumps
+// from, say, v2 to v9 in one registry update; hijacked packages doing a
+// "re-publish everything under a new major" often do.
+const majorVersionJumpThreshold = 5
+
+// spamKeywords are terms that show up in descriptions injected by a hijacked
+// or squatted listing but essentially never in a legitimate MCP server
+// description. Matched case-insensitively as a plain substring; there is no
+// word-boundary check, so a keyword also matches inside a longer word.
+var spamKeywords = []string{
+	"viagra", "cialis", "casino", "porn", "xxx", "crypto giveaway",
+	"free airdrop", "click here", "bit.ly", "make money fast",
+}
+
+// detectAnomalies runs registry-hijack heuristics against a change and
+// reports whether any fired, along with a human-readable reason for each.
+// from is nil for a newly listed server, since there is nothing to compare
+// ownership or version history against. securityScoreThreshold flags a
+// server whose SecurityScore is at or below it (see Engine.securityScoreThreshold).
+func detectAnomalies(change types.Change, from *types.Server, to types.Server, securityScoreThreshold int) (bool, []string) {
+	var reasons []string
+
+	if from != nil {
+		if reason, ok := repositoryOwnerChanged(from.Repository, to.Repository); ok {
+			reasons = append(reasons, reason)
+		}
+		if reason, ok := packageHijacked(from.Packages, to.Packages); ok {
+			reasons = append(reasons, reason)
+		}
+		if reason, ok := versionJumpedImplausibly(change.PreviousVersion, change.NewVersion); ok {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if reason, ok := descriptionLooksLikeSpam(to.Description); ok {
+		reasons = append(reasons, reason)
+	}
+
+	if reason, ok := securityScoreDegraded(from, to, securityScoreThreshold); ok {
+		reasons = append(reasons, reason)
+	}
+
+	return len(reasons) > 0, reasons
+}
+
+// securityScoreDegraded flags a server whose current SecurityScore is at or
+// below the configured threshold, or that gained a vulnerability it didn't
+// previously have, either of which means a subscriber watching for
+// supply-chain risk should hear about it even if nothing else changed.
+func securityScoreDegraded(from *types.Server, to types.Server, threshold int) (string, bool) {
+	if to.SecurityScore == nil {
+		return "", false
+	}
+
+	if *to.SecurityScore <= threshold {
+		return fmt.Sprintf("security score %d is at or below the threshold of %d", *to.SecurityScore, threshold), true
+	}
+
+	if from == nil {
+		return "", false
+	}
+
+	previousVulns := make(map[string]bool, len(from.Vulnerabilities))
+	for _, v := range from.Vulnerabilities {
+		previousVulns[v.ID] = true
+	}
+	for _, v := range to.Vulnerabilities {
+		if !previousVulns[v.ID] {
+			return "new vulnerability " + v.ID + " (" + v.Severity + ") affecting " + v.PackageName, true
+		}
+	}
+
+	return "", false
+}
+
+// repositoryOwnerChanged flags a server whose repository URL now points at a
+// different owner/organization on the same host, the pattern left behind
+// when an account takeover or a lapsed-domain squat swaps in a look-alike
+// fork.
+func repositoryOwnerChanged(from, to *types.Repository) (string, bool) {
+	if from == nil || to == nil || from.URL == to.URL {
+		return "", false
+	}
+
+	fromOwner, fromOK := repositoryOwner(from.URL)
+	toOwner, toOK := repositoryOwner(to.URL)
+	if !fromOK || !toOK || fromOwner == toOwner {
+		return "", false
+	}
+
+	return "repository ownership changed from \"" + fromOwner + "\" to \"" + toOwner + "\"", true
+}
+
+// repositoryOwner extracts the first path segment (the owner/org) from a
+// repository URL such as "https://github.com/owner/repo".
+func repositoryOwner(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) == 0 || parts[0] == "" {
+		return "", false
+	}
+
+	return strings.ToLower(u.Host) + "/" + strings.ToLower(parts[0]), true
+}
+
+// packageHijacked flags a server whose previous packages were entirely
+// replaced by packages under different names on the same registries, rather
+// than a version bump of the packages already listed. A legitimate update
+// adds packages or bumps versions; it doesn't rename every existing one out
+// from under the same server entry.
+func packageHijacked(from, to []types.Package) (string, bool) {
+	if len(from) == 0 || len(to) == 0 {
+		return "", false
+	}
+
+	fromNames := make(map[string]string, len(from)) // registry -> name
+	for _, p := range from {
+		fromNames[p.RegistryType] = p.Name
+	}
+
+	for _, p := range to {
+		prevName, existed := fromNames[p.RegistryType]
+		if existed && prevName != p.Name {
+			return "package name changed from \"" + prevName + "\" to \"" + p.Name + "\" on registry \"" + p.RegistryType + "\"", true
+		}
+	}
+
+	return "", false
+}
+
+// versionJumpedImplausibly flags an update whose major version skipped
+// several releases at once, a shape more consistent with a hijacked package
+// republishing everything under an inflated version than with normal
+// incremental development.
+func versionJumpedImplausibly(previous, next string) (string, bool) {
+	bump, ok := semver.Classify(previous, next)
+	if !ok || bump != "major" {
+		return "", false
+	}
+
+	p, _ := semver.Parse(previous)
+	n, _ := semver.Parse(next)
+	if n.Major-p.Major < majorVersionJumpThreshold {
+		return "", false
+	}
+
+	return "version jumped from " + previous + " to " + next, true
+}
+
+// spamWordPattern is rebuilt from spamKeywords so multi-word phrases
+// ("crypto giveaway") still match as a whole phrase rather than requiring
+// word-boundary matching per token.
+var spamWordPattern = regexp.MustCompile(`(?i)` + strings.Join(quoteAll(spamKeywords), "|"))
+
+// quoteAll escapes each keyword for use in a regexp alternation.
+func quoteAll(words []string) []string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return quoted
+}
+
+// descriptionLooksLikeSpam flags a description containing wording that
+// legitimate MCP server listings essentially never use, the kind commonly
+// injected when a listing is hijacked to advertise something unrelated.
+func descriptionLooksLikeSpam(description string) (string, bool) {
+	match := spamWordPattern.FindString(description)
+	if match == "" {
+		return "", false
+	}
+	return "description contains suspicious keyword \"" + strings.ToLower(match) + "\"", true
+}
@@ -5,21 +5,55 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/nirholas/mcp-notify/internal/semver"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
+// changeIDNamespace is the UUID namespace used to derive deterministic
+// change IDs. It has no meaning beyond seeding uuid.NewSHA1 and must never
+// change, or every change's ID would change with it.
+var changeIDNamespace = uuid.MustParse("6f1b2a5e-6e0e-4b3e-9a3f-2b7b6b2e6b1a")
+
+// defaultSecurityScoreThreshold is the security score at or below which an
+// update is flagged suspicious when no explicit threshold is configured via
+// WithSecurityScoreThreshold.
+const defaultSecurityScoreThreshold = 70
+
 // Engine detects changes between registry snapshots.
-type Engine struct{}
+type Engine struct {
+	// registryURL scopes derived change IDs to the registry being polled,
+	// so the same server/version transition observed from two different
+	// registries (e.g. federation peers) never collides.
+	registryURL string
+
+	// securityScoreThreshold flags an update as suspicious when the
+	// server's post-update SecurityScore is at or below this value.
+	securityScoreThreshold int
+}
 
-// NewEngine creates a new diff engine.
-func NewEngine() *Engine {
-	return &Engine{}
+// Option configures optional Engine behavior.
+type Option func(*Engine)
+
+// WithSecurityScoreThreshold overrides the security score at or below which
+// an updated server is flagged suspicious (see detectAnomalies).
+func WithSecurityScoreThreshold(threshold int) Option {
+	return func(e *Engine) { e.securityScoreThreshold = threshold }
+}
+
+// NewEngine creates a new diff engine for the given registry URL.
+func NewEngine(registryURL string, opts ...Option) *Engine {
+	e := &Engine{registryURL: registryURL, securityScoreThreshold: defaultSecurityScoreThreshold}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
 }
 
 // CreateSnapshot creates a new snapshot from a list of servers.
@@ -77,34 +111,40 @@ func (e *Engine) Compare(from, to *types.Snapshot) *types.DiffResult {
 
 		if !exists {
 			// New server
+			newVersion := getVersion(toServer)
 			change := types.Change{
-				ID:         uuid.New(),
+				ID:         e.deriveChangeID(from, to, name, types.ChangeTypeNew, "", newVersion),
 				SnapshotID: to.ID,
 				ServerName: name,
 				ChangeType: types.ChangeTypeNew,
-				NewVersion: getVersion(toServer),
+				NewVersion: newVersion,
 				Server:     &toServer,
 				DetectedAt: time.Now().UTC(),
 			}
+			change.Severity = classifySeverity(change)
+			change.Suspicious, change.SuspiciousReasons = detectAnomalies(change, nil, toServer, e.securityScoreThreshold)
 			result.NewServers = append(result.NewServers, change)
 		} else if !e.serversEqual(fromServer, toServer) {
 			// Updated server
 			fieldChanges := e.detectFieldChanges(fromServer, toServer)
 			serverCopy := toServer
 			fromCopy := fromServer
+			previousVersion, newVersion := getVersion(fromServer), getVersion(toServer)
 
 			change := types.Change{
-				ID:              uuid.New(),
+				ID:              e.deriveChangeID(from, to, name, types.ChangeTypeUpdated, previousVersion, newVersion),
 				SnapshotID:      to.ID,
 				ServerName:      name,
 				ChangeType:      types.ChangeTypeUpdated,
-				PreviousVersion: getVersion(fromServer),
-				NewVersion:      getVersion(toServer),
+				PreviousVersion: previousVersion,
+				NewVersion:      newVersion,
 				FieldChanges:    fieldChanges,
 				Server:          &serverCopy,
 				PreviousServer:  &fromCopy,
 				DetectedAt:      time.Now().UTC(),
 			}
+			change.Severity = classifySeverity(change)
+			change.Suspicious, change.SuspiciousReasons = detectAnomalies(change, &fromCopy, toServer, e.securityScoreThreshold)
 			result.UpdatedServers = append(result.UpdatedServers, change)
 		}
 	}
@@ -113,15 +153,17 @@ func (e *Engine) Compare(from, to *types.Snapshot) *types.DiffResult {
 	for name, fromServer := range fromServers {
 		if _, exists := toServers[name]; !exists {
 			serverCopy := fromServer
+			previousVersion := getVersion(fromServer)
 			change := types.Change{
-				ID:              uuid.New(),
+				ID:              e.deriveChangeID(from, to, name, types.ChangeTypeRemoved, previousVersion, ""),
 				SnapshotID:      to.ID,
 				ServerName:      name,
 				ChangeType:      types.ChangeTypeRemoved,
-				PreviousVersion: getVersion(fromServer),
+				PreviousVersion: previousVersion,
 				PreviousServer:  &serverCopy,
 				DetectedAt:      time.Now().UTC(),
 			}
+			change.Severity = classifySeverity(change)
 			result.RemovedServers = append(result.RemovedServers, change)
 		}
 	}
@@ -136,6 +178,24 @@ func (e *Engine) Compare(from, to *types.Snapshot) *types.DiffResult {
 	return result
 }
 
+// deriveChangeID computes a UUIDv5 change ID from the registry, server,
+// version transition, and snapshot pair that produced it, so replaying the
+// same poll (or a federation peer observing the same transition) derives
+// the identical ID instead of a random one. from may be nil for the first
+// poll against an empty history.
+func (e *Engine) deriveChangeID(from, to *types.Snapshot, serverName string, changeType types.ChangeType, previousVersion, newVersion string) uuid.UUID {
+	var fromHash string
+	if from != nil {
+		fromHash = from.Hash
+	}
+
+	data := strings.Join([]string{
+		e.registryURL, serverName, string(changeType), previousVersion, newVersion, fromHash, to.Hash,
+	}, "|")
+
+	return uuid.NewSHA1(changeIDNamespace, []byte(data))
+}
+
 // HasChanges checks if two snapshots have any differences using hash comparison.
 // This is a quick check before doing a full comparison.
 func (e *Engine) HasChanges(from, to *types.Snapshot) bool {
@@ -173,9 +233,23 @@ func (e *Engine) serversEqual(a, b types.Server) bool {
 		return false
 	}
 
+	// Compare security score, so a vulnerability appearing or a score drop
+	// is surfaced as an update even when nothing else about the listing
+	// changed.
+	if !securityScoresEqual(a.SecurityScore, b.SecurityScore) {
+		return false
+	}
+
 	return true
 }
 
+func securityScoresEqual(a, b *int) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	return a == nil || *a == *b
+}
+
 // detectFieldChanges detects which specific fields changed between two servers.
 func (e *Engine) detectFieldChanges(from, to types.Server) []types.FieldChange {
 	var changes []types.FieldChange
@@ -222,6 +296,14 @@ func (e *Engine) detectFieldChanges(from, to types.Server) []types.FieldChange {
 		})
 	}
 
+	if !securityScoresEqual(from.SecurityScore, to.SecurityScore) {
+		changes = append(changes, types.FieldChange{
+			Field:    "security_score",
+			OldValue: from.SecurityScore,
+			NewValue: to.SecurityScore,
+		})
+	}
+
 	return changes
 }
 
@@ -355,6 +437,33 @@ func filterChangeList(changes []types.Change, filter types.SubscriptionFilter) [
 
 // MatchesFilter checks if a change matches a subscription filter.
 func MatchesFilter(change types.Change, filter types.SubscriptionFilter) bool {
+	// Exclusions are checked first and always win, so a server muted via
+	// ExcludeServers/ExcludeNamespaces/ExcludeKeywords never matches even if
+	// it satisfies every inclusion filter below.
+	for _, s := range filter.ExcludeServers {
+		if s == change.ServerName {
+			return false
+		}
+	}
+
+	for _, pattern := range filter.ExcludeNamespaces {
+		if matchNamespace(change.ServerName, pattern) {
+			return false
+		}
+	}
+
+	if len(filter.ExcludeKeywords) > 0 {
+		searchText := strings.ToLower(change.ServerName)
+		if change.Server != nil {
+			searchText += " " + strings.ToLower(change.Server.Description)
+		}
+		for _, kw := range filter.ExcludeKeywords {
+			if strings.Contains(searchText, strings.ToLower(kw)) {
+				return false
+			}
+		}
+	}
+
 	// Check change types filter
 	if len(filter.ChangeTypes) > 0 {
 		found := false
@@ -369,6 +478,34 @@ func MatchesFilter(change types.Change, filter types.SubscriptionFilter) bool {
 		}
 	}
 
+	// Check severities filter
+	if len(filter.Severities) > 0 {
+		found := false
+		for _, s := range filter.Severities {
+			if s == change.Severity {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// Check minimum version bump
+	if filter.MinVersionBump != "" {
+		if bump, ok := semver.Classify(change.PreviousVersion, change.NewVersion); ok && !semver.AtLeast(bump, filter.MinVersionBump) {
+			return false
+		}
+	}
+
+	// Check pre-release exclusion
+	if filter.IgnorePreReleases {
+		if v, ok := semver.Parse(relevantVersion(change)); ok && v.PreRelease != "" {
+			return false
+		}
+	}
+
 	// Check specific servers filter
 	if len(filter.Servers) > 0 {
 		found := false
@@ -397,6 +534,13 @@ func MatchesFilter(change types.Change, filter types.SubscriptionFilter) bool {
 		}
 	}
 
+	// Check namespace regex
+	if filter.NamespaceRegex != "" {
+		if re, err := regexp.Compile(filter.NamespaceRegex); err == nil && !re.MatchString(change.ServerName) {
+			return false
+		}
+	}
+
 	// Check keywords
 	if len(filter.Keywords) > 0 {
 		matched := false
@@ -415,6 +559,17 @@ func MatchesFilter(change types.Change, filter types.SubscriptionFilter) bool {
 		}
 	}
 
+	// Check keyword regex
+	if filter.KeywordRegex != "" {
+		searchText := change.ServerName
+		if change.Server != nil {
+			searchText += " " + change.Server.Description
+		}
+		if re, err := regexp.Compile(filter.KeywordRegex); err == nil && !re.MatchString(searchText) {
+			return false
+		}
+	}
+
 	// Check package types
 	if len(filter.PackageTypes) > 0 && change.Server != nil {
 		matched := false
@@ -437,6 +592,16 @@ func MatchesFilter(change types.Change, filter types.SubscriptionFilter) bool {
 	return true
 }
 
+// relevantVersion returns the version string that best represents a change
+// for pre-release inspection: the new version for new/updated servers, or
+// the last known version for a removed one.
+func relevantVersion(change types.Change) string {
+	if change.ChangeType == types.ChangeTypeRemoved {
+		return change.PreviousVersion
+	}
+	return change.NewVersion
+}
+
 // matchNamespace checks if a server name matches a namespace pattern.
 // Patterns support * as a wildcard.
 func matchNamespace(name, pattern string) bool {
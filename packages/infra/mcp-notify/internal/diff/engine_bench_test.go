@@ -9,7 +9,7 @@ import (
 
 // BenchmarkDiffSmall benchmarks diff engine with 100 servers.
 func BenchmarkDiffSmall(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	servers := fixtures.GenerateLargeServerList(100)
 	modifiedServers := fixtures.GenerateModifiedServerList(servers, 10, 5, 5)
 
@@ -24,7 +24,7 @@ func BenchmarkDiffSmall(b *testing.B) {
 
 // BenchmarkDiffMedium benchmarks diff engine with 1000 servers.
 func BenchmarkDiffMedium(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	servers := fixtures.GenerateLargeServerList(1000)
 	modifiedServers := fixtures.GenerateModifiedServerList(servers, 50, 30, 20)
 
@@ -39,7 +39,7 @@ func BenchmarkDiffMedium(b *testing.B) {
 
 // BenchmarkDiffLarge benchmarks diff engine with 10000 servers.
 func BenchmarkDiffLarge(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	servers := fixtures.GenerateLargeServerList(10000)
 	modifiedServers := fixtures.GenerateModifiedServerList(servers, 500, 200, 300)
 
@@ -54,7 +54,7 @@ func BenchmarkDiffLarge(b *testing.B) {
 
 // BenchmarkSnapshotCreation benchmarks snapshot creation.
 func BenchmarkSnapshotCreation(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	sizes := []struct {
 		name string
 		size int
@@ -77,7 +77,7 @@ func BenchmarkSnapshotCreation(b *testing.B) {
 
 // BenchmarkHashComputation benchmarks hash computation for quick comparison.
 func BenchmarkHashComputation(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	sizes := []int{100, 1000, 10000}
 
 	for _, size := range sizes {
@@ -95,7 +95,7 @@ func BenchmarkHashComputation(b *testing.B) {
 
 // BenchmarkHasChanges benchmarks the quick change detection.
 func BenchmarkHasChanges(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	sizes := []int{100, 1000, 10000}
 
@@ -126,7 +126,7 @@ func BenchmarkHasChanges(b *testing.B) {
 
 // BenchmarkDiffNoChanges benchmarks diff when there are no changes.
 func BenchmarkDiffNoChanges(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	sizes := []int{100, 1000, 10000}
 
 	for _, size := range sizes {
@@ -145,7 +145,7 @@ func BenchmarkDiffNoChanges(b *testing.B) {
 
 // BenchmarkDiffAllNew benchmarks diff when all servers are new.
 func BenchmarkDiffAllNew(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	sizes := []int{100, 1000, 10000}
 
 	for _, size := range sizes {
@@ -164,7 +164,7 @@ func BenchmarkDiffAllNew(b *testing.B) {
 
 // BenchmarkDiffAllRemoved benchmarks diff when all servers are removed.
 func BenchmarkDiffAllRemoved(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	sizes := []int{100, 1000, 10000}
 
 	for _, size := range sizes {
@@ -183,7 +183,7 @@ func BenchmarkDiffAllRemoved(b *testing.B) {
 
 // BenchmarkDiffMixedChanges benchmarks diff with mixed change types.
 func BenchmarkDiffMixedChanges(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	testCases := []struct {
 		name    string
@@ -218,7 +218,7 @@ func BenchmarkDiffMixedChanges(b *testing.B) {
 
 // BenchmarkDiffMemoryAllocation benchmarks memory allocations during diff.
 func BenchmarkDiffMemoryAllocation(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	servers := fixtures.GenerateLargeServerList(1000)
 	modifiedServers := fixtures.GenerateModifiedServerList(servers, 50, 50, 50)
 
@@ -234,7 +234,7 @@ func BenchmarkDiffMemoryAllocation(b *testing.B) {
 
 // BenchmarkSnapshotMemory benchmarks memory usage of snapshots.
 func BenchmarkSnapshotMemory(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	servers := fixtures.GenerateLargeServerList(10000)
 
 	b.ReportAllocs()
@@ -246,7 +246,7 @@ func BenchmarkSnapshotMemory(b *testing.B) {
 
 // BenchmarkDiffParallel benchmarks parallel diff operations.
 func BenchmarkDiffParallel(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	servers := fixtures.GenerateLargeServerList(1000)
 	modifiedServers := fixtures.GenerateModifiedServerList(servers, 50, 50, 50)
 
@@ -262,7 +262,7 @@ func BenchmarkDiffParallel(b *testing.B) {
 
 // BenchmarkServerLookup benchmarks server lookup in snapshot.
 func BenchmarkServerLookup(b *testing.B) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 	sizes := []int{100, 1000, 10000}
 
 	for _, size := range sizes {
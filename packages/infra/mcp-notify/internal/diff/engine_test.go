@@ -13,7 +13,7 @@ import (
 )
 
 func TestEngine_CreateSnapshot(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	servers := []types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -36,7 +36,7 @@ func TestEngine_CreateSnapshot(t *testing.T) {
 }
 
 func TestEngine_CreateSnapshot_Empty(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	snapshot := engine.CreateSnapshot([]types.Server{})
 
@@ -47,7 +47,7 @@ func TestEngine_CreateSnapshot_Empty(t *testing.T) {
 }
 
 func TestEngine_Compare_BothNil(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	result := engine.Compare(nil, nil)
 
@@ -59,7 +59,7 @@ func TestEngine_Compare_BothNil(t *testing.T) {
 }
 
 func TestEngine_Compare_FromNil(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	to := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -75,7 +75,7 @@ func TestEngine_Compare_FromNil(t *testing.T) {
 }
 
 func TestEngine_Compare_ToNil(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -91,7 +91,7 @@ func TestEngine_Compare_ToNil(t *testing.T) {
 }
 
 func TestEngine_Compare_NewServers(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -122,7 +122,7 @@ func TestEngine_Compare_NewServers(t *testing.T) {
 }
 
 func TestEngine_Compare_RemovedServers(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -153,7 +153,7 @@ func TestEngine_Compare_RemovedServers(t *testing.T) {
 }
 
 func TestEngine_Compare_UpdatedServers(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Old description"},
@@ -185,7 +185,7 @@ func TestEngine_Compare_UpdatedServers(t *testing.T) {
 }
 
 func TestEngine_Compare_VersionChange(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{
@@ -228,7 +228,7 @@ func TestEngine_Compare_VersionChange(t *testing.T) {
 }
 
 func TestEngine_Compare_PackageChanges(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{
@@ -263,7 +263,7 @@ func TestEngine_Compare_PackageChanges(t *testing.T) {
 }
 
 func TestEngine_Compare_RemoteChanges(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{
@@ -298,7 +298,7 @@ func TestEngine_Compare_RemoteChanges(t *testing.T) {
 }
 
 func TestEngine_Compare_RepositoryChange(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{
@@ -335,7 +335,7 @@ func TestEngine_Compare_RepositoryChange(t *testing.T) {
 }
 
 func TestEngine_Compare_NoChanges(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	servers := []types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -354,7 +354,7 @@ func TestEngine_Compare_NoChanges(t *testing.T) {
 }
 
 func TestEngine_Compare_MixedChanges(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	from := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Original"},
@@ -381,7 +381,7 @@ func TestEngine_Compare_MixedChanges(t *testing.T) {
 }
 
 func TestEngine_HasChanges(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	tests := []struct {
 		name     string
@@ -430,7 +430,7 @@ func TestEngine_HasChanges(t *testing.T) {
 }
 
 func TestEngine_HashConsistency(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	servers := []types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -453,7 +453,7 @@ func TestEngine_HashConsistency(t *testing.T) {
 }
 
 func TestEngine_HashDifferent(t *testing.T) {
-	engine := NewEngine()
+	engine := NewEngine("https://registry.example.com")
 
 	snapshot1 := engine.CreateSnapshot([]types.Server{
 		{Name: "server1", Description: "Server 1"},
@@ -0,0 +1,41 @@
+package diff
+
+import (
+	"github.com/nirholas/mcp-notify/internal/semver"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// classifySeverity assigns a Severity to a change based on its type and, for
+// updates, which fields moved. Removal and a major version bump are treated
+// as major since both can break consumers depending on the server; a
+// description-only tweak is informational; everything else defaults to
+// minor.
+func classifySeverity(change types.Change) types.Severity {
+	switch change.ChangeType {
+	case types.ChangeTypeRemoved:
+		return types.SeverityMajor
+	case types.ChangeTypeNew:
+		return types.SeverityMinor
+	case types.ChangeTypeUpdated:
+		return classifyUpdateSeverity(change)
+	default:
+		return types.SeverityMinor
+	}
+}
+
+// classifyUpdateSeverity inspects an updated change's field changes to decide
+// how impactful the update is. A major version bump outranks any other
+// concurrent field change; a change touching only the description is
+// informational; anything else (packages, remotes, repository, a non-major
+// version bump) is minor.
+func classifyUpdateSeverity(change types.Change) types.Severity {
+	if bump, ok := semver.Classify(change.PreviousVersion, change.NewVersion); ok && bump == "major" {
+		return types.SeverityMajor
+	}
+
+	if len(change.FieldChanges) == 1 && change.FieldChanges[0].Field == "description" {
+		return types.SeverityInfo
+	}
+
+	return types.SeverityMinor
+}
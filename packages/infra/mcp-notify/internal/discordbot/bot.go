@@ -0,0 +1,215 @@
+// Package discordbot implements Discord's HTTP interactions endpoint, so a
+// channel can create and manage subscriptions with "/mcp" slash commands
+// instead of the admin API.
+package discordbot
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Discord interaction types and response types this bot handles.
+// See https://discord.com/developers/docs/interactions/receiving-and-responding.
+const (
+	interactionTypePing               = 1
+	interactionTypeApplicationCommand = 2
+
+	responseTypePong           = 1
+	responseTypeChannelMessage = 4
+
+	// flagEphemeral marks a response visible only to the invoking user.
+	flagEphemeral = 1 << 6
+
+	// recentChangesWindow bounds how far back "/mcp recent" looks.
+	recentChangesWindow = 7 * 24 * time.Hour
+	recentChangesLimit  = 5
+)
+
+// Config holds Discord bot configuration.
+type Config struct {
+	// PublicKey is the application's hex-encoded Ed25519 public key, used to
+	// verify that an inbound interaction really came from Discord.
+	PublicKey string
+}
+
+// Bot handles Discord interactions for the "/mcp" slash command family.
+type Bot struct {
+	publicKey       ed25519.PublicKey
+	db              db.Database
+	subscriptionMgr *subscription.Manager
+}
+
+// NewBot creates a new Bot. Returns an error if PublicKey is not a valid
+// hex-encoded Ed25519 public key.
+func NewBot(cfg Config, database db.Database, subscriptionMgr *subscription.Manager) (*Bot, error) {
+	keyBytes, err := hex.DecodeString(cfg.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Discord public key: %w", err)
+	}
+	if len(keyBytes) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid Discord public key: expected %d bytes, got %d", ed25519.PublicKeySize, len(keyBytes))
+	}
+
+	return &Bot{
+		publicKey:       ed25519.PublicKey(keyBytes),
+		db:              database,
+		subscriptionMgr: subscriptionMgr,
+	}, nil
+}
+
+// VerifySignature checks that an inbound interaction request really came
+// from Discord: the signature is Ed25519(timestamp+body), hex-encoded, sent
+// in the X-Signature-Ed25519 header, with the timestamp in
+// X-Signature-Timestamp.
+func (b *Bot) VerifySignature(timestamp, signatureHex string, body []byte) bool {
+	if timestamp == "" || signatureHex == "" {
+		return false
+	}
+
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+
+	message := append([]byte(timestamp), body...)
+	return ed25519.Verify(b.publicKey, message, signature)
+}
+
+// interactionPayload is the subset of Discord's interaction object this bot
+// acts on: a top-level "/mcp" command with one subcommand.
+type interactionPayload struct {
+	Type      int    `json:"type"`
+	ChannelID string `json:"channel_id"`
+	Data      struct {
+		Name    string              `json:"name"`
+		Options []interactionOption `json:"options"`
+	} `json:"data"`
+}
+
+type interactionOption struct {
+	Name    string              `json:"name"`
+	Value   string              `json:"value,omitempty"`
+	Options []interactionOption `json:"options,omitempty"`
+}
+
+type interactionResponse struct {
+	Type int           `json:"type"`
+	Data *responseData `json:"data,omitempty"`
+}
+
+type responseData struct {
+	Content string `json:"content"`
+	Flags   int    `json:"flags,omitempty"`
+}
+
+// HandleInteraction dispatches an inbound interaction and returns the
+// response body to send back to Discord. Discord requires this within
+// roughly 3 seconds of receiving the request.
+func (b *Bot) HandleInteraction(ctx context.Context, body []byte) (any, error) {
+	var interaction interactionPayload
+	if err := json.Unmarshal(body, &interaction); err != nil {
+		return nil, fmt.Errorf("invalid interaction payload: %w", err)
+	}
+
+	switch interaction.Type {
+	case interactionTypePing:
+		return interactionResponse{Type: responseTypePong}, nil
+	case interactionTypeApplicationCommand:
+		return b.handleCommand(ctx, interaction)
+	default:
+		return ephemeral(fmt.Sprintf("Unsupported interaction type: %d", interaction.Type)), nil
+	}
+}
+
+func (b *Bot) handleCommand(ctx context.Context, interaction interactionPayload) (any, error) {
+	if interaction.Data.Name != "mcp" || len(interaction.Data.Options) == 0 {
+		return ephemeral("Unknown command."), nil
+	}
+
+	sub := interaction.Data.Options[0]
+	switch sub.Name {
+	case "watch":
+		var namespace string
+		if len(sub.Options) > 0 {
+			namespace = sub.Options[0].Value
+		}
+		if namespace == "" {
+			return ephemeral("Usage: /mcp watch <namespace>"), nil
+		}
+		return b.handleWatch(ctx, interaction.ChannelID, namespace)
+	case "unwatch":
+		return b.handleUnwatch(ctx, interaction.ChannelID)
+	case "recent":
+		return b.handleRecent(ctx)
+	default:
+		return ephemeral(fmt.Sprintf("Unknown /mcp subcommand: %s", sub.Name)), nil
+	}
+}
+
+func (b *Bot) handleWatch(ctx context.Context, channelID, namespace string) (any, error) {
+	req := types.CreateSubscriptionRequest{
+		Name:    fmt.Sprintf("Discord #%s: %s", channelID, namespace),
+		Filters: types.SubscriptionFilter{Namespaces: []string{namespace}},
+		Channels: []types.ChannelRequest{{
+			Type:   types.ChannelDiscord,
+			Config: types.ChannelConfig{DiscordChannelID: channelID},
+		}},
+	}
+
+	if _, _, err := b.subscriptionMgr.Create(ctx, req, nil, nil); err != nil {
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	return ephemeral(fmt.Sprintf("Now watching `%s` in this channel.", namespace)), nil
+}
+
+func (b *Bot) handleUnwatch(ctx context.Context, channelID string) (any, error) {
+	subs, err := b.subscriptionMgr.FindByDiscordChannel(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return ephemeral("This channel isn't watching anything."), nil
+	}
+
+	for _, s := range subs {
+		if err := b.subscriptionMgr.Delete(ctx, s.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete subscription %s: %w", s.ID, err)
+		}
+	}
+
+	return ephemeral(fmt.Sprintf("Stopped watching (%d subscription(s) removed).", len(subs))), nil
+}
+
+func (b *Bot) handleRecent(ctx context.Context) (any, error) {
+	changes, err := b.db.GetChangesSince(ctx, time.Now().Add(-recentChangesWindow), recentChangesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recent changes: %w", err)
+	}
+	if len(changes) == 0 {
+		return ephemeral("No recent changes in the last 7 days."), nil
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		lines = append(lines, fmt.Sprintf("• **%s** %s", c.ServerName, c.ChangeType))
+	}
+
+	return ephemeral(strings.Join(lines, "\n")), nil
+}
+
+func ephemeral(content string) interactionResponse {
+	return interactionResponse{
+		Type: responseTypeChannelMessage,
+		Data: &responseData{Content: content, Flags: flagEphemeral},
+	}
+}
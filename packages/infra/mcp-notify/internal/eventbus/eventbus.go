@@ -0,0 +1,175 @@
+// Package eventbus publishes every detected registry change to an external
+// event bus (Kafka or NATS JetStream), so downstream data pipelines can
+// consume changes without polling the HTTP API. Delivery uses an
+// at-least-once outbox: each change is recorded in the event_outbox table
+// in the same poll cycle that saves the Change, and only removed once the
+// configured Publisher confirms the send, so a crash or broker outage
+// between the two just leaves it for the next flush instead of losing the
+// event.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/config"
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/eventbus/kafka"
+	"github.com/nirholas/mcp-notify/internal/eventbus/nats"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+const (
+	defaultFlushInterval = 5 * time.Second
+	defaultBatchSize     = 100
+	maxRetryBackoff      = 5 * time.Minute
+)
+
+// Publisher sends a single event payload to topic on the underlying event
+// bus. Implementations must be safe for concurrent use.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key string, payload []byte) error
+	Close() error
+}
+
+// NewPublisher builds the Publisher selected by cfg.Backend.
+func NewPublisher(ctx context.Context, cfg config.EventBusConfig) (Publisher, error) {
+	switch cfg.Backend {
+	case "kafka":
+		return kafka.NewPublisher(kafka.Config{Brokers: cfg.Kafka.Brokers})
+	case "nats":
+		return nats.NewPublisher(ctx, nats.Config{
+			URL:     cfg.NATS.URL,
+			Stream:  cfg.NATS.Stream,
+			Subject: cfg.Topic,
+		})
+	default:
+		return nil, fmt.Errorf("eventbus: unknown backend %q (want \"kafka\" or \"nats\")", cfg.Backend)
+	}
+}
+
+// event is the wire payload published to the event bus.
+type event struct {
+	EventType       string        `json:"event_type"`
+	EventID         string        `json:"event_id"`
+	ServerName      string        `json:"server_name"`
+	Timestamp       time.Time     `json:"timestamp"`
+	PreviousVersion string        `json:"previous_version,omitempty"`
+	NewVersion      string        `json:"new_version,omitempty"`
+	Server          *types.Server `json:"server,omitempty"`
+}
+
+// Bus queues detected changes for delivery and flushes the queue to a
+// Publisher.
+type Bus struct {
+	db        db.Database
+	publisher Publisher
+	topic     string
+}
+
+// NewBus creates a Bus that queues changes for delivery to topic via
+// publisher.
+func NewBus(database db.Database, publisher Publisher, topic string) *Bus {
+	return &Bus{db: database, publisher: publisher, topic: topic}
+}
+
+// Enqueue records change in the outbox for delivery. It's called from the
+// same poll cycle that saves change, and never fails the poll: an error
+// here just means the event ships one flush cycle late, once retried by the
+// caller's own SaveChange error handling.
+func (b *Bus) Enqueue(ctx context.Context, change *types.Change) error {
+	payload, err := json.Marshal(buildEvent(change))
+	if err != nil {
+		return fmt.Errorf("eventbus: failed to marshal event payload: %w", err)
+	}
+
+	return b.db.SaveOutboxEvent(ctx, &types.OutboxEvent{
+		ID:          uuid.New(),
+		ChangeID:    change.ID,
+		Topic:       b.topic,
+		Payload:     payload,
+		NextAttempt: time.Now(),
+	})
+}
+
+func buildEvent(change *types.Change) event {
+	return event{
+		EventType:       string(change.ChangeType),
+		EventID:         change.ID.String(),
+		ServerName:      change.ServerName,
+		Timestamp:       change.DetectedAt,
+		PreviousVersion: change.PreviousVersion,
+		NewVersion:      change.NewVersion,
+		Server:          change.Server,
+	}
+}
+
+// ProcessOutbox publishes a batch of due events, deleting each on success.
+// A failed publish reschedules that event with a backoff proportional to
+// its attempt count instead of blocking the rest of the batch.
+func (b *Bus) ProcessOutbox(ctx context.Context) error {
+	events, err := b.db.GetPendingOutboxEvents(ctx, defaultBatchSize)
+	if err != nil {
+		return fmt.Errorf("eventbus: failed to load pending outbox events: %w", err)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	log.Debug().Int("count", len(events)).Msg("Flushing event outbox")
+
+	for i := range events {
+		evt := events[i]
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := b.publisher.Publish(ctx, evt.Topic, evt.ChangeID.String(), evt.Payload); err != nil {
+			backoff := time.Duration(evt.Attempts+1) * time.Second
+			if backoff > maxRetryBackoff {
+				backoff = maxRetryBackoff
+			}
+			if markErr := b.db.MarkOutboxEventFailed(ctx, evt.ID, time.Now().Add(backoff), err.Error()); markErr != nil {
+				log.Error().Err(markErr).Str("event_id", evt.ID.String()).Msg("Failed to record outbox publish failure")
+			}
+			log.Warn().Err(err).Str("event_id", evt.ID.String()).Msg("Failed to publish event, will retry")
+			continue
+		}
+
+		if err := b.db.DeleteOutboxEvent(ctx, evt.ID); err != nil {
+			log.Error().Err(err).Str("event_id", evt.ID.String()).Msg("Failed to delete published outbox event")
+		}
+	}
+
+	return nil
+}
+
+// Run drains the outbox on a ticker until ctx is canceled.
+func (b *Bus) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = defaultFlushInterval
+	}
+	log.Info().Dur("interval", interval).Msg("Starting event bus outbox flusher")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := b.ProcessOutbox(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to process event outbox")
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Package kafka publishes event bus messages to a Kafka topic.
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	segmentio "github.com/segmentio/kafka-go"
+)
+
+// Config holds Kafka publisher configuration.
+type Config struct {
+	Brokers []string
+}
+
+// Publisher publishes messages to Kafka using a single shared writer, with
+// the topic set per-message so one Publisher can serve every event bus
+// topic the caller uses.
+type Publisher struct {
+	writer *segmentio.Writer
+}
+
+// NewPublisher creates a Kafka publisher connected to cfg.Brokers.
+func NewPublisher(cfg Config) (*Publisher, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka: at least one broker is required")
+	}
+
+	return &Publisher{
+		writer: &segmentio.Writer{
+			Addr:                   segmentio.TCP(cfg.Brokers...),
+			Balancer:               &segmentio.Hash{},
+			RequiredAcks:           segmentio.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}, nil
+}
+
+// Publish sends payload to topic, keyed by key for consistent partitioning.
+// RequiredAcks: RequireAll means Publish only returns once every in-sync
+// replica has the message, matching the outbox's at-least-once contract.
+func (p *Publisher) Publish(ctx context.Context, topic string, key string, payload []byte) error {
+	err := p.writer.WriteMessages(ctx, segmentio.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("kafka: failed to publish to topic %q: %w", topic, err)
+	}
+	return nil
+}
+
+// Close releases the underlying writer's connections.
+func (p *Publisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,78 @@
+// Package nats publishes event bus messages to a NATS JetStream subject.
+package nats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Config holds NATS publisher configuration.
+type Config struct {
+	URL string
+	// Stream is created (if it doesn't already exist) to persist Subject,
+	// so JetStream acknowledges Publish only after the message is durably
+	// stored.
+	Stream  string
+	Subject string
+}
+
+// Publisher publishes messages to a NATS JetStream stream. JetStream's
+// synchronous Publish only acknowledges once the message is durably
+// stored, giving the outbox flusher a reliable success/failure signal.
+type Publisher struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// NewPublisher connects to cfg.URL and ensures cfg.Stream exists.
+func NewPublisher(ctx context.Context, cfg Config) (*Publisher, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("nats: URL is required")
+	}
+	if cfg.Stream == "" {
+		return nil, fmt.Errorf("nats: stream is required")
+	}
+	if cfg.Subject == "" {
+		return nil, fmt.Errorf("nats: subject is required")
+	}
+
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("nats: failed to connect: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("nats: failed to initialize jetstream: %w", err)
+	}
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     cfg.Stream,
+		Subjects: []string{cfg.Subject},
+	}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("nats: failed to create stream %q: %w", cfg.Stream, err)
+	}
+
+	return &Publisher{conn: conn, js: js}, nil
+}
+
+// Publish publishes payload to subject. key is unused: JetStream dedups by
+// the Nats-Msg-Id header, which isn't needed here since the outbox already
+// guarantees each event is published until it succeeds, not more than once
+// per attempt.
+func (p *Publisher) Publish(ctx context.Context, subject string, key string, payload []byte) error {
+	if _, err := p.js.Publish(ctx, subject, payload); err != nil {
+		return fmt.Errorf("nats: failed to publish to subject %q: %w", subject, err)
+	}
+	return nil
+}
+
+// Close drains and closes the underlying connection.
+func (p *Publisher) Close() error {
+	return p.conn.Drain()
+}
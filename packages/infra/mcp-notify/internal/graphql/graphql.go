@@ -0,0 +1,324 @@
+// Package graphql implements a minimal GraphQL query executor for the read
+// APIs (changes, servers, subscriptions) exposed at POST /graphql.
+//
+// It intentionally does not vendor a full GraphQL engine (gqlgen,
+// graphql-go): this environment can't fetch new dependencies, and a
+// hand-rolled implementation of the full spec (fragments, directives,
+// mutations, introspection) isn't worth the surface area for three
+// dashboard-facing read queries. What's implemented is a real parser for a
+// single anonymous query block containing one or more root fields, each
+// with optional parenthesized arguments and a curly-brace selection set:
+//
+//	{ changes(since: "2024-01-01T00:00:00Z", limit: 20) { id serverName } servers(limit: 5) { name } }
+//
+// The selection set is parsed (so malformed queries are rejected) but not
+// projected against: a resolved root field always returns its full object
+// shape rather than only the requested subfields. Callers get a superset of
+// what they asked for, which keeps the executor simple without breaking
+// forward compatibility as fields are added.
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Resolver resolves a single root field given its parsed arguments.
+type Resolver func(ctx context.Context, args map[string]any) (any, error)
+
+// Schema maps root field names to the resolver that answers them.
+type Schema struct {
+	Resolvers map[string]Resolver
+}
+
+// Result is the standard GraphQL response envelope.
+type Result struct {
+	Data   map[string]any `json:"data,omitempty"`
+	Errors []string       `json:"errors,omitempty"`
+}
+
+// Execute parses query and resolves every requested root field against the
+// schema. Unknown root fields produce an entry in Result.Errors rather than
+// failing the whole request, matching GraphQL's partial-response behavior.
+func (s *Schema) Execute(ctx context.Context, query string, variables map[string]any) *Result {
+	fields, err := parse(query, variables)
+	if err != nil {
+		return &Result{Errors: []string{err.Error()}}
+	}
+
+	result := &Result{Data: make(map[string]any, len(fields))}
+	for _, f := range fields {
+		resolver, ok := s.Resolvers[f.name]
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Sprintf("unknown field %q", f.name))
+			continue
+		}
+		data, err := resolver(ctx, f.args)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", f.name, err))
+			continue
+		}
+		result.Data[f.name] = data
+	}
+	return result
+}
+
+type field struct {
+	name string
+	args map[string]any
+}
+
+// parse extracts the root fields, their arguments, and their (discarded)
+// selection sets from a single anonymous query operation. Variable
+// references ($name) are resolved against variables.
+func parse(query string, variables map[string]any) ([]field, error) {
+	p := &parser{input: query, variables: variables}
+	p.skipKeyword("query")
+	p.skipWhitespace()
+	p.skipName() // optional operation name
+
+	p.skipWhitespace()
+	if !p.consume('{') {
+		return nil, fmt.Errorf("expected '{' to start selection set")
+	}
+
+	var fields []field
+	for {
+		p.skipWhitespace()
+		if p.consume('}') {
+			break
+		}
+		if p.eof() {
+			return nil, fmt.Errorf("unexpected end of query, unclosed selection set")
+		}
+
+		name := p.readName()
+		if name == "" {
+			return nil, fmt.Errorf("expected field name at position %d", p.pos)
+		}
+
+		args, err := p.readArgs()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipWhitespace()
+		if p.peek() == '{' {
+			if err := p.skipSelectionSet(); err != nil {
+				return nil, err
+			}
+		}
+
+		fields = append(fields, field{name: name, args: args})
+	}
+
+	return fields, nil
+}
+
+type parser struct {
+	input     string
+	pos       int
+	variables map[string]any
+}
+
+func (p *parser) eof() bool { return p.pos >= len(p.input) }
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) consume(b byte) bool {
+	p.skipWhitespace()
+	if p.peek() == b {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) skipWhitespace() {
+	for !p.eof() {
+		switch p.input[p.pos] {
+		case ' ', '\t', '\n', '\r', ',':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) skipKeyword(kw string) {
+	p.skipWhitespace()
+	if strings.HasPrefix(p.input[p.pos:], kw) {
+		p.pos += len(kw)
+	}
+}
+
+func isNameByte(b byte) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+func (p *parser) readName() string {
+	p.skipWhitespace()
+	start := p.pos
+	for !p.eof() && isNameByte(p.input[p.pos]) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) skipName() {
+	p.readName()
+}
+
+// readArgs parses an optional "(name: value, ...)" argument list.
+func (p *parser) readArgs() (map[string]any, error) {
+	p.skipWhitespace()
+	if p.peek() != '(' {
+		return nil, nil
+	}
+	p.pos++ // consume '('
+
+	args := make(map[string]any)
+	for {
+		p.skipWhitespace()
+		if p.consume(')') {
+			break
+		}
+		if p.eof() {
+			return nil, fmt.Errorf("unexpected end of query, unclosed argument list")
+		}
+
+		name := p.readName()
+		if name == "" {
+			return nil, fmt.Errorf("expected argument name at position %d", p.pos)
+		}
+		p.skipWhitespace()
+		if !p.consume(':') {
+			return nil, fmt.Errorf("expected ':' after argument %q", name)
+		}
+
+		value, err := p.readValue()
+		if err != nil {
+			return nil, err
+		}
+		args[name] = value
+	}
+	return args, nil
+}
+
+func (p *parser) readValue() (any, error) {
+	p.skipWhitespace()
+	if p.eof() {
+		return nil, fmt.Errorf("expected value at position %d", p.pos)
+	}
+
+	switch c := p.peek(); {
+	case c == '"':
+		return p.readString()
+	case c == '$':
+		return p.readVariable()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.readNumber()
+	case strings.HasPrefix(p.input[p.pos:], "true"):
+		p.pos += 4
+		return true, nil
+	case strings.HasPrefix(p.input[p.pos:], "false"):
+		p.pos += 5
+		return false, nil
+	case strings.HasPrefix(p.input[p.pos:], "null"):
+		p.pos += 4
+		return nil, nil
+	default:
+		// Bare word, e.g. an enum value like NEW.
+		name := p.readName()
+		if name == "" {
+			return nil, fmt.Errorf("invalid value at position %d", p.pos)
+		}
+		return name, nil
+	}
+}
+
+func (p *parser) readString() (string, error) {
+	p.pos++ // consume opening quote
+	var sb strings.Builder
+	for {
+		if p.eof() {
+			return "", fmt.Errorf("unterminated string literal")
+		}
+		c := p.input[p.pos]
+		if c == '"' {
+			p.pos++
+			return sb.String(), nil
+		}
+		if c == '\\' && p.pos+1 < len(p.input) {
+			p.pos++
+			sb.WriteByte(p.input[p.pos])
+			p.pos++
+			continue
+		}
+		sb.WriteByte(c)
+		p.pos++
+	}
+}
+
+func (p *parser) readVariable() (any, error) {
+	p.pos++ // consume '$'
+	name := p.readName()
+	if name == "" {
+		return nil, fmt.Errorf("expected variable name after '$'")
+	}
+	value, ok := p.variables[name]
+	if !ok {
+		return nil, fmt.Errorf("undefined variable $%s", name)
+	}
+	return value, nil
+}
+
+func (p *parser) readNumber() (any, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	isFloat := false
+	for !p.eof() && (p.input[p.pos] >= '0' && p.input[p.pos] <= '9' || p.input[p.pos] == '.') {
+		if p.input[p.pos] == '.' {
+			isFloat = true
+		}
+		p.pos++
+	}
+	raw := p.input[start:p.pos]
+	if isFloat {
+		f, err := strconv.ParseFloat(raw, 64)
+		return f, err
+	}
+	n, err := strconv.Atoi(raw)
+	return n, err
+}
+
+// skipSelectionSet consumes a balanced "{ ... }" block without interpreting
+// it, since resolvers return full objects rather than projected fields.
+func (p *parser) skipSelectionSet() error {
+	depth := 0
+	for {
+		if p.eof() {
+			return fmt.Errorf("unclosed selection set")
+		}
+		switch p.input[p.pos] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return nil
+			}
+		}
+		p.pos++
+	}
+}
@@ -0,0 +1,66 @@
+// Package heartbeat pings an external dead-man's-switch monitor (e.g.
+// healthchecks.io) after each poll, so an operator is alerted by that
+// external service if this process stops polling entirely — a poller
+// goroutine deadlock or crash leaves nothing here to raise its own alert.
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Config holds heartbeat client configuration.
+type Config struct {
+	// URL is the base ping URL (e.g. https://hc-ping.com/<uuid>). A GET is
+	// sent to URL on success and to URL+"/fail" on failure, matching
+	// healthchecks.io's convention.
+	URL     string
+	Timeout time.Duration
+}
+
+// Client pings a dead-man's-switch monitoring URL.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates a new heartbeat client.
+func NewClient(cfg Config) *Client {
+	return &Client{
+		url:        cfg.URL,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Success pings the base URL, signaling that a poll completed successfully.
+func (c *Client) Success(ctx context.Context) error {
+	return c.ping(ctx, c.url)
+}
+
+// Fail pings the "/fail" URL, signaling that a poll failed. The error itself
+// isn't sent, since ping bodies aren't surfaced by most monitors and the
+// poller's own failure log already captures it.
+func (c *Client) Fail(ctx context.Context) error {
+	return c.ping(ctx, c.url+"/fail")
+}
+
+func (c *Client) ping(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build heartbeat request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send heartbeat ping: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("heartbeat ping returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Package i18n provides bundled translations and locale-aware date
+// formatting for notification content sent over email, Slack, and Discord.
+package i18n
+
+import "time"
+
+// Locale is one of the notification content locales bundled with the
+// server. An unrecognized or empty locale falls back to English.
+type Locale string
+
+const (
+	English  Locale = "en"
+	Spanish  Locale = "es"
+	German   Locale = "de"
+	Japanese Locale = "ja"
+	Chinese  Locale = "zh"
+)
+
+// Key identifies a translatable notification string.
+type Key string
+
+const (
+	KeyNewServerTitle           Key = "new_server_title"
+	KeyServerUpdatedTitle       Key = "server_updated_title"
+	KeyServerRemovedTitle       Key = "server_removed_title"
+	KeySubscriptionSummaryTitle Key = "subscription_summary_title"
+	KeyChangeTypeNew            Key = "change_type_new"
+	KeyChangeTypeUpdated        Key = "change_type_updated"
+	KeyChangeTypeRemoved        Key = "change_type_removed"
+	KeyVersionLabel             Key = "version_label"
+	KeyPackagesLabel            Key = "packages_label"
+	KeyRepositoryLabel          Key = "repository_label"
+	KeyChangedFieldsLabel       Key = "changed_fields_label"
+	KeyDetectedAtLabel          Key = "detected_at_label"
+	KeyViewRepository           Key = "view_repository"
+	KeyViewInRegistry           Key = "view_in_registry"
+	KeyViewHistory              Key = "view_history"
+	KeyMuteServer               Key = "mute_server"
+)
+
+var messages = map[Locale]map[Key]string{
+	English: {
+		KeyNewServerTitle:           "New MCP Server",
+		KeyServerUpdatedTitle:       "Server Updated",
+		KeyServerRemovedTitle:       "Server Removed",
+		KeySubscriptionSummaryTitle: "Subscription Summary",
+		KeyChangeTypeNew:            "New",
+		KeyChangeTypeUpdated:        "Updated",
+		KeyChangeTypeRemoved:        "Removed",
+		KeyVersionLabel:             "Version",
+		KeyPackagesLabel:            "Packages",
+		KeyRepositoryLabel:          "Repository",
+		KeyChangedFieldsLabel:       "Changed Fields",
+		KeyDetectedAtLabel:          "Detected at",
+		KeyViewRepository:           "View Repository",
+		KeyViewInRegistry:           "View in Registry",
+		KeyViewHistory:              "View History",
+		KeyMuteServer:               "Mute this server",
+	},
+	Spanish: {
+		KeyNewServerTitle:           "Nuevo servidor MCP",
+		KeyServerUpdatedTitle:       "Servidor actualizado",
+		KeyServerRemovedTitle:       "Servidor eliminado",
+		KeySubscriptionSummaryTitle: "Resumen de la suscripción",
+		KeyChangeTypeNew:            "Nuevo",
+		KeyChangeTypeUpdated:        "Actualizado",
+		KeyChangeTypeRemoved:        "Eliminado",
+		KeyVersionLabel:             "Versión",
+		KeyPackagesLabel:            "Paquetes",
+		KeyRepositoryLabel:          "Repositorio",
+		KeyChangedFieldsLabel:       "Campos modificados",
+		KeyDetectedAtLabel:          "Detectado el",
+		KeyViewRepository:           "Ver repositorio",
+		KeyViewInRegistry:           "Ver en el registro",
+		KeyViewHistory:              "Ver historial",
+		KeyMuteServer:               "Silenciar este servidor",
+	},
+	German: {
+		KeyNewServerTitle:           "Neuer MCP-Server",
+		KeyServerUpdatedTitle:       "Server aktualisiert",
+		KeyServerRemovedTitle:       "Server entfernt",
+		KeySubscriptionSummaryTitle: "Abonnementübersicht",
+		KeyChangeTypeNew:            "Neu",
+		KeyChangeTypeUpdated:        "Aktualisiert",
+		KeyChangeTypeRemoved:        "Entfernt",
+		KeyVersionLabel:             "Version",
+		KeyPackagesLabel:            "Pakete",
+		KeyRepositoryLabel:          "Repository",
+		KeyChangedFieldsLabel:       "Geänderte Felder",
+		KeyDetectedAtLabel:          "Erkannt am",
+		KeyViewRepository:           "Repository ansehen",
+		KeyViewInRegistry:           "Im Registry ansehen",
+		KeyViewHistory:              "Verlauf ansehen",
+		KeyMuteServer:               "Diesen Server stummschalten",
+	},
+	Japanese: {
+		KeyNewServerTitle:           "新しいMCPサーバー",
+		KeyServerUpdatedTitle:       "サーバーが更新されました",
+		KeyServerRemovedTitle:       "サーバーが削除されました",
+		KeySubscriptionSummaryTitle: "サブスクリプションの概要",
+		KeyChangeTypeNew:            "新規",
+		KeyChangeTypeUpdated:        "更新",
+		KeyChangeTypeRemoved:        "削除",
+		KeyVersionLabel:             "バージョン",
+		KeyPackagesLabel:            "パッケージ",
+		KeyRepositoryLabel:          "リポジトリ",
+		KeyChangedFieldsLabel:       "変更されたフィールド",
+		KeyDetectedAtLabel:          "検出日時",
+		KeyViewRepository:           "リポジトリを表示",
+		KeyViewInRegistry:           "レジストリで表示",
+		KeyViewHistory:              "履歴を表示",
+		KeyMuteServer:               "このサーバーをミュート",
+	},
+	Chinese: {
+		KeyNewServerTitle:           "新的 MCP 服务器",
+		KeyServerUpdatedTitle:       "服务器已更新",
+		KeyServerRemovedTitle:       "服务器已移除",
+		KeySubscriptionSummaryTitle: "订阅摘要",
+		KeyChangeTypeNew:            "新增",
+		KeyChangeTypeUpdated:        "已更新",
+		KeyChangeTypeRemoved:        "已移除",
+		KeyVersionLabel:             "版本",
+		KeyPackagesLabel:            "软件包",
+		KeyRepositoryLabel:          "仓库",
+		KeyChangedFieldsLabel:       "变更字段",
+		KeyDetectedAtLabel:          "检测时间",
+		KeyViewRepository:           "查看仓库",
+		KeyViewInRegistry:           "在注册表中查看",
+		KeyViewHistory:              "查看历史记录",
+		KeyMuteServer:               "静音此服务器",
+	},
+}
+
+// dateFormats maps each bundled locale to the Go reference-time layout used
+// to render notification timestamps.
+var dateFormats = map[Locale]string{
+	English:  "Jan 2, 2006 at 3:04 PM MST",
+	Spanish:  "2 ene 2006, 15:04 MST",
+	German:   "2. Jan 2006, 15:04 MST",
+	Japanese: "2006年1月2日 15:04 MST",
+	Chinese:  "2006年1月2日 15:04 MST",
+}
+
+// normalize maps an arbitrary locale string to a bundled Locale, falling
+// back to English for anything unrecognized or empty.
+func normalize(locale Locale) Locale {
+	if _, ok := messages[locale]; ok {
+		return locale
+	}
+	return English
+}
+
+// T returns the translation of key in locale, falling back to English if
+// locale is unrecognized or the key isn't translated for it.
+func T(locale Locale, key Key) string {
+	if msg, ok := messages[normalize(locale)][key]; ok {
+		return msg
+	}
+	return messages[English][key]
+}
+
+// FormatTime renders t using the date format bundled for locale.
+func FormatTime(locale Locale, t time.Time) string {
+	return t.Format(dateFormats[normalize(locale)])
+}
@@ -0,0 +1,63 @@
+// Package leader provides Postgres-advisory-lock-based leader election so
+// only one replica in a multi-replica deployment runs single-instance work
+// (the registry poller, the digest scheduler) while every replica keeps
+// serving the API.
+package leader
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+)
+
+// defaultRetryInterval is how often a non-leader replica retries acquiring
+// leadership.
+const defaultRetryInterval = 15 * time.Second
+
+// Elector campaigns for leadership of a named advisory lock and runs a
+// caller-supplied function only while holding it.
+type Elector struct {
+	db            db.Database
+	name          string
+	retryInterval time.Duration
+}
+
+// New creates an Elector for the named lock. A non-positive retryInterval
+// falls back to defaultRetryInterval.
+func New(database db.Database, name string, retryInterval time.Duration) *Elector {
+	if retryInterval <= 0 {
+		retryInterval = defaultRetryInterval
+	}
+	return &Elector{db: database, name: name, retryInterval: retryInterval}
+}
+
+// Run blocks until ctx is canceled, running fn for as long as (and only
+// while) this replica holds leadership of the lock. The lock is a
+// Postgres advisory lock held on a single connection for fn's whole
+// lifetime, so a crashed leader releases it automatically (the session
+// ends) instead of requiring a lease timeout. While leadership can't be
+// acquired, Run retries every retryInterval; if fn returns before ctx is
+// canceled, leadership is released and Run campaigns again.
+func (e *Elector) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	for {
+		acquired, err := e.db.WithMaintenanceLock(ctx, e.name, func(lockCtx context.Context) error {
+			log.Info().Str("lock", e.name).Msg("Acquired leadership")
+			return fn(lockCtx)
+		})
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Str("lock", e.name).Msg("Leader-run failed")
+		}
+		if acquired {
+			log.Info().Str("lock", e.name).Msg("Lost leadership")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.retryInterval):
+		}
+	}
+}
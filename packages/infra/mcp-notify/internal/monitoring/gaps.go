@@ -0,0 +1,51 @@
+// Package monitoring detects gaps in the poller's own monitoring coverage
+// (the poller was down, or every attempt in a window failed) so subscribers
+// can be told "we weren't watching" instead of silently seeing "no changes".
+package monitoring
+
+import (
+	"sort"
+	"time"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// DetectGaps walks poll records in chronological order and reports windows
+// where no successful poll completed for longer than expectedInterval
+// allows (tolerance widens the window slightly to avoid flagging ordinary
+// scheduling jitter). A gap is also reported from the last successful poll
+// to now if that gap already exceeds the threshold.
+func DetectGaps(records []types.PollRecord, expectedInterval, tolerance time.Duration, now time.Time) []types.MonitoringGap {
+	threshold := expectedInterval + tolerance
+	if threshold <= 0 {
+		return nil
+	}
+
+	successful := make([]types.PollRecord, 0, len(records))
+	for _, r := range records {
+		if r.Success {
+			successful = append(successful, r)
+		}
+	}
+	sort.Slice(successful, func(i, j int) bool {
+		return successful[i].CompletedAt.Before(successful[j].CompletedAt)
+	})
+
+	var gaps []types.MonitoringGap
+	for i := 1; i < len(successful); i++ {
+		from := successful[i-1].CompletedAt
+		to := successful[i].CompletedAt
+		if to.Sub(from) > threshold {
+			gaps = append(gaps, types.MonitoringGap{From: from, To: to})
+		}
+	}
+
+	if len(successful) > 0 {
+		last := successful[len(successful)-1].CompletedAt
+		if now.Sub(last) > threshold {
+			gaps = append(gaps, types.MonitoringGap{From: last, To: now})
+		}
+	}
+
+	return gaps
+}
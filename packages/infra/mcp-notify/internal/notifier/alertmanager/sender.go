@@ -0,0 +1,156 @@
+// Package alertmanager sends registry changes as Alertmanager-compatible
+// alerts, so ops teams can route them through an existing alerting pipeline
+// instead of standing up a separate notification path.
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds Alertmanager sender configuration.
+type Config struct {
+	Timeout       time.Duration
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender posts notifications to an Alertmanager instance's v2 alerts API.
+type Sender struct {
+	httpClient    *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new Alertmanager sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelAlertmanager
+}
+
+// Send posts a notification to Alertmanager's /api/v2/alerts endpoint.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	url := channel.Config.AlertmanagerURL
+	if url == "" {
+		return fmt.Errorf("alertmanager URL not configured")
+	}
+
+	alerts := []Alert{s.buildAlert(change)}
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying Alertmanager notification")
+		}
+
+		err := s.sendRequest(ctx, url, alerts)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+func (s *Sender) sendRequest(ctx context.Context, url string, alerts []Alert) error {
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alerts: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/api/v2/alerts", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// buildAlert converts a registry change into an Alertmanager alert, using
+// labels for the dimensions ops teams route/group on (namespace, change
+// type, server) and annotations for the human-readable description.
+func (s *Sender) buildAlert(change *types.Change) Alert {
+	labels := map[string]string{
+		"alertname":   "mcp_registry_change",
+		"change_type": string(change.ChangeType),
+		"server":      change.ServerName,
+	}
+	if change.Server != nil && change.Server.Name != "" {
+		if namespace, _, ok := splitServerName(change.Server.Name); ok {
+			labels["namespace"] = namespace
+		}
+	}
+
+	summary := fmt.Sprintf("%s: %s", change.ChangeType, change.ServerName)
+	description := summary
+	if change.Server != nil && change.Server.Description != "" {
+		description = change.Server.Description
+	}
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		description += fmt.Sprintf(" (%s -> %s)", change.PreviousVersion, change.NewVersion)
+	}
+
+	return Alert{
+		Labels: labels,
+		Annotations: map[string]string{
+			"summary":     summary,
+			"description": description,
+		},
+		StartsAt: change.DetectedAt,
+	}
+}
+
+// splitServerName splits an MCP registry server name of the form
+// "namespace/name" into its namespace, reporting ok=false when the name has
+// no namespace segment.
+func splitServerName(name string) (namespace, rest string, ok bool) {
+	for i := 0; i < len(name); i++ {
+		if name[i] == '/' {
+			return name[:i], name[i+1:], true
+		}
+	}
+	return "", name, false
+}
+
+// Alert is an Alertmanager v2 alert as accepted by POST /api/v2/alerts.
+// See https://github.com/prometheus/alertmanager/blob/main/api/v2/openapi.yaml
+type Alert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	StartsAt    time.Time         `json:"startsAt,omitempty"`
+}
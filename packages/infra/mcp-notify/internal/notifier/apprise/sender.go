@@ -0,0 +1,153 @@
+// Package apprise provides notification sending to a self-hosted Apprise API
+// server (https://github.com/caronc/apprise-api), letting users reach any of
+// Apprise's dozens of supported services from a single configured endpoint.
+package apprise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds Apprise sender configuration.
+type Config struct {
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender sends notifications via an Apprise API server.
+type Sender struct {
+	httpClient    *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new Apprise sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelApprise
+}
+
+// Send sends a notification via the Apprise API's stateless /notify
+// endpoint. AppriseURL is the server's base URL (e.g. http://localhost:8000)
+// and AppriseKey is the config key holding the actual downstream service
+// URLs, both configured through Apprise itself. See
+// https://github.com/caronc/apprise-api#api-details.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	if channel.Config.AppriseURL == "" {
+		return fmt.Errorf("apprise URL not configured")
+	}
+	if channel.Config.AppriseKey == "" {
+		return fmt.Errorf("apprise key not configured")
+	}
+
+	payload := s.buildPayload(change, channel.Config.AppriseTag)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying Apprise notification")
+		}
+
+		err := s.sendRequest(ctx, channel.Config.AppriseURL, channel.Config.AppriseKey, payload)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+func (s *Sender) sendRequest(ctx context.Context, baseURL, key string, payload AppriseNotifyRequest) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/notify/%s", baseURL, key), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// buildPayload builds an Apprise API notify request. notifyType maps the
+// change type to Apprise's info/success/warning/failure levels so
+// downstream services that render them (e.g. color-coded chat messages)
+// get a sensible default.
+func (s *Sender) buildPayload(change *types.Change, tag string) AppriseNotifyRequest {
+	var title, notifyType string
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		title = "New MCP Server"
+		notifyType = "success"
+	case types.ChangeTypeUpdated:
+		title = "Server Updated"
+		notifyType = "info"
+	case types.ChangeTypeRemoved:
+		title = "Server Removed"
+		notifyType = "warning"
+	case types.ChangeTypeSummary:
+		title = "Subscription Summary"
+		notifyType = "info"
+	}
+
+	body := change.ServerName
+	if change.Server != nil && change.Server.Description != "" {
+		body = fmt.Sprintf("%s\n%s", body, change.Server.Description)
+	}
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		body = fmt.Sprintf("%s\n%s -> %s", body, change.PreviousVersion, change.NewVersion)
+	}
+
+	return AppriseNotifyRequest{
+		Title: title,
+		Body:  body,
+		Type:  notifyType,
+		Tag:   tag,
+	}
+}
+
+// AppriseNotifyRequest is the Apprise API's /notify/{key} request body.
+type AppriseNotifyRequest struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body"`
+	Type  string `json:"type,omitempty"` // info, success, warning, failure
+	Tag   string `json:"tag,omitempty"`  // restrict delivery to services with this Apprise tag
+}
@@ -12,14 +12,22 @@ import (
 	"github.com/rs/zerolog/log"
 	"golang.org/x/time/rate"
 
+	"github.com/nirholas/mcp-notify/internal/i18n"
+	"github.com/nirholas/mcp-notify/internal/notifier/template"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
 // Config holds Discord sender configuration.
 type Config struct {
-	RateLimit     string        // e.g., "30/min"
+	RateLimit     string // e.g., "30/min"
 	RetryAttempts int
 	RetryDelay    time.Duration
+
+	// BotToken, if set, lets channels deliver by channel ID instead of a
+	// per-channel webhook URL, via the Discord bot REST API. Used by
+	// subscriptions created through bot slash commands, which bind to the
+	// invoking channel rather than an incoming webhook.
+	BotToken string
 }
 
 // Sender sends notifications via Discord webhooks.
@@ -28,6 +36,7 @@ type Sender struct {
 	limiter       *rate.Limiter
 	retryAttempts int
 	retryDelay    time.Duration
+	botToken      string
 }
 
 // NewSender creates a new Discord sender.
@@ -42,6 +51,7 @@ func NewSender(cfg Config) *Sender {
 		limiter:       limiter,
 		retryAttempts: cfg.RetryAttempts,
 		retryDelay:    cfg.RetryDelay,
+		botToken:      cfg.BotToken,
 	}
 }
 
@@ -58,21 +68,13 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 	}
 
 	webhookURL := channel.Config.DiscordWebhookURL
-	if webhookURL == "" {
+	if webhookURL == "" && (channel.Config.DiscordChannelID == "" || s.botToken == "") {
 		return fmt.Errorf("discord webhook URL not configured")
 	}
 
-	// Build Discord embed
-	embed := s.buildEmbed(change)
-
-	payload := DiscordPayload{
-		Username:  channel.Config.DiscordUsername,
-		AvatarURL: channel.Config.DiscordAvatarURL,
-		Embeds:    []DiscordEmbed{embed},
-	}
-
-	if payload.Username == "" {
-		payload.Username = "MCP Notify"
+	payload, err := RenderPayload(channel, change)
+	if err != nil {
+		return err
 	}
 
 	// Send with retries
@@ -87,7 +89,12 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 			log.Debug().Int("attempt", attempt).Msg("Retrying Discord notification")
 		}
 
-		err := s.sendRequest(ctx, webhookURL, payload)
+		var err error
+		if webhookURL != "" {
+			err = s.sendRequest(ctx, webhookURL, payload)
+		} else {
+			err = s.sendViaBot(ctx, channel.Config.DiscordChannelID, payload)
+		}
 		if err == nil {
 			return nil
 		}
@@ -97,6 +104,37 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 	return lastErr
 }
 
+// sendViaBot delivers payload to channelID using the Discord bot REST API
+// instead of an incoming webhook, for channels bound by bot slash commands.
+func (s *Sender) sendViaBot(ctx context.Context, channelID string, payload DiscordPayload) error {
+	url := fmt.Sprintf("https://discord.com/api/v10/channels/%s/messages", channelID)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bot "+s.botToken)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
 func (s *Sender) sendRequest(ctx context.Context, webhookURL string, payload DiscordPayload) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
@@ -124,7 +162,33 @@ func (s *Sender) sendRequest(ctx context.Context, webhookURL string, payload Dis
 	return nil
 }
 
-func (s *Sender) buildEmbed(change *types.Change) DiscordEmbed {
+// RenderPayload builds the Discord webhook payload for a change without
+// sending it, so previews and tests can inspect exactly what Send would
+// deliver.
+func RenderPayload(channel *types.Channel, change *types.Change) (DiscordPayload, error) {
+	payload := DiscordPayload{
+		Username:  channel.Config.DiscordUsername,
+		AvatarURL: channel.Config.DiscordAvatarURL,
+	}
+
+	if channel.Config.MessageTemplate != "" {
+		content, err := template.Render(channel.Config.MessageTemplate, change)
+		if err != nil {
+			return DiscordPayload{}, err
+		}
+		payload.Content = content
+	} else {
+		payload.Embeds = []DiscordEmbed{buildEmbed(change, i18n.Locale(channel.Config.Locale))}
+	}
+
+	if payload.Username == "" {
+		payload.Username = "MCP Notify"
+	}
+
+	return payload, nil
+}
+
+func buildEmbed(change *types.Change, locale i18n.Locale) DiscordEmbed {
 	embed := DiscordEmbed{
 		Timestamp: change.DetectedAt.Format(time.RFC3339),
 	}
@@ -133,13 +197,16 @@ func (s *Sender) buildEmbed(change *types.Change) DiscordEmbed {
 	switch change.ChangeType {
 	case types.ChangeTypeNew:
 		embed.Color = 0x57F287 // Green
-		embed.Title = "🆕 New MCP Server"
+		embed.Title = "🆕 " + i18n.T(locale, i18n.KeyNewServerTitle)
 	case types.ChangeTypeUpdated:
 		embed.Color = 0x5865F2 // Blue
-		embed.Title = "📝 Server Updated"
+		embed.Title = "📝 " + i18n.T(locale, i18n.KeyServerUpdatedTitle)
 	case types.ChangeTypeRemoved:
 		embed.Color = 0xED4245 // Red
-		embed.Title = "🗑️ Server Removed"
+		embed.Title = "🗑️ " + i18n.T(locale, i18n.KeyServerRemovedTitle)
+	case types.ChangeTypeSummary:
+		embed.Color = 0xFEE75C // Yellow
+		embed.Title = "📋 " + i18n.T(locale, i18n.KeySubscriptionSummaryTitle)
 	}
 
 	embed.Title += fmt.Sprintf(": %s", change.ServerName)
@@ -159,13 +226,13 @@ func (s *Sender) buildEmbed(change *types.Change) DiscordEmbed {
 	// Version field
 	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
 		fields = append(fields, DiscordField{
-			Name:   "Version",
+			Name:   i18n.T(locale, i18n.KeyVersionLabel),
 			Value:  fmt.Sprintf("`%s` → `%s`", change.PreviousVersion, change.NewVersion),
 			Inline: true,
 		})
 	} else if change.NewVersion != "" {
 		fields = append(fields, DiscordField{
-			Name:   "Version",
+			Name:   i18n.T(locale, i18n.KeyVersionLabel),
 			Value:  fmt.Sprintf("`%s`", change.NewVersion),
 			Inline: true,
 		})
@@ -186,7 +253,7 @@ func (s *Sender) buildEmbed(change *types.Change) DiscordEmbed {
 		}
 		if packageLinks != "" {
 			fields = append(fields, DiscordField{
-				Name:   "Packages",
+				Name:   i18n.T(locale, i18n.KeyPackagesLabel),
 				Value:  packageLinks,
 				Inline: true,
 			})
@@ -196,7 +263,7 @@ func (s *Sender) buildEmbed(change *types.Change) DiscordEmbed {
 	// Repository link
 	if change.Server != nil && change.Server.Repository != nil && change.Server.Repository.URL != "" {
 		fields = append(fields, DiscordField{
-			Name:   "Repository",
+			Name:   i18n.T(locale, i18n.KeyRepositoryLabel),
 			Value:  fmt.Sprintf("[View on %s](%s)", change.Server.Repository.Source, change.Server.Repository.URL),
 			Inline: true,
 		})
@@ -213,7 +280,7 @@ func (s *Sender) buildEmbed(change *types.Change) DiscordEmbed {
 		}
 		if changedFields != "" {
 			fields = append(fields, DiscordField{
-				Name:   "Changed Fields",
+				Name:   i18n.T(locale, i18n.KeyChangedFieldsLabel),
 				Value:  changedFields,
 				Inline: false,
 			})
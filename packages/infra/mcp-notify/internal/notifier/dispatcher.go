@@ -18,12 +18,23 @@ import (
 
 	"github.com/nirholas/mcp-notify/internal/config"
 	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/notifier/alertmanager"
+	"github.com/nirholas/mcp-notify/internal/notifier/apprise"
 	"github.com/nirholas/mcp-notify/internal/notifier/discord"
 	"github.com/nirholas/mcp-notify/internal/notifier/email"
+	"github.com/nirholas/mcp-notify/internal/notifier/eventbridge"
+	"github.com/nirholas/mcp-notify/internal/notifier/googlechat"
+	"github.com/nirholas/mcp-notify/internal/notifier/mattermost"
+	"github.com/nirholas/mcp-notify/internal/notifier/push"
+	"github.com/nirholas/mcp-notify/internal/notifier/rocketchat"
 	"github.com/nirholas/mcp-notify/internal/notifier/slack"
+	"github.com/nirholas/mcp-notify/internal/notifier/sms"
+	"github.com/nirholas/mcp-notify/internal/notifier/sns"
 	"github.com/nirholas/mcp-notify/internal/notifier/teams"
 	"github.com/nirholas/mcp-notify/internal/notifier/telegram"
 	"github.com/nirholas/mcp-notify/internal/notifier/webhook"
+	"github.com/nirholas/mcp-notify/internal/notifier/zulip"
+	"github.com/nirholas/mcp-notify/internal/telemetry"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -34,8 +45,8 @@ var (
 
 // Common errors
 var (
-	ErrCircuitOpen     = errors.New("circuit breaker is open")
-	ErrNoSender        = errors.New("no sender registered for channel type")
+	ErrCircuitOpen        = errors.New("circuit breaker is open")
+	ErrNoSender           = errors.New("no sender registered for channel type")
 	ErrMaxRetriesExceeded = errors.New("maximum retries exceeded")
 )
 
@@ -48,14 +59,14 @@ type Sender interface {
 // CircuitBreaker implements the circuit breaker pattern to prevent
 // hammering failed notification channels.
 type CircuitBreaker struct {
-	mu            sync.RWMutex
-	failures      int
-	successes     int
-	lastFailure   time.Time
-	state         CircuitState
-	threshold     int           // Failures to open circuit
-	timeout       time.Duration // Time to wait before half-open
-	successThreshold int        // Successes needed to close circuit
+	mu               sync.RWMutex
+	failures         int
+	successes        int
+	lastFailure      time.Time
+	state            CircuitState
+	threshold        int           // Failures to open circuit
+	timeout          time.Duration // Time to wait before half-open
+	successThreshold int           // Successes needed to close circuit
 }
 
 // CircuitState represents the state of the circuit breaker.
@@ -112,8 +123,10 @@ func (cb *CircuitBreaker) RecordSuccess() {
 	}
 }
 
-// RecordFailure records a failed request.
-func (cb *CircuitBreaker) RecordFailure() {
+// RecordFailure records a failed request and reports whether this call
+// transitioned the circuit into the open state, so callers can emit an
+// accurate circuit-opened metric instead of one that fires on every failure.
+func (cb *CircuitBreaker) RecordFailure() (opened bool) {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
@@ -123,11 +136,15 @@ func (cb *CircuitBreaker) RecordFailure() {
 
 	if cb.state == CircuitClosed && cb.failures >= cb.threshold {
 		cb.state = CircuitOpen
+		opened = true
 		log.Warn().Int("failures", cb.failures).Msg("Circuit breaker opened")
 	} else if cb.state == CircuitHalfOpen {
 		cb.state = CircuitOpen
+		opened = true
 		log.Warn().Msg("Circuit breaker reopened from half-open")
 	}
+
+	return opened
 }
 
 // State returns the current state of the circuit breaker.
@@ -137,16 +154,6 @@ func (cb *CircuitBreaker) State() CircuitState {
 	return cb.state
 }
 
-// RetryItem represents an item in the retry queue.
-type RetryItem struct {
-	ChannelID    string
-	ChangeID     string
-	Attempts     int
-	NextRetry    time.Time
-	MaxAttempts  int
-	LastError    string
-}
-
 // Dispatcher routes notifications to the appropriate sender.
 type Dispatcher struct {
 	senders         map[types.ChannelType]Sender
@@ -154,75 +161,124 @@ type Dispatcher struct {
 	database        db.Database
 	sem             *semaphore.Weighted
 	mu              sync.RWMutex
-	retryQueue      []RetryItem
-	retryMu         sync.Mutex
+	Sampler         *Sampler
 
 	// Configuration
-	maxRetries        int
-	baseRetryDelay    time.Duration
-	maxConcurrent     int64
-	circuitThreshold  int
-	circuitTimeout    time.Duration
+	maxRetries             int
+	baseRetryDelay         time.Duration
+	maxConcurrent          int64
+	circuitThreshold       int
+	circuitTimeout         time.Duration
+	maxConsecutiveFailures int
 
 	// Metrics
 	notificationsSent   metric.Int64Counter
 	notificationsFailed metric.Int64Counter
 	notificationLatency metric.Float64Histogram
 	circuitOpened       metric.Int64Counter
+	circuitState        metric.Int64Gauge
 	retryQueueSize      metric.Int64Gauge
 }
 
 // NewDispatcher creates a new notification dispatcher.
-func NewDispatcher(cfg config.NotificationsConfig, database db.Database) (*Dispatcher, error) {
+func NewDispatcher(ctx context.Context, cfg config.NotificationsConfig, database db.Database) (*Dispatcher, error) {
 	d := &Dispatcher{
-		senders:          make(map[types.ChannelType]Sender),
-		circuitBreakers:  make(map[string]*CircuitBreaker),
-		database:         database,
-		sem:              semaphore.NewWeighted(100), // Max 100 concurrent notifications
-		retryQueue:       make([]RetryItem, 0),
-		maxRetries:       5,
-		baseRetryDelay:   time.Second * 5,
-		maxConcurrent:    100,
-		circuitThreshold: 5,
-		circuitTimeout:   time.Minute * 5,
+		senders:                make(map[types.ChannelType]Sender),
+		circuitBreakers:        make(map[string]*CircuitBreaker),
+		database:               database,
+		sem:                    semaphore.NewWeighted(100), // Max 100 concurrent notifications
+		Sampler:                NewSampler(),
+		maxRetries:             5,
+		baseRetryDelay:         time.Second * 5,
+		maxConcurrent:          100,
+		circuitThreshold:       5,
+		circuitTimeout:         time.Minute * 5,
+		maxConsecutiveFailures: cfg.MaxConsecutiveFailures,
 	}
 
+	senders, err := buildSenders(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	d.senders = senders
+
+	// Initialize metrics
+	d.initMetrics()
+
+	log.Info().
+		Int("sender_count", len(d.senders)).
+		Msg("Notification dispatcher initialized")
+
+	return d, nil
+}
+
+// ReloadSenders rebuilds every channel sender from cfg (picking up changed
+// credentials, rate limits, retry settings, etc.) and atomically swaps them
+// in, so a config reload can pick up new notification channel credentials
+// without dropping in-flight dispatches or restarting the process.
+func (d *Dispatcher) ReloadSenders(ctx context.Context, cfg config.NotificationsConfig) error {
+	senders, err := buildSenders(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild notification senders: %w", err)
+	}
+
+	d.mu.Lock()
+	d.senders = senders
+	d.maxConsecutiveFailures = cfg.MaxConsecutiveFailures
+	d.mu.Unlock()
+
+	log.Info().
+		Int("sender_count", len(senders)).
+		Msg("Notification senders reloaded")
+
+	return nil
+}
+
+// buildSenders constructs every enabled channel's Sender from cfg.
+func buildSenders(ctx context.Context, cfg config.NotificationsConfig) (map[types.ChannelType]Sender, error) {
+	senders := make(map[types.ChannelType]Sender)
+
 	// Initialize senders based on config
 	if cfg.Discord.Enabled {
-		d.senders[types.ChannelDiscord] = discord.NewSender(discord.Config{
+		senders[types.ChannelDiscord] = discord.NewSender(discord.Config{
 			RateLimit:     cfg.Discord.RateLimit,
 			RetryAttempts: cfg.Discord.RetryAttempts,
 			RetryDelay:    cfg.Discord.RetryDelay,
+			BotToken:      cfg.Discord.Bot.Token,
 		})
 	}
 
 	if cfg.Slack.Enabled {
-		d.senders[types.ChannelSlack] = slack.NewSender(slack.Config{
+		senders[types.ChannelSlack] = slack.NewSender(slack.Config{
 			RateLimit:     cfg.Slack.RateLimit,
 			RetryAttempts: cfg.Slack.RetryAttempts,
 			RetryDelay:    cfg.Slack.RetryDelay,
+			SiteBaseURL:   cfg.RSS.BaseURL,
 		})
 	}
 
 	if cfg.Email.Enabled {
+		transport, err := buildEmailTransport(ctx, cfg.Email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create email transport: %w", err)
+		}
+
 		emailSender, err := email.NewSender(email.Config{
-			SMTPHost:      cfg.Email.SMTP.Host,
-			SMTPPort:      cfg.Email.SMTP.Port,
-			SMTPUsername:  cfg.Email.SMTP.Username,
-			SMTPPassword:  cfg.Email.SMTP.Password,
-			FromAddress:   cfg.Email.SMTP.From,
-			TLS:           cfg.Email.SMTP.TLS,
-			RetryAttempts: cfg.Email.RetryAttempts,
-			RetryDelay:    cfg.Email.RetryDelay,
+			Transport:      transport,
+			FromAddress:    cfg.Email.SMTP.From,
+			RetryAttempts:  cfg.Email.RetryAttempts,
+			RetryDelay:     cfg.Email.RetryDelay,
+			UnsubscribeURL: cfg.Email.UnsubscribeURL,
+			SecretKey:      cfg.Email.SecretKey,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create email sender: %w", err)
 		}
-		d.senders[types.ChannelEmail] = emailSender
+		senders[types.ChannelEmail] = emailSender
 	}
 
 	if cfg.Webhook.Enabled {
-		d.senders[types.ChannelWebhook] = webhook.NewSender(webhook.Config{
+		senders[types.ChannelWebhook] = webhook.NewSender(webhook.Config{
 			Timeout:       cfg.Webhook.Timeout,
 			RetryAttempts: cfg.Webhook.RetryAttempts,
 			RetryDelay:    cfg.Webhook.RetryDelay,
@@ -230,26 +286,137 @@ func NewDispatcher(cfg config.NotificationsConfig, database db.Database) (*Dispa
 		})
 	}
 
-	// Initialize Telegram sender (always enabled, requires config per channel)
-	d.senders[types.ChannelTelegram] = telegram.NewSender(telegram.Config{
+	if cfg.Alertmanager.Enabled {
+		senders[types.ChannelAlertmanager] = alertmanager.NewSender(alertmanager.Config{
+			Timeout:       cfg.Alertmanager.Timeout,
+			RetryAttempts: cfg.Alertmanager.RetryAttempts,
+			RetryDelay:    cfg.Alertmanager.RetryDelay,
+		})
+	}
+
+	if cfg.Mattermost.Enabled {
+		senders[types.ChannelMattermost] = mattermost.NewSender(mattermost.Config{
+			RetryAttempts: cfg.Mattermost.RetryAttempts,
+			RetryDelay:    cfg.Mattermost.RetryDelay,
+		})
+	}
+
+	if cfg.RocketChat.Enabled {
+		senders[types.ChannelRocketChat] = rocketchat.NewSender(rocketchat.Config{
+			RetryAttempts: cfg.RocketChat.RetryAttempts,
+			RetryDelay:    cfg.RocketChat.RetryDelay,
+		})
+	}
+
+	if cfg.Push.Enabled {
+		senders[types.ChannelPush] = push.NewSender(push.Config{
+			RetryAttempts: cfg.Push.RetryAttempts,
+			RetryDelay:    cfg.Push.RetryDelay,
+		})
+	}
+
+	if cfg.Apprise.Enabled {
+		senders[types.ChannelApprise] = apprise.NewSender(apprise.Config{
+			RetryAttempts: cfg.Apprise.RetryAttempts,
+			RetryDelay:    cfg.Apprise.RetryDelay,
+		})
+	}
+
+	if cfg.SMS.Enabled {
+		var provider sms.Provider
+		switch cfg.SMS.Provider {
+		case "twilio", "":
+			provider = sms.NewTwilioProvider(cfg.SMS.Twilio.AccountSID, cfg.SMS.Twilio.AuthToken, cfg.SMS.Twilio.FromNumber)
+		default:
+			return nil, fmt.Errorf("unknown SMS provider %q", cfg.SMS.Provider)
+		}
+		senders[types.ChannelSMS] = sms.NewSender(sms.Config{
+			Provider:                  provider,
+			MaxPerSubscriptionPerHour: cfg.SMS.MaxPerSubscriptionPerHour,
+			RetryAttempts:             cfg.SMS.RetryAttempts,
+			RetryDelay:                cfg.SMS.RetryDelay,
+		})
+	}
+
+	if cfg.SNS.Enabled {
+		snsSender, err := sns.NewSender(ctx, sns.Config{
+			Region:        cfg.SNS.Region,
+			RetryAttempts: cfg.SNS.RetryAttempts,
+			RetryDelay:    cfg.SNS.RetryDelay,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SNS sender: %w", err)
+		}
+		senders[types.ChannelSNS] = snsSender
+	}
+
+	if cfg.EventBridge.Enabled {
+		eventBridgeSender, err := eventbridge.NewSender(ctx, eventbridge.Config{
+			Region:        cfg.EventBridge.Region,
+			RetryAttempts: cfg.EventBridge.RetryAttempts,
+			RetryDelay:    cfg.EventBridge.RetryDelay,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create EventBridge sender: %w", err)
+		}
+		senders[types.ChannelEventBridge] = eventBridgeSender
+	}
+
+	if cfg.GoogleChat.Enabled {
+		senders[types.ChannelGoogleChat] = googlechat.NewSender(googlechat.Config{
+			RetryAttempts: cfg.GoogleChat.RetryAttempts,
+			RetryDelay:    cfg.GoogleChat.RetryDelay,
+		})
+	}
+
+	if cfg.Zulip.Enabled {
+		senders[types.ChannelZulip] = zulip.NewSender(zulip.Config{
+			Site:          cfg.Zulip.Site,
+			BotEmail:      cfg.Zulip.BotEmail,
+			BotAPIKey:     cfg.Zulip.BotAPIKey,
+			RetryAttempts: cfg.Zulip.RetryAttempts,
+			RetryDelay:    cfg.Zulip.RetryDelay,
+		})
+	}
+
+	// Initialize Telegram sender (always enabled, requires config per channel
+	// unless cfg.Telegram.Bot.BotToken supplies a global fallback)
+	senders[types.ChannelTelegram] = telegram.NewSender(telegram.Config{
+		BotToken:      cfg.Telegram.Bot.BotToken,
 		RetryAttempts: 3,
 		RetryDelay:    time.Second * 2,
 	})
 
 	// Initialize Teams sender (always enabled, requires config per channel)
-	d.senders[types.ChannelTeams] = teams.NewSender(teams.Config{
+	senders[types.ChannelTeams] = teams.NewSender(teams.Config{
 		RetryAttempts: 3,
 		RetryDelay:    time.Second * 2,
 	})
 
-	// Initialize metrics
-	d.initMetrics()
-
-	log.Info().
-		Int("sender_count", len(d.senders)).
-		Msg("Notification dispatcher initialized")
+	return senders, nil
+}
 
-	return d, nil
+// buildEmailTransport constructs the email.Transport selected by
+// cfg.Provider, defaulting to SMTP when unset.
+func buildEmailTransport(ctx context.Context, cfg config.EmailConfig) (email.Transport, error) {
+	switch cfg.Provider {
+	case "", "smtp":
+		return email.NewSMTPTransport(email.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			TLS:      cfg.SMTP.TLS,
+		}), nil
+	case "ses":
+		return email.NewSESTransport(ctx, email.SESConfig{Region: cfg.SES.Region})
+	case "sendgrid":
+		return email.NewSendGridTransport(cfg.SendGrid.APIKey), nil
+	case "mailgun":
+		return email.NewMailgunTransport(cfg.Mailgun.APIKey, cfg.Mailgun.Domain, cfg.Mailgun.BaseURL), nil
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", cfg.Provider)
+	}
 }
 
 func (d *Dispatcher) initMetrics() {
@@ -275,16 +442,70 @@ func (d *Dispatcher) initMetrics() {
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to create notification latency metric")
 	}
+
+	d.circuitOpened, err = meter.Int64Counter("notifications.circuit_breaker.opened_total",
+		metric.WithDescription("Total number of times a channel circuit breaker tripped open"),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to create circuit opened metric")
+	}
+
+	d.circuitState, err = meter.Int64Gauge("notifications.circuit_breaker.state",
+		metric.WithDescription("Current circuit breaker state per channel (0=closed, 1=half-open, 2=open)"),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to create circuit state metric")
+	}
+
+	d.retryQueueSize, err = meter.Int64Gauge("notifications.retry_queue.size",
+		metric.WithDescription("Number of notifications currently pending retry"),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to create retry queue size metric")
+	}
+}
+
+// recordCircuitState updates the circuit breaker gauge and, when a call just
+// tripped the circuit open, the opened counter for a channel.
+func (d *Dispatcher) recordCircuitState(ctx context.Context, channel *types.Channel, state CircuitState, justOpened bool) {
+	attrs := metric.WithAttributes(
+		attribute.String("channel_type", string(channel.Type)),
+		attribute.String("channel_id", channel.ID.String()),
+	)
+
+	if d.circuitState != nil {
+		d.circuitState.Record(ctx, int64(state), attrs)
+	}
+	if justOpened && d.circuitOpened != nil {
+		d.circuitOpened.Add(ctx, 1, attrs)
+	}
 }
 
 // Dispatch sends a notification for a change through a channel.
 func (d *Dispatcher) Dispatch(ctx context.Context, channel *types.Channel, change *types.Change) error {
-	ctx, span := tracer.Start(ctx, "Dispatch",
+	return d.dispatchNotification(ctx, channel, change, nil)
+}
+
+// dispatchNotification sends a notification, persisting a fresh record when
+// existing is nil or updating an already-persisted one when retrying. Failed
+// attempts under maxRetries are left in the "pending" state with next_retry
+// set, so ProcessRetryQueue can pick them back up straight from the database
+// even if the process restarts before the retry fires.
+func (d *Dispatcher) dispatchNotification(ctx context.Context, channel *types.Channel, change *types.Change, existing *types.Notification) error {
+	spanOpts := []trace.SpanStartOption{
 		trace.WithAttributes(
 			attribute.String("channel_type", string(channel.Type)),
 			attribute.String("server_name", change.ServerName),
 		),
-	)
+	}
+	// Continuing the poll trace via context propagation already covers the
+	// common case (see internal/poller's outbox trace stamping), but a link
+	// back to the originating change's span survives even a retry whose
+	// context doesn't carry that trace at all.
+	if link, ok := telemetry.LinkFromTraceContext(change.TraceContext); ok {
+		spanOpts = append(spanOpts, trace.WithLinks(link))
+	}
+	ctx, span := tracer.Start(ctx, "Dispatch", spanOpts...)
 	defer span.End()
 
 	// Acquire semaphore to limit concurrency
@@ -311,18 +532,14 @@ func (d *Dispatcher) Dispatch(ctx context.Context, channel *types.Channel, chang
 		return fmt.Errorf("no sender registered for channel type: %s", channel.Type)
 	}
 
-	// Create notification record
-	notification := &types.Notification{
-		SubscriptionID: channel.SubscriptionID,
-		ChannelID:      channel.ID,
-		ChangeID:       change.ID,
-		Status:         "pending",
-		CreatedAt:      time.Now().UTC(),
-	}
+	notification := existing
+	if notification == nil {
+		notification = newPendingNotification(channel, change)
 
-	// Save notification record
-	if err := d.database.SaveNotification(ctx, notification); err != nil {
-		log.Error().Err(err).Msg("Failed to save notification record")
+		// Save notification record
+		if err := d.database.SaveNotification(ctx, notification); err != nil {
+			log.Error().Err(err).Msg("Failed to save notification record")
+		}
 	}
 
 	// Send notification
@@ -330,9 +547,22 @@ func (d *Dispatcher) Dispatch(ctx context.Context, channel *types.Channel, chang
 
 	// Update notification status
 	if err != nil {
-		notification.Status = "failed"
-		notification.Error = err.Error()
 		notification.Attempts++
+		notification.Error = err.Error()
+
+		if notification.Attempts >= d.maxRetries {
+			notification.Status = "dead_letter"
+			notification.NextRetry = nil
+			log.Error().
+				Str("channel_id", channel.ID.String()).
+				Int("attempts", notification.Attempts).
+				Msg("Maximum retries exceeded, moving to dead letter")
+		} else {
+			notification.Status = "pending"
+			nextRetry := time.Now().Add(d.retryBackoff(notification.Attempts))
+			notification.NextRetry = &nextRetry
+		}
+
 		if d.notificationsFailed != nil {
 			d.notificationsFailed.Add(ctx, 1,
 				metric.WithAttributes(attribute.String("channel_type", string(channel.Type))),
@@ -349,6 +579,7 @@ func (d *Dispatcher) Dispatch(ctx context.Context, channel *types.Channel, chang
 		notification.Status = "sent"
 		now := time.Now().UTC()
 		notification.SentAt = &now
+		notification.NextRetry = nil
 		if d.notificationsSent != nil {
 			d.notificationsSent.Add(ctx, 1,
 				metric.WithAttributes(attribute.String("channel_type", string(channel.Type))),
@@ -374,6 +605,40 @@ func (d *Dispatcher) Dispatch(ctx context.Context, channel *types.Channel, chang
 	return err
 }
 
+// newPendingNotification builds a fresh "pending" notification record for
+// change on channel, ready to save.
+func newPendingNotification(channel *types.Channel, change *types.Change) *types.Notification {
+	return &types.Notification{
+		ID:             uuid.New(),
+		SubscriptionID: channel.SubscriptionID,
+		ChannelID:      channel.ID,
+		ChangeID:       change.ID,
+		Status:         "pending",
+		CreatedAt:      time.Now().UTC(),
+		ServerName:     change.ServerName,
+		ChangeType:     change.ChangeType,
+		PayloadSnippet: renderPayloadSnippet(change),
+	}
+}
+
+// maxPayloadSnippetLen caps how much of a rendered notification is stored for
+// search, so a long server description doesn't bloat the notifications table.
+const maxPayloadSnippetLen = 280
+
+// renderPayloadSnippet builds a short, human-readable summary of what a
+// notification said, independent of any single channel's rendering, so it
+// can be stored alongside the notification record and searched later.
+func renderPayloadSnippet(change *types.Change) string {
+	snippet := fmt.Sprintf("[%s] %s", change.ChangeType, change.ServerName)
+	if change.Server != nil && change.Server.Description != "" {
+		snippet = fmt.Sprintf("%s - %s", snippet, change.Server.Description)
+	}
+	if len(snippet) > maxPayloadSnippetLen {
+		snippet = snippet[:maxPayloadSnippetLen] + "..."
+	}
+	return snippet
+}
+
 // DispatchBatch sends notifications for multiple changes.
 func (d *Dispatcher) DispatchBatch(ctx context.Context, channel *types.Channel, changes []*types.Change) error {
 	ctx, span := tracer.Start(ctx, "DispatchBatch",
@@ -395,18 +660,70 @@ func (d *Dispatcher) DispatchBatch(ctx context.Context, channel *types.Channel,
 	return lastErr
 }
 
-// updateChannelStats updates the channel's delivery statistics.
+// updateChannelStats updates the channel's delivery statistics, auto-disabling
+// it once ConsecutiveFailures reaches d.maxConsecutiveFailures (if configured
+// and nonzero).
 func (d *Dispatcher) updateChannelStats(ctx context.Context, channel *types.Channel, success bool) error {
 	now := time.Now().UTC()
 	if success {
 		channel.SuccessCount++
 		channel.LastSuccess = &now
+		channel.ConsecutiveFailures = 0
 	} else {
 		channel.FailureCount++
 		channel.LastFailure = &now
+		channel.ConsecutiveFailures++
 	}
 
-	return d.database.UpdateChannel(ctx, channel)
+	disabling := !success && channel.Enabled && d.maxConsecutiveFailures > 0 &&
+		channel.ConsecutiveFailures >= d.maxConsecutiveFailures
+	if disabling {
+		channel.Enabled = false
+	}
+
+	if err := d.database.UpdateChannel(ctx, channel); err != nil {
+		return err
+	}
+
+	if disabling {
+		d.notifyChannelDisabled(ctx, channel)
+	}
+
+	return nil
+}
+
+// notifyChannelDisabled tells a subscription's other enabled channels that
+// one of their siblings was auto-disabled after too many consecutive
+// failures, since the disabled channel obviously can't deliver that news
+// itself.
+func (d *Dispatcher) notifyChannelDisabled(ctx context.Context, disabled *types.Channel) {
+	siblings, err := d.database.GetChannelsForSubscription(ctx, disabled.SubscriptionID)
+	if err != nil {
+		log.Error().Err(err).Str("channel_id", disabled.ID.String()).Msg("Failed to load sibling channels after auto-disabling channel")
+		return
+	}
+
+	summary := types.Change{
+		ServerName: disabled.SubscriptionID.String(),
+		ChangeType: types.ChangeTypeSummary,
+		Server: &types.Server{
+			Description: fmt.Sprintf(
+				"Your %s channel was disabled after %d consecutive delivery failures (last error: %s). Re-enable it once fixed.",
+				disabled.Type, disabled.ConsecutiveFailures, disabled.LastError,
+			),
+		},
+		DetectedAt: time.Now(),
+		Confirmed:  true,
+	}
+
+	for _, sibling := range siblings {
+		if sibling.ID == disabled.ID || !sibling.Enabled {
+			continue
+		}
+		if err := d.Dispatch(ctx, &sibling, &summary); err != nil {
+			log.Error().Err(err).Str("channel_id", sibling.ID.String()).Msg("Failed to notify channel of sibling auto-disable")
+		}
+	}
 }
 
 // GetSupportedChannels returns the list of enabled channel types.
@@ -437,6 +754,63 @@ func (d *Dispatcher) TestChannel(ctx context.Context, channel *types.Channel) er
 	return d.Dispatch(ctx, channel, testChange)
 }
 
+// ErrPreviewNotSupported is returned by Preview for channel types that have
+// no distinct rendered form to inspect ahead of sending (e.g. push, SMS
+// providers driven entirely by opaque third-party templates).
+var ErrPreviewNotSupported = errors.New("preview not supported for this channel type")
+
+// Preview renders the message a channel would receive for a change without
+// sending it, so template authors and UI builders can iterate safely. The
+// returned value's concrete type depends on channel.Type: discord.DiscordPayload,
+// slack.SlackPayload, a webhook body/content-type pair, or an email preview.
+func (d *Dispatcher) Preview(channel *types.Channel, change *types.Change) (any, error) {
+	d.mu.RLock()
+	sender, ok := d.senders[channel.Type]
+	d.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no sender registered for channel type: %s", channel.Type)
+	}
+
+	switch s := sender.(type) {
+	case *discord.Sender:
+		return discord.RenderPayload(channel, change)
+	case *slack.Sender:
+		return s.RenderPayload(channel, change)
+	case *webhook.Sender:
+		body, contentType, payloadVersion, err := webhook.RenderBody(channel, change)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"content_type": contentType, "payload_version": payloadVersion, "body": string(body)}, nil
+	case *email.Sender:
+		subject, html, text, err := s.RenderPreview(channel, change)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"subject": subject, "html": html, "text": text}, nil
+	default:
+		return nil, ErrPreviewNotSupported
+	}
+}
+
+// VerifyUnsubscribeToken checks that token matches the signed value for
+// subscriptionID and emailAddr, as embedded in a notification email's
+// unsubscribe link. Returns false if no email sender is configured.
+func (d *Dispatcher) VerifyUnsubscribeToken(subscriptionID, emailAddr, token string) bool {
+	d.mu.RLock()
+	sender, ok := d.senders[types.ChannelEmail]
+	d.mu.RUnlock()
+	if !ok {
+		return false
+	}
+
+	s, ok := sender.(*email.Sender)
+	if !ok {
+		return false
+	}
+	return s.VerifyUnsubscribeToken(subscriptionID, emailAddr, token)
+}
+
 // DispatchBatchByChannel sends batched notifications for multiple changes to a single channel.
 // This is more efficient than individual dispatches for bulk operations.
 func (d *Dispatcher) DispatchBatchByChannel(ctx context.Context, channel *types.Channel, changes []types.Change) error {
@@ -458,6 +832,17 @@ func (d *Dispatcher) DispatchBatchByChannel(ctx context.Context, channel *types.
 		return ErrCircuitOpen
 	}
 
+	// Pre-create and save every notification record in one round trip, then
+	// dispatch each individually so a single slow/failing send doesn't hold
+	// up the rest of the batch's persistence.
+	notifications := make([]types.Notification, len(changes))
+	for i := range changes {
+		notifications[i] = *newPendingNotification(channel, &changes[i])
+	}
+	if err := d.database.SaveNotifications(ctx, notifications); err != nil {
+		log.Error().Err(err).Msg("Failed to save batch notification records")
+	}
+
 	var successCount, failCount int
 	var lastErr error
 
@@ -468,13 +853,15 @@ func (d *Dispatcher) DispatchBatchByChannel(ctx context.Context, channel *types.
 		default:
 		}
 
-		if err := d.Dispatch(ctx, channel, &changes[i]); err != nil {
+		if err := d.dispatchNotification(ctx, channel, &changes[i], &notifications[i]); err != nil {
 			failCount++
 			lastErr = err
-			cb.RecordFailure()
+			opened := cb.RecordFailure()
+			d.recordCircuitState(ctx, channel, cb.State(), opened)
 		} else {
 			successCount++
 			cb.RecordSuccess()
+			d.recordCircuitState(ctx, channel, cb.State(), false)
 		}
 	}
 
@@ -501,171 +888,93 @@ func (d *Dispatcher) getCircuitBreaker(channelID string) *CircuitBreaker {
 	return cb
 }
 
-// EnqueueRetry adds a failed notification to the retry queue.
-func (d *Dispatcher) EnqueueRetry(channelID, changeID string, err error) {
-	d.retryMu.Lock()
-	defer d.retryMu.Unlock()
-
-	// Calculate next retry time with exponential backoff
-	attempts := 1
-	for _, item := range d.retryQueue {
-		if item.ChannelID == channelID && item.ChangeID == changeID {
-			attempts = item.Attempts + 1
-			break
-		}
-	}
-
-	// Exponential backoff: 5s, 10s, 20s, 40s, 80s
+// retryBackoff computes the exponential backoff delay before the given
+// attempt number: 5s, 10s, 20s, 40s, 80s, capped at 1 hour.
+func (d *Dispatcher) retryBackoff(attempts int) time.Duration {
 	delay := d.baseRetryDelay * time.Duration(1<<uint(attempts-1))
 	if delay > time.Hour {
-		delay = time.Hour // Cap at 1 hour
-	}
-
-	item := RetryItem{
-		ChannelID:   channelID,
-		ChangeID:    changeID,
-		Attempts:    attempts,
-		NextRetry:   time.Now().Add(delay),
-		MaxAttempts: d.maxRetries,
-		LastError:   err.Error(),
+		delay = time.Hour
 	}
-
-	// Remove existing entry for same channel/change and add new one
-	newQueue := make([]RetryItem, 0, len(d.retryQueue)+1)
-	for _, existing := range d.retryQueue {
-		if existing.ChannelID != channelID || existing.ChangeID != changeID {
-			newQueue = append(newQueue, existing)
-		}
-	}
-	newQueue = append(newQueue, item)
-	d.retryQueue = newQueue
-
-	log.Debug().
-		Str("channel_id", channelID).
-		Str("change_id", changeID).
-		Int("attempts", attempts).
-		Time("next_retry", item.NextRetry).
-		Msg("Enqueued notification for retry")
+	return delay
 }
 
-// ProcessRetryQueue processes items in the retry queue that are ready for retry.
+// ProcessRetryQueue re-dispatches notifications that are due for retry.
+// Retry state lives entirely in the notifications table (status="pending"
+// with next_retry set by a failed dispatchNotification call), so the queue
+// survives process restarts instead of being lost with an in-memory queue.
 func (d *Dispatcher) ProcessRetryQueue(ctx context.Context) error {
-	d.retryMu.Lock()
-	now := time.Now()
-	var readyItems []RetryItem
-	var remainingItems []RetryItem
+	notifications, err := d.database.GetPendingNotifications(ctx, 100)
+	if err != nil {
+		return fmt.Errorf("failed to load pending notifications: %w", err)
+	}
 
-	for _, item := range d.retryQueue {
-		if now.After(item.NextRetry) {
-			readyItems = append(readyItems, item)
-		} else {
-			remainingItems = append(remainingItems, item)
-		}
+	if d.retryQueueSize != nil {
+		d.retryQueueSize.Record(ctx, int64(len(notifications)))
 	}
-	d.retryQueue = remainingItems
-	d.retryMu.Unlock()
 
-	if len(readyItems) == 0 {
+	if len(notifications) == 0 {
 		return nil
 	}
 
-	log.Info().Int("count", len(readyItems)).Msg("Processing retry queue")
+	log.Info().Int("count", len(notifications)).Msg("Processing retry queue")
 
-	var deadLetterItems []RetryItem
+	for i := range notifications {
+		notification := notifications[i]
 
-	for _, item := range readyItems {
 		select {
 		case <-ctx.Done():
-			// Re-add items that weren't processed
-			d.retryMu.Lock()
-			d.retryQueue = append(d.retryQueue, readyItems...)
-			d.retryMu.Unlock()
 			return ctx.Err()
 		default:
 		}
 
-		// Load channel and change from database
-		channelID, _ := parseUUID(item.ChannelID)
-		changeID, _ := parseUUID(item.ChangeID)
-
-		channel, err := d.database.GetChannelByID(ctx, channelID)
+		channel, err := d.database.GetChannelByID(ctx, notification.ChannelID)
 		if err != nil || channel == nil {
-			log.Error().Str("channel_id", item.ChannelID).Msg("Failed to load channel for retry")
+			log.Error().Str("channel_id", notification.ChannelID.String()).Msg("Failed to load channel for retry")
 			continue
 		}
 
-		change, err := d.database.GetChangeByID(ctx, changeID)
+		change, err := d.database.GetChangeByID(ctx, notification.ChangeID)
 		if err != nil || change == nil {
-			log.Error().Str("change_id", item.ChangeID).Msg("Failed to load change for retry")
+			log.Error().Str("change_id", notification.ChangeID.String()).Msg("Failed to load change for retry")
 			continue
 		}
 
-		// Attempt to send
-		err = d.Dispatch(ctx, channel, change)
-		if err != nil {
-			if item.Attempts >= item.MaxAttempts {
-				log.Error().
-					Str("channel_id", item.ChannelID).
-					Str("change_id", item.ChangeID).
-					Int("attempts", item.Attempts).
-					Err(err).
-					Msg("Maximum retries exceeded, moving to dead letter")
-				deadLetterItems = append(deadLetterItems, item)
-			} else {
-				// Re-enqueue with incremented attempts
-				d.EnqueueRetry(item.ChannelID, item.ChangeID, err)
-			}
+		if err := d.dispatchNotification(ctx, channel, change, &notification); err != nil {
+			log.Warn().
+				Err(err).
+				Str("notification_id", notification.ID.String()).
+				Int("attempts", notification.Attempts).
+				Msg("Retry attempt failed")
 		} else {
-			log.Info().
-				Str("channel_id", item.ChannelID).
-				Str("change_id", item.ChangeID).
-				Int("attempts", item.Attempts).
-				Msg("Retry successful")
-		}
-	}
-
-	// Save dead letter items (failed permanently)
-	for _, item := range deadLetterItems {
-		if err := d.saveToDeadLetter(ctx, item); err != nil {
-			log.Error().Err(err).Msg("Failed to save to dead letter queue")
+			log.Info().Str("notification_id", notification.ID.String()).Msg("Retry successful")
 		}
 	}
 
 	return nil
 }
 
-// saveToDeadLetter saves a permanently failed notification to the dead letter queue.
-func (d *Dispatcher) saveToDeadLetter(ctx context.Context, item RetryItem) error {
-	// Update notification status to failed permanently
-	channelID, _ := parseUUID(item.ChannelID)
-	changeID, _ := parseUUID(item.ChangeID)
-
-	notification := &types.Notification{
-		ChannelID: channelID,
-		ChangeID:  changeID,
-		Status:    "dead_letter",
-		Attempts:  item.Attempts,
-		Error:     item.LastError,
-	}
-
-	return d.database.UpdateNotification(ctx, notification)
-}
+// RunRetryProcessor runs ProcessRetryQueue on a ticker until ctx is canceled.
+func (d *Dispatcher) RunRetryProcessor(ctx context.Context, interval time.Duration) error {
+	log.Info().Dur("interval", interval).Msg("Starting notification retry processor")
 
-// GetRetryQueueSize returns the current size of the retry queue.
-func (d *Dispatcher) GetRetryQueueSize() int {
-	d.retryMu.Lock()
-	defer d.retryMu.Unlock()
-	return len(d.retryQueue)
-}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-// ClearRetryQueue clears the retry queue.
-func (d *Dispatcher) ClearRetryQueue() {
-	d.retryMu.Lock()
-	defer d.retryMu.Unlock()
-	d.retryQueue = make([]RetryItem, 0)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := d.ProcessRetryQueue(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to process retry queue")
+			}
+		}
+	}
 }
 
 // DispatchWithCircuitBreaker sends a notification with circuit breaker protection.
+// A failed attempt is left in the database in a retryable state by Dispatch
+// itself; ProcessRetryQueue is responsible for picking it back up.
 func (d *Dispatcher) DispatchWithCircuitBreaker(ctx context.Context, channel *types.Channel, change *types.Change) error {
 	cb := d.getCircuitBreaker(channel.ID.String())
 
@@ -678,13 +987,13 @@ func (d *Dispatcher) DispatchWithCircuitBreaker(ctx context.Context, channel *ty
 
 	err := d.Dispatch(ctx, channel, change)
 	if err != nil {
-		cb.RecordFailure()
-		// Enqueue for retry
-		d.EnqueueRetry(channel.ID.String(), change.ID.String(), err)
+		opened := cb.RecordFailure()
+		d.recordCircuitState(ctx, channel, cb.State(), opened)
 		return err
 	}
 
 	cb.RecordSuccess()
+	d.recordCircuitState(ctx, channel, cb.State(), false)
 	return nil
 }
 
@@ -714,11 +1023,6 @@ func (d *Dispatcher) ResetCircuitBreaker(channelID string) {
 	}
 }
 
-// parseUUID is a helper to parse UUIDs from strings.
-func parseUUID(s string) (uuid.UUID, error) {
-	return uuid.Parse(s)
-}
-
 // BatchConfig holds configuration for batch processing.
 type BatchConfig struct {
 	MaxBatchSize  int
@@ -728,11 +1032,11 @@ type BatchConfig struct {
 
 // NotificationBatcher collects notifications and dispatches them in batches.
 type NotificationBatcher struct {
-	dispatcher  *Dispatcher
-	config      BatchConfig
-	mu          sync.Mutex
-	batches     map[string][]*pendingNotification // channel_id -> notifications
-	flushTimer  *time.Timer
+	dispatcher *Dispatcher
+	config     BatchConfig
+	mu         sync.Mutex
+	batches    map[string][]*pendingNotification // channel_id -> notifications
+	flushTimer *time.Timer
 }
 
 type pendingNotification struct {
@@ -900,4 +1204,3 @@ func (nb *NotificationBatcher) FlushOld(ctx context.Context) {
 		}
 	}
 }
-
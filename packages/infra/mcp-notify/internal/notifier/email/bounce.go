@@ -0,0 +1,215 @@
+package email
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BounceEvent describes a single bounce (hard or soft, see Permanent) or
+// spam complaint reported by a provider's webhook, used to disable the
+// affected channel.
+type BounceEvent struct {
+	Email     string
+	Permanent bool // true for a hard bounce or complaint; false for a soft/transient bounce
+	Reason    string
+}
+
+// sesNotification is the outer SNS envelope AWS delivers to an HTTPS
+// subscription endpoint. SES event details are JSON-encoded within Message.
+type sesNotification struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+type sesEventMessage struct {
+	NotificationType string `json:"notificationType"`
+	Bounce           *struct {
+		BounceType        string `json:"bounceType"`
+		BouncedRecipients []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplainedRecipients []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// ParseSESNotification parses an SNS envelope delivered to the SES bounce
+// webhook. If the envelope is a pending subscription confirmation, isConfirm
+// is true and subscribeURL should be fetched with ConfirmSESSubscription to
+// complete it; otherwise events holds the reported bounces/complaints.
+func ParseSESNotification(body []byte) (events []BounceEvent, isConfirm bool, subscribeURL string, err error) {
+	var envelope sesNotification
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, false, "", fmt.Errorf("failed to parse SNS envelope: %w", err)
+	}
+
+	if envelope.Type == "SubscriptionConfirmation" {
+		return nil, true, envelope.SubscribeURL, nil
+	}
+
+	var msg sesEventMessage
+	if err := json.Unmarshal([]byte(envelope.Message), &msg); err != nil {
+		return nil, false, "", fmt.Errorf("failed to parse SES event message: %w", err)
+	}
+
+	switch msg.NotificationType {
+	case "Bounce":
+		if msg.Bounce == nil {
+			return nil, false, "", nil
+		}
+		permanent := msg.Bounce.BounceType == "Permanent"
+		for _, r := range msg.Bounce.BouncedRecipients {
+			events = append(events, BounceEvent{Email: r.EmailAddress, Permanent: permanent, Reason: "bounce: " + msg.Bounce.BounceType})
+		}
+	case "Complaint":
+		if msg.Complaint == nil {
+			return nil, false, "", nil
+		}
+		for _, r := range msg.Complaint.ComplainedRecipients {
+			events = append(events, BounceEvent{Email: r.EmailAddress, Permanent: true, Reason: "complaint"})
+		}
+	}
+
+	return events, false, "", nil
+}
+
+// ConfirmSESSubscription fetches subscribeURL to complete an SNS HTTPS
+// subscription, as AWS requires before it will deliver further
+// notifications to it.
+func ConfirmSESSubscription(ctx context.Context, subscribeURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subscribeURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create confirmation request: %w", err)
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to confirm SNS subscription: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status confirming SNS subscription: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// sendGridEvent is a single element of the JSON array SendGrid's Event
+// Webhook posts.
+type sendGridEvent struct {
+	Email  string `json:"email"`
+	Event  string `json:"event"`
+	Type   string `json:"type"` // bounce classification, e.g. "bounce" (hard) vs "blocked" (soft)
+	Reason string `json:"reason"`
+}
+
+// ParseSendGridEvents parses the JSON array posted by SendGrid's Event
+// Webhook into bounce/complaint events, ignoring every other event type
+// (delivered, opened, clicked, and so on).
+func ParseSendGridEvents(body []byte) ([]BounceEvent, error) {
+	var raw []sendGridEvent
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse SendGrid events: %w", err)
+	}
+
+	var events []BounceEvent
+	for _, e := range raw {
+		switch e.Event {
+		case "bounce":
+			events = append(events, BounceEvent{Email: e.Email, Permanent: e.Type != "blocked", Reason: e.Reason})
+		case "spamreport":
+			events = append(events, BounceEvent{Email: e.Email, Permanent: true, Reason: "spam report"})
+		}
+	}
+
+	return events, nil
+}
+
+// mailgunWebhook is the JSON body Mailgun's signed webhooks post.
+// See https://documentation.mailgun.com/docs/mailgun/user-manual/tracking-messages/#webhooks-1.
+type mailgunWebhook struct {
+	Signature struct {
+		Timestamp string `json:"timestamp"`
+		Token     string `json:"token"`
+		Signature string `json:"signature"`
+	} `json:"signature"`
+	EventData struct {
+		Event          string `json:"event"`
+		Severity       string `json:"severity"` // "permanent" or "temporary", for a "failed" event
+		Recipient      string `json:"recipient"`
+		DeliveryStatus struct {
+			Description string `json:"description"`
+		} `json:"delivery-status"`
+	} `json:"event-data"`
+}
+
+// MailgunSignature is the signature block of a Mailgun webhook payload.
+type MailgunSignature struct {
+	Timestamp string
+	Token     string
+	Signature string
+}
+
+// ParseMailgunSignature extracts the signature block from a Mailgun webhook
+// body, before the request is trusted enough to act on.
+func ParseMailgunSignature(body []byte) (MailgunSignature, error) {
+	var raw mailgunWebhook
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return MailgunSignature{}, fmt.Errorf("failed to parse Mailgun webhook: %w", err)
+	}
+
+	return MailgunSignature{
+		Timestamp: raw.Signature.Timestamp,
+		Token:     raw.Signature.Token,
+		Signature: raw.Signature.Signature,
+	}, nil
+}
+
+// VerifyMailgunSignature checks that sig matches the HMAC-SHA256 of
+// timestamp+token signed with signingKey, per Mailgun's webhook security
+// scheme.
+func VerifyMailgunSignature(sig MailgunSignature, signingKey string) bool {
+	if signingKey == "" || sig.Signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(sig.Timestamp + sig.Token))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(sig.Signature))
+}
+
+// ParseMailgunEvent parses the event-data block of a Mailgun webhook into a
+// BounceEvent. ok is false for anything other than a permanent failure or a
+// spam complaint (deliveries, opens, clicks, temporary failures, and so
+// on), which callers should accept with 200 OK but otherwise ignore.
+func ParseMailgunEvent(body []byte) (event BounceEvent, ok bool, err error) {
+	var raw mailgunWebhook
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return BounceEvent{}, false, fmt.Errorf("failed to parse Mailgun webhook: %w", err)
+	}
+
+	switch raw.EventData.Event {
+	case "failed":
+		if raw.EventData.Severity != "permanent" {
+			return BounceEvent{}, false, nil
+		}
+		return BounceEvent{Email: raw.EventData.Recipient, Permanent: true, Reason: raw.EventData.DeliveryStatus.Description}, true, nil
+	case "complained":
+		return BounceEvent{Email: raw.EventData.Recipient, Permanent: true, Reason: "complaint"}, true, nil
+	default:
+		return BounceEvent{}, false, nil
+	}
+}
@@ -0,0 +1,80 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// defaultMailgunBaseURL is Mailgun's US-region API base. EU-region domains
+// must use https://api.eu.mailgun.net/v3 instead.
+const defaultMailgunBaseURL = "https://api.mailgun.net/v3"
+
+// MailgunTransport delivers email via the Mailgun Messages API.
+// See https://documentation.mailgun.com/docs/mailgun/api-reference/openapi-final/tag/Messages/.
+type MailgunTransport struct {
+	httpClient *http.Client
+	apiKey     string
+	domain     string
+	baseURL    string
+}
+
+// NewMailgunTransport creates a new Mailgun transport. An empty baseURL
+// defaults to defaultMailgunBaseURL.
+func NewMailgunTransport(apiKey, domain, baseURL string) *MailgunTransport {
+	if baseURL == "" {
+		baseURL = defaultMailgunBaseURL
+	}
+
+	return &MailgunTransport{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		apiKey:     apiKey,
+		domain:     domain,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Send delivers msg via Mailgun.
+func (t *MailgunTransport) Send(ctx context.Context, msg Message) error {
+	if t.apiKey == "" {
+		return fmt.Errorf("Mailgun API key not configured")
+	}
+	if t.domain == "" {
+		return fmt.Errorf("Mailgun domain not configured")
+	}
+
+	form := url.Values{}
+	form.Set("from", msg.From)
+	form.Set("to", msg.To)
+	form.Set("subject", msg.Subject)
+	form.Set("text", msg.Text)
+	form.Set("html", msg.HTML)
+	for k, v := range msg.Headers {
+		form.Set("h:"+k, v)
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/messages", t.baseURL, t.domain)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("api", t.apiKey)
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
@@ -1,4 +1,6 @@
-// Package email provides email notification sending via SMTP.
+// Package email provides email notification sending via a pluggable
+// Transport: direct SMTP by default, or the SES, SendGrid, and Mailgun HTTP
+// APIs for deployments where outbound SMTP is blocked.
 package email
 
 import (
@@ -6,7 +8,6 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
-	"crypto/tls"
 	"encoding/base64"
 	"fmt"
 	"html/template"
@@ -14,19 +15,34 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
-	"gopkg.in/gomail.v2"
 
+	"github.com/nirholas/mcp-notify/internal/i18n"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
+// Message is a fully-rendered, transport-agnostic email ready to hand to a
+// Transport.
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+	Headers map[string]string
+}
+
+// Transport delivers a single Message. SMTPTransport is the built-in
+// default; SESTransport, SendGridTransport, and MailgunTransport call their
+// provider's HTTP API instead, so Sender itself never needs to know which
+// one is in use.
+type Transport interface {
+	Send(ctx context.Context, msg Message) error
+}
+
 // Config holds email sender configuration.
 type Config struct {
-	SMTPHost       string
-	SMTPPort       int
-	SMTPUsername   string
-	SMTPPassword   string
+	Transport      Transport
 	FromAddress    string
-	TLS            bool
 	RetryAttempts  int
 	RetryDelay     time.Duration
 	UnsubscribeURL string // Base URL for unsubscribe links
@@ -35,7 +51,7 @@ type Config struct {
 
 // Sender sends notifications via email.
 type Sender struct {
-	dialer         *gomail.Dialer
+	transport      Transport
 	fromAddress    string
 	retryAttempts  int
 	retryDelay     time.Duration
@@ -62,14 +78,12 @@ var templateFuncs = template.FuncMap{
 	},
 }
 
-// NewSender creates a new email sender.
+// NewSender creates a new email sender. cfg.Transport must be set; use
+// NewSMTPTransport, NewSESTransport, NewSendGridTransport, or
+// NewMailgunTransport to build one.
 func NewSender(cfg Config) (*Sender, error) {
-	dialer := gomail.NewDialer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword)
-	
-	if cfg.TLS {
-		dialer.TLSConfig = &tls.Config{
-			ServerName: cfg.SMTPHost,
-		}
+	if cfg.Transport == nil {
+		return nil, fmt.Errorf("email transport not configured")
 	}
 
 	// Parse email templates with custom functions
@@ -94,7 +108,7 @@ func NewSender(cfg Config) (*Sender, error) {
 	}
 
 	return &Sender{
-		dialer:         dialer,
+		transport:      cfg.Transport,
 		fromAddress:    cfg.FromAddress,
 		retryAttempts:  cfg.RetryAttempts,
 		retryDelay:     cfg.RetryDelay,
@@ -120,8 +134,8 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 	}
 
 	// Build email content
-	data := s.buildEmailData(change)
-	
+	data := s.buildEmailData(change, i18n.Locale(channel.Config.Locale))
+
 	// Add unsubscribe URL if configured
 	if s.unsubscribeURL != "" {
 		data.UnsubscribeURL = s.generateUnsubscribeLink(channel.SubscriptionID.String(), toAddress)
@@ -137,23 +151,27 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 		return fmt.Errorf("failed to render text template: %w", err)
 	}
 
-	// Create message with proper MIME headers
-	m := gomail.NewMessage()
-	m.SetHeader("From", s.fromAddress)
-	m.SetHeader("To", toAddress)
-	m.SetHeader("Subject", data.Subject)
-	m.SetHeader("X-Mailer", "MCP-Notify/1.0")
-	m.SetHeader("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
-	
+	headers := map[string]string{
+		"X-Mailer":              "MCP-Notify/1.0",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
 	if data.UnsubscribeURL != "" {
-		m.SetHeader("List-Unsubscribe", fmt.Sprintf("<%s>", data.UnsubscribeURL))
+		headers["List-Unsubscribe"] = fmt.Sprintf("<%s>", data.UnsubscribeURL)
 	}
-	
-	// Set both plain text and HTML parts (multipart/alternative)
-	m.SetBody("text/plain", textBody.String())
-	m.AddAlternative("text/html", htmlBody.String())
 
-	// Send with retries
+	return s.sendWithRetry(ctx, Message{
+		From:    s.fromAddress,
+		To:      toAddress,
+		Subject: data.Subject,
+		HTML:    htmlBody.String(),
+		Text:    textBody.String(),
+		Headers: headers,
+	}, "email notification")
+}
+
+// sendWithRetry hands msg to the configured transport, retrying on failure
+// per s.retryAttempts/retryDelay. label identifies the send in retry logs.
+func (s *Sender) sendWithRetry(ctx context.Context, msg Message, label string) error {
 	var lastErr error
 	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
 		if attempt > 0 {
@@ -162,10 +180,10 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 				return ctx.Err()
 			case <-time.After(s.retryDelay * time.Duration(attempt)):
 			}
-			log.Debug().Int("attempt", attempt).Msg("Retrying email notification")
+			log.Debug().Int("attempt", attempt).Str("kind", label).Msg("Retrying email send")
 		}
 
-		err := s.dialer.DialAndSend(m)
+		err := s.transport.Send(ctx, msg)
 		if err == nil {
 			return nil
 		}
@@ -175,13 +193,33 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 	return lastErr
 }
 
+// RenderPreview renders the subject, HTML body, and text body Send would
+// deliver for a change, without sending it, so previews and tests can
+// inspect the exact output. The unsubscribe link is omitted since there is
+// no real subscription/address to sign it against.
+func (s *Sender) RenderPreview(channel *types.Channel, change *types.Change) (subject, html, text string, err error) {
+	data := s.buildEmailData(change, i18n.Locale(channel.Config.Locale))
+
+	var htmlBody bytes.Buffer
+	if err := s.htmlTemplate.Execute(&htmlBody, data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render HTML template: %w", err)
+	}
+
+	var textBody bytes.Buffer
+	if err := s.textTemplate.Execute(&textBody, data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render text template: %w", err)
+	}
+
+	return data.Subject, htmlBody.String(), textBody.String(), nil
+}
+
 // SendDigest sends a digest email with multiple changes.
-func (s *Sender) SendDigest(ctx context.Context, toAddress string, changes []types.Change, frequency types.DigestFrequency) error {
+func (s *Sender) SendDigest(ctx context.Context, toAddress string, changes []types.Change, frequency types.DigestFrequency, locale i18n.Locale) error {
 	if len(changes) == 0 {
 		return nil
 	}
 
-	data := s.buildDigestData(changes, frequency)
+	data := s.buildDigestData(changes, frequency, locale)
 
 	var htmlBody bytes.Buffer
 	if err := s.digestHtmlTmpl.Execute(&htmlBody, data); err != nil {
@@ -193,34 +231,14 @@ func (s *Sender) SendDigest(ctx context.Context, toAddress string, changes []typ
 		return fmt.Errorf("failed to render text template: %w", err)
 	}
 
-	m := gomail.NewMessage()
-	m.SetHeader("From", s.fromAddress)
-	m.SetHeader("To", toAddress)
-	m.SetHeader("Subject", data.Subject)
-	m.SetHeader("X-Mailer", "MCP-Notify/1.0")
-	m.SetBody("text/plain", textBody.String())
-	m.AddAlternative("text/html", htmlBody.String())
-
-	// Send with retries
-	var lastErr error
-	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
-		if attempt > 0 {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(s.retryDelay * time.Duration(attempt)):
-			}
-			log.Debug().Int("attempt", attempt).Msg("Retrying digest email")
-		}
-
-		err := s.dialer.DialAndSend(m)
-		if err == nil {
-			return nil
-		}
-		lastErr = err
-	}
-
-	return lastErr
+	return s.sendWithRetry(ctx, Message{
+		From:    s.fromAddress,
+		To:      toAddress,
+		Subject: data.Subject,
+		HTML:    htmlBody.String(),
+		Text:    textBody.String(),
+		Headers: map[string]string{"X-Mailer": "MCP-Notify/1.0"},
+	}, "digest email")
 }
 
 // generateUnsubscribeLink generates a signed unsubscribe link.
@@ -234,6 +252,17 @@ func (s *Sender) generateUnsubscribeLink(subscriptionID, email string) string {
 	return fmt.Sprintf("%s?sub=%s&token=%s", s.unsubscribeURL, subscriptionID, token)
 }
 
+// VerifyUnsubscribeToken checks that token matches the signed value for
+// subscriptionID and email, as produced by generateUnsubscribeLink. Used by
+// the /unsubscribe endpoint to reject forged or tampered links.
+func (s *Sender) VerifyUnsubscribeToken(subscriptionID, email, token string) bool {
+	if token == "" {
+		return false
+	}
+	expected := s.signToken(subscriptionID, email)
+	return hmac.Equal([]byte(expected), []byte(token))
+}
+
 // signToken creates an HMAC signature for the unsubscribe token.
 func (s *Sender) signToken(subscriptionID, email string) string {
 	if s.secretKey == "" {
@@ -252,31 +281,33 @@ func (s *Sender) SendDigestToChannel(ctx context.Context, channel *types.Channel
 	if toAddress == "" {
 		return fmt.Errorf("email address not configured")
 	}
-	return s.SendDigest(ctx, toAddress, changes, frequency)
+	return s.SendDigest(ctx, toAddress, changes, frequency, i18n.Locale(channel.Config.Locale))
 }
 
 // EmailData holds data for email templates.
 type EmailData struct {
-	Subject       string
-	Title         string
-	Preheader     string
-	Changes       []ChangeData
-	TotalChanges  int
-	NewCount      int
-	UpdatedCount  int
-	RemovedCount  int
-	DigestPeriod  string
-	RegistryURL   string
+	Subject        string
+	Title          string
+	Preheader      string
+	Changes        []ChangeData
+	TotalChanges   int
+	NewCount       int
+	UpdatedCount   int
+	RemovedCount   int
+	DigestPeriod   string
+	RegistryURL    string
 	UnsubscribeURL string
-	Timestamp     string
+	Timestamp      string
 }
 
 // ChangeData holds data for a single change in email templates.
 type ChangeData struct {
 	ServerName      string
 	Description     string
+	Summary         string
 	ChangeType      string
 	ChangeTypeEmoji string
+	Severity        string
 	Version         string
 	PreviousVersion string
 	VersionChange   string
@@ -292,28 +323,33 @@ type PackageData struct {
 	URL  string
 }
 
-func (s *Sender) buildEmailData(change *types.Change) EmailData {
+func (s *Sender) buildEmailData(change *types.Change, locale i18n.Locale) EmailData {
 	var emoji, changeTypeText string
 	switch change.ChangeType {
 	case types.ChangeTypeNew:
 		emoji = "🆕"
-		changeTypeText = "New"
+		changeTypeText = i18n.T(locale, i18n.KeyChangeTypeNew)
 	case types.ChangeTypeUpdated:
 		emoji = "📝"
-		changeTypeText = "Updated"
+		changeTypeText = i18n.T(locale, i18n.KeyChangeTypeUpdated)
 	case types.ChangeTypeRemoved:
 		emoji = "🗑️"
-		changeTypeText = "Removed"
+		changeTypeText = i18n.T(locale, i18n.KeyChangeTypeRemoved)
+	case types.ChangeTypeSummary:
+		emoji = "📋"
+		changeTypeText = i18n.T(locale, i18n.KeySubscriptionSummaryTitle)
 	}
 
 	changeData := ChangeData{
 		ServerName:      change.ServerName,
+		Summary:         change.AISummary,
 		ChangeType:      changeTypeText,
 		ChangeTypeEmoji: emoji,
+		Severity:        string(change.Severity),
 		Version:         change.NewVersion,
 		PreviousVersion: change.PreviousVersion,
 		RegistryURL:     fmt.Sprintf("https://registry.modelcontextprotocol.io/servers/%s", change.ServerName),
-		DetectedAt:      change.DetectedAt.Format("Jan 2, 2006 at 3:04 PM UTC"),
+		DetectedAt:      i18n.FormatTime(locale, change.DetectedAt),
 	}
 
 	if change.PreviousVersion != "" && change.NewVersion != "" {
@@ -340,11 +376,11 @@ func (s *Sender) buildEmailData(change *types.Change) EmailData {
 		Changes:      []ChangeData{changeData},
 		TotalChanges: 1,
 		RegistryURL:  "https://registry.modelcontextprotocol.io",
-		Timestamp:    time.Now().Format("Jan 2, 2006 at 3:04 PM UTC"),
+		Timestamp:    i18n.FormatTime(locale, time.Now()),
 	}
 }
 
-func (s *Sender) buildDigestData(changes []types.Change, frequency types.DigestFrequency) EmailData {
+func (s *Sender) buildDigestData(changes []types.Change, frequency types.DigestFrequency, locale i18n.Locale) EmailData {
 	var newCount, updatedCount, removedCount int
 	var changeDataList []ChangeData
 
@@ -360,7 +396,9 @@ func (s *Sender) buildDigestData(changes []types.Change, frequency types.DigestF
 
 		changeData := ChangeData{
 			ServerName: change.ServerName,
+			Summary:    change.AISummary,
 			ChangeType: string(change.ChangeType),
+			Severity:   string(change.Severity),
 		}
 
 		if change.Server != nil {
@@ -391,7 +429,7 @@ func (s *Sender) buildDigestData(changes []types.Change, frequency types.DigestF
 		RemovedCount: removedCount,
 		DigestPeriod: period,
 		RegistryURL:  "https://registry.modelcontextprotocol.io",
-		Timestamp:    time.Now().Format("Jan 2, 2006 at 3:04 PM UTC"),
+		Timestamp:    i18n.FormatTime(locale, time.Now()),
 	}
 }
 
@@ -430,7 +468,9 @@ const htmlEmailTemplate = `<!DOCTYPE html>
       {{range .Changes}}
       <div style="margin-bottom: 24px;">
         <span class="change-type change-type-{{.ChangeType | lower}}">{{.ChangeTypeEmoji}} {{.ChangeType}}</span>
+        {{if .Severity}}<span class="severity severity-{{.Severity}}">{{.Severity}}</span>{{end}}
         <div class="server-name">{{.ServerName}}</div>
+        {{if .Summary}}<p class="description">{{.Summary}}</p>{{end}}
         {{if .Description}}<p class="description">{{.Description}}</p>{{end}}
         {{if .VersionChange}}<p>Version: <span class="version">{{.VersionChange}}</span></p>{{end}}
         {{if .Version}}<p>Version: <span class="version">{{.Version}}</span></p>{{end}}
@@ -450,7 +490,8 @@ const htmlEmailTemplate = `<!DOCTYPE html>
 const textEmailTemplate = `{{.Title}}
 
 {{range .Changes}}
-{{.ChangeTypeEmoji}} {{.ChangeType}}: {{.ServerName}}
+{{.ChangeTypeEmoji}} {{.ChangeType}}: {{.ServerName}}{{if .Severity}} [{{.Severity}}]{{end}}
+{{if .Summary}}{{.Summary}}{{end}}
 {{if .Description}}{{.Description}}{{end}}
 {{if .VersionChange}}Version: {{.VersionChange}}{{end}}
 {{if .Version}}Version: {{.Version}}{{end}}
@@ -529,6 +570,7 @@ const digestHtmlEmailTemplate = `<!DOCTYPE html>
       {{range .Changes}}
       <div class="change-item">
         <span class="change-type change-type-{{.ChangeType | lower}}">{{.ChangeType}}</span>
+        {{if .Severity}}<span class="severity severity-{{.Severity}}">{{.Severity}}</span>{{end}}
         <span class="server-name">{{.ServerName}}</span>
         {{if .Description}}<p style="margin: 4px 0 0; font-size: 13px; color: #666;">{{.Description | truncate 100}}</p>{{end}}
       </div>
@@ -556,7 +598,7 @@ Summary:
 
 Changes:
 {{range .Changes}}
-• {{.ChangeType}}: {{.ServerName}}
+• {{.ChangeType}}: {{.ServerName}}{{if .Severity}} [{{.Severity}}]{{end}}
 {{end}}
 
 View all changes: {{.RegistryURL}}
@@ -0,0 +1,159 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+// SESConfig holds Amazon SES transport configuration. Credentials are
+// resolved from the environment via the AWS SDK's default credential chain,
+// same as the sns and eventbridge senders.
+type SESConfig struct {
+	// Region overrides the region resolved from the default credential
+	// chain, if set.
+	Region string
+}
+
+// SESTransport delivers email via the SES v2 SendEmail HTTP API, signed
+// with SigV4. There is no vendored SES SDK client in this module, so
+// requests are built and signed by hand with the AWS SDK's core signer
+// package instead.
+type SESTransport struct {
+	httpClient *http.Client
+	awsCfg     aws.Config
+	region     string
+}
+
+// NewSESTransport creates a new SES transport, resolving AWS credentials
+// from the environment.
+func NewSESTransport(ctx context.Context, cfg SESConfig) (*SESTransport, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	if cfg.Region != "" {
+		opts = append(opts, awsconfig.WithRegion(cfg.Region))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	if awsCfg.Region == "" {
+		return nil, fmt.Errorf("SES region not configured")
+	}
+
+	return &SESTransport{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		awsCfg:     awsCfg,
+		region:     awsCfg.Region,
+	}, nil
+}
+
+// sesSendEmailRequest is the body of a v2 SendEmail request.
+// See https://docs.aws.amazon.com/ses/latest/APIReference-V2/API_SendEmail.html.
+type sesSendEmailRequest struct {
+	FromEmailAddress string          `json:"FromEmailAddress"`
+	Destination      sesDestination  `json:"Destination"`
+	Content          sesEmailContent `json:"Content"`
+}
+
+type sesDestination struct {
+	ToAddresses []string `json:"ToAddresses"`
+}
+
+type sesEmailContent struct {
+	Simple sesSimpleContent `json:"Simple"`
+}
+
+type sesSimpleContent struct {
+	Subject sesContentPart `json:"Subject"`
+	Body    sesBody        `json:"Body"`
+	Headers []sesHeader    `json:"Headers,omitempty"`
+}
+
+type sesBody struct {
+	Html sesContentPart `json:"Html,omitempty"`
+	Text sesContentPart `json:"Text,omitempty"`
+}
+
+type sesContentPart struct {
+	Data string `json:"Data"`
+}
+
+type sesHeader struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// Send delivers msg via SES's SendEmail API, signing the request with SigV4.
+func (t *SESTransport) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(sesSendEmailRequest{
+		FromEmailAddress: msg.From,
+		Destination:      sesDestination{ToAddresses: []string{msg.To}},
+		Content: sesEmailContent{
+			Simple: sesSimpleContent{
+				Subject: sesContentPart{Data: msg.Subject},
+				Body: sesBody{
+					Html: sesContentPart{Data: msg.HTML},
+					Text: sesContentPart{Data: msg.Text},
+				},
+				Headers: sesHeadersFrom(msg.Headers),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal SES request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://email.%s.amazonaws.com/v2/email/outbound-emails", t.region)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create SES request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	creds, err := t.awsCfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to resolve AWS credentials: %w", err)
+	}
+
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, payloadHash, "ses", t.region, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign SES request: %w", err)
+	}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("SES request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("SES returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+func sesHeadersFrom(headers map[string]string) []sesHeader {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	out := make([]sesHeader, 0, len(headers))
+	for k, v := range headers {
+		out = append(out, sesHeader{Name: k, Value: v})
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+
+	"gopkg.in/gomail.v2"
+)
+
+// SMTPConfig holds SMTP transport configuration.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLS      bool
+}
+
+// SMTPTransport delivers email via a direct SMTP connection. This is the
+// default transport when EmailConfig.Provider is unset.
+type SMTPTransport struct {
+	dialer *gomail.Dialer
+}
+
+// NewSMTPTransport creates a new SMTP transport.
+func NewSMTPTransport(cfg SMTPConfig) *SMTPTransport {
+	dialer := gomail.NewDialer(cfg.Host, cfg.Port, cfg.Username, cfg.Password)
+	if cfg.TLS {
+		dialer.TLSConfig = &tls.Config{
+			ServerName: cfg.Host,
+		}
+	}
+
+	return &SMTPTransport{dialer: dialer}
+}
+
+// Send delivers msg over SMTP.
+func (t *SMTPTransport) Send(_ context.Context, msg Message) error {
+	m := gomail.NewMessage()
+	m.SetHeader("From", msg.From)
+	m.SetHeader("To", msg.To)
+	m.SetHeader("Subject", msg.Subject)
+	for k, v := range msg.Headers {
+		m.SetHeader(k, v)
+	}
+	m.SetBody("text/plain", msg.Text)
+	m.AddAlternative("text/html", msg.HTML)
+
+	return t.dialer.DialAndSend(m)
+}
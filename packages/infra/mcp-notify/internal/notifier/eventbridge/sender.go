@@ -0,0 +1,149 @@
+// Package eventbridge publishes registry changes to an AWS EventBridge bus,
+// so AWS-native teams can route changes to their own rules and targets
+// without a custom webhook receiver.
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	mcptypes "github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// defaultSource is the EventBridge "Source" field used when a channel
+// doesn't override it.
+const defaultSource = "mcp-notify"
+
+// Config holds EventBridge sender configuration. Credentials are resolved
+// from the environment via the AWS SDK's default credential chain, not
+// read from this Config.
+type Config struct {
+	// Region overrides the region resolved from the default credential
+	// chain, if set.
+	Region        string
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender publishes notifications to an AWS EventBridge bus.
+type Sender struct {
+	client        *eventbridge.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new EventBridge sender, resolving AWS credentials
+// from the environment.
+func NewSender(ctx context.Context, cfg Config) (*Sender, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	if cfg.Region != "" {
+		opts = append(opts, awsconfig.WithRegion(cfg.Region))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &Sender{
+		client:        eventbridge.NewFromConfig(awsCfg),
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}, nil
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() mcptypes.ChannelType {
+	return mcptypes.ChannelEventBridge
+}
+
+// Send publishes a notification as an event on the channel's configured
+// EventBridge bus.
+func (s *Sender) Send(ctx context.Context, channel *mcptypes.Channel, change *mcptypes.Change) error {
+	busName := channel.Config.EventBridgeBusName
+	if busName == "" {
+		return fmt.Errorf("EventBridge bus name not configured")
+	}
+
+	source := channel.Config.EventBridgeSource
+	if source == "" {
+		source = defaultSource
+	}
+
+	detail, err := json.Marshal(buildDetail(change))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event detail: %w", err)
+	}
+	detailType := fmt.Sprintf("mcp-notify.%s", change.ChangeType)
+	detailStr := string(detail)
+
+	entry := types.PutEventsRequestEntry{
+		EventBusName: &busName,
+		Source:       &source,
+		DetailType:   &detailType,
+		Detail:       &detailStr,
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+		}
+
+		out, err := s.client.PutEvents(ctx, &eventbridge.PutEventsInput{
+			Entries: []types.PutEventsRequestEntry{entry},
+		})
+		if err != nil {
+			lastErr = fmt.Errorf("failed to put event on bus %s: %w", busName, err)
+			continue
+		}
+		if out.FailedEntryCount > 0 && len(out.Entries) > 0 {
+			lastErr = fmt.Errorf("failed to put event on bus %s: %s", busName, awsErrorMessage(out.Entries[0]))
+			continue
+		}
+		return nil
+	}
+
+	return lastErr
+}
+
+func awsErrorMessage(entry types.PutEventsResultEntry) string {
+	if entry.ErrorMessage != nil {
+		return *entry.ErrorMessage
+	}
+	if entry.ErrorCode != nil {
+		return *entry.ErrorCode
+	}
+	return "unknown error"
+}
+
+// detail is the JSON "Detail" body of the EventBridge event.
+type detail struct {
+	EventID         string           `json:"event_id"`
+	ServerName      string           `json:"server_name"`
+	Timestamp       time.Time        `json:"timestamp"`
+	PreviousVersion string           `json:"previous_version,omitempty"`
+	NewVersion      string           `json:"new_version,omitempty"`
+	Server          *mcptypes.Server `json:"server,omitempty"`
+}
+
+func buildDetail(change *mcptypes.Change) detail {
+	return detail{
+		EventID:         change.ID.String(),
+		ServerName:      change.ServerName,
+		Timestamp:       change.DetectedAt,
+		PreviousVersion: change.PreviousVersion,
+		NewVersion:      change.NewVersion,
+		Server:          change.Server,
+	}
+}
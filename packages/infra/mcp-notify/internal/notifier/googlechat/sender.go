@@ -0,0 +1,247 @@
+// Package googlechat provides Google Chat incoming-webhook notification
+// sending using the Cards v2 message format.
+package googlechat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds Google Chat sender configuration.
+type Config struct {
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender sends notifications via Google Chat incoming webhooks.
+type Sender struct {
+	httpClient    *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new Google Chat sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelGoogleChat
+}
+
+// Send sends a notification via Google Chat webhook.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	webhookURL := channel.Config.GoogleChatWebhookURL
+	if webhookURL == "" {
+		return fmt.Errorf("google chat webhook URL not configured")
+	}
+
+	message := s.buildMessage(change)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying Google Chat notification")
+		}
+
+		err := s.sendRequest(ctx, webhookURL, message)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+func (s *Sender) sendRequest(ctx context.Context, webhookURL string, message CardsV2Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// buildMessage builds a Google Chat Cards v2 message.
+// See https://developers.google.com/workspace/chat/api/reference/rest/v1/cards
+func (s *Sender) buildMessage(change *types.Change) CardsV2Message {
+	var title, subtitle string
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		title = "🆕 New MCP Server"
+	case types.ChangeTypeUpdated:
+		title = "📝 Server Updated"
+	case types.ChangeTypeRemoved:
+		title = "🗑️ Server Removed"
+	case types.ChangeTypeSummary:
+		title = "📋 Subscription Summary"
+	}
+	subtitle = change.ServerName
+
+	var widgets []Widget
+	if change.Server != nil && change.Server.Description != "" {
+		widgets = append(widgets, Widget{
+			TextParagraph: &TextParagraph{Text: change.Server.Description},
+		})
+	}
+
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		widgets = append(widgets, Widget{
+			DecoratedText: &DecoratedText{
+				TopLabel: "Version",
+				Text:     fmt.Sprintf("%s → %s", change.PreviousVersion, change.NewVersion),
+			},
+		})
+	} else if change.NewVersion != "" {
+		widgets = append(widgets, Widget{
+			DecoratedText: &DecoratedText{
+				TopLabel: "Version",
+				Text:     change.NewVersion,
+			},
+		})
+	}
+
+	widgets = append(widgets, Widget{
+		DecoratedText: &DecoratedText{
+			TopLabel: "Detected At",
+			Text:     change.DetectedAt.Format("Jan 2, 2006 3:04 PM UTC"),
+		},
+	})
+
+	registryURL := fmt.Sprintf("https://registry.modelcontextprotocol.io/servers/%s", change.ServerName)
+	widgets = append(widgets, Widget{
+		ButtonList: &ButtonList{
+			Buttons: []Button{
+				{
+					Text: "View in Registry",
+					OnClick: OnClick{
+						OpenLink: OpenLink{URL: registryURL},
+					},
+				},
+			},
+		},
+	})
+
+	return CardsV2Message{
+		CardsV2: []CardsV2{
+			{
+				CardID: "mcp-notify-" + change.ID.String(),
+				Card: Card{
+					Header: &CardHeader{
+						Title:    title,
+						Subtitle: subtitle,
+					},
+					Sections: []Section{
+						{Widgets: widgets},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Google Chat Cards v2 message types.
+
+// CardsV2Message is the top-level webhook payload.
+type CardsV2Message struct {
+	CardsV2 []CardsV2 `json:"cardsV2"`
+}
+
+// CardsV2 wraps a single card.
+type CardsV2 struct {
+	CardID string `json:"cardId"`
+	Card   Card   `json:"card"`
+}
+
+// Card is a Google Chat card.
+type Card struct {
+	Header   *CardHeader `json:"header,omitempty"`
+	Sections []Section   `json:"sections"`
+}
+
+// CardHeader is the card's title area.
+type CardHeader struct {
+	Title    string `json:"title,omitempty"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+// Section groups widgets within a card.
+type Section struct {
+	Widgets []Widget `json:"widgets"`
+}
+
+// Widget is a single card element. Exactly one field should be set.
+type Widget struct {
+	TextParagraph *TextParagraph `json:"textParagraph,omitempty"`
+	DecoratedText *DecoratedText `json:"decoratedText,omitempty"`
+	ButtonList    *ButtonList    `json:"buttonList,omitempty"`
+}
+
+// TextParagraph is a block of plain/simple-HTML text.
+type TextParagraph struct {
+	Text string `json:"text"`
+}
+
+// DecoratedText is a labeled key-value row.
+type DecoratedText struct {
+	TopLabel string `json:"topLabel,omitempty"`
+	Text     string `json:"text"`
+}
+
+// ButtonList is a row of buttons.
+type ButtonList struct {
+	Buttons []Button `json:"buttons"`
+}
+
+// Button is a single clickable button.
+type Button struct {
+	Text    string  `json:"text"`
+	OnClick OnClick `json:"onClick"`
+}
+
+// OnClick describes a button's action.
+type OnClick struct {
+	OpenLink OpenLink `json:"openLink"`
+}
+
+// OpenLink opens a URL when a button is clicked.
+type OpenLink struct {
+	URL string `json:"url"`
+}
@@ -0,0 +1,182 @@
+// Package mattermost provides Mattermost incoming-webhook notification sending.
+package mattermost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds Mattermost sender configuration.
+type Config struct {
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender sends notifications via Mattermost incoming webhooks.
+type Sender struct {
+	httpClient    *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new Mattermost sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelMattermost
+}
+
+// Send sends a notification via Mattermost incoming webhook.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	webhookURL := channel.Config.MattermostWebhookURL
+	if webhookURL == "" {
+		return fmt.Errorf("mattermost webhook URL not configured")
+	}
+
+	payload := s.buildPayload(change, channel.Config.MattermostChannel)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying Mattermost notification")
+		}
+
+		err := s.sendRequest(ctx, webhookURL, payload)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+func (s *Sender) sendRequest(ctx context.Context, webhookURL string, payload MattermostPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// buildPayload builds a Mattermost incoming-webhook payload using its
+// message attachments format (a subset of Slack's legacy attachments, not
+// Slack's newer block kit, which Mattermost does not render).
+func (s *Sender) buildPayload(change *types.Change, channelOverride string) MattermostPayload {
+	var color, title string
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		color = "#43B581"
+		title = "🆕 New MCP Server"
+	case types.ChangeTypeUpdated:
+		color = "#5865F2"
+		title = "📝 Server Updated"
+	case types.ChangeTypeRemoved:
+		color = "#ED4245"
+		title = "🗑️ Server Removed"
+	case types.ChangeTypeSummary:
+		color = "#FAA61A"
+		title = "📋 Subscription Summary"
+	}
+
+	text := change.ServerName
+	if change.Server != nil && change.Server.Description != "" {
+		text = fmt.Sprintf("%s\n%s", text, change.Server.Description)
+	}
+
+	var fields []MattermostField
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		fields = append(fields, MattermostField{
+			Title: "Version",
+			Value: fmt.Sprintf("`%s` → `%s`", change.PreviousVersion, change.NewVersion),
+			Short: true,
+		})
+	} else if change.NewVersion != "" {
+		fields = append(fields, MattermostField{
+			Title: "Version",
+			Value: fmt.Sprintf("`%s`", change.NewVersion),
+			Short: true,
+		})
+	}
+
+	return MattermostPayload{
+		Channel: channelOverride,
+		Attachments: []MattermostAttachment{
+			{
+				Fallback:  fmt.Sprintf("%s: %s", title, change.ServerName),
+				Color:     color,
+				Title:     title,
+				Text:      text,
+				Fields:    fields,
+				Footer:    "MCP Notify",
+				Timestamp: change.DetectedAt.Unix(),
+			},
+		},
+	}
+}
+
+// Mattermost payload types (incoming webhook message attachments format).
+// See https://developers.mattermost.com/integrate/webhooks/incoming/
+
+type MattermostPayload struct {
+	Channel     string                 `json:"channel,omitempty"`
+	Username    string                 `json:"username,omitempty"`
+	IconURL     string                 `json:"icon_url,omitempty"`
+	Text        string                 `json:"text,omitempty"`
+	Attachments []MattermostAttachment `json:"attachments,omitempty"`
+}
+
+type MattermostAttachment struct {
+	Fallback  string            `json:"fallback,omitempty"`
+	Color     string            `json:"color,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Text      string            `json:"text,omitempty"`
+	Fields    []MattermostField `json:"fields,omitempty"`
+	Footer    string            `json:"footer,omitempty"`
+	Timestamp int64             `json:"ts,omitempty"`
+}
+
+type MattermostField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short,omitempty"`
+}
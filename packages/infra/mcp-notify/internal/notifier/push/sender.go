@@ -0,0 +1,199 @@
+// Package push provides notification sending to self-hosted push gateways
+// (ntfy.sh and Gotify), so self-hosters can get phone notifications without
+// running a chat platform.
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+const (
+	providerNtfy   = "ntfy"
+	providerGotify = "gotify"
+)
+
+// Config holds push sender configuration.
+type Config struct {
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender sends notifications via ntfy.sh or Gotify, selected per channel by
+// ChannelConfig.PushProvider.
+type Sender struct {
+	httpClient    *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new push sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelPush
+}
+
+// Send sends a notification via ntfy.sh or Gotify, depending on the
+// channel's configured provider.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	if channel.Config.PushURL == "" {
+		return fmt.Errorf("push URL not configured")
+	}
+
+	var send func(ctx context.Context) error
+	switch channel.Config.PushProvider {
+	case providerGotify, "":
+		send = func(ctx context.Context) error { return s.sendGotify(ctx, channel.Config, change) }
+	case providerNtfy:
+		send = func(ctx context.Context) error { return s.sendNtfy(ctx, channel.Config, change) }
+	default:
+		return fmt.Errorf("unknown push provider %q", channel.Config.PushProvider)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying push notification")
+		}
+
+		err := send(ctx)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+// sendNtfy publishes to an ntfy topic. cfg.PushURL is the full topic URL
+// (e.g. https://ntfy.sh/my-topic); the message is the request body per
+// https://docs.ntfy.sh/publish/.
+func (s *Sender) sendNtfy(ctx context.Context, cfg types.ChannelConfig, change *types.Change) error {
+	title, body := renderMessage(change)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.PushURL, bytes.NewReader([]byte(body)))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Title", title)
+	req.Header.Set("Tags", ntfyTag(change.ChangeType))
+	if cfg.PushToken != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.PushToken)
+	}
+
+	return s.do(req)
+}
+
+// sendGotify pushes a message to a Gotify server. cfg.PushURL is the
+// server's base URL and cfg.PushToken is an application token, combined per
+// https://gotify.net/api-docs#/message/createMessage.
+func (s *Sender) sendGotify(ctx context.Context, cfg types.ChannelConfig, change *types.Change) error {
+	if cfg.PushToken == "" {
+		return fmt.Errorf("gotify application token not configured")
+	}
+
+	title, message := renderMessage(change)
+	body, err := json.Marshal(gotifyMessage{
+		Title:    title,
+		Message:  message,
+		Priority: 5,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/message?token=%s", cfg.PushURL, cfg.PushToken), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return s.do(req)
+}
+
+func (s *Sender) do(req *http.Request) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// renderMessage builds a provider-agnostic title and body for a change.
+func renderMessage(change *types.Change) (title, body string) {
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		title = "New MCP Server"
+	case types.ChangeTypeUpdated:
+		title = "Server Updated"
+	case types.ChangeTypeRemoved:
+		title = "Server Removed"
+	case types.ChangeTypeSummary:
+		title = "Subscription Summary"
+	}
+
+	body = change.ServerName
+	if change.Server != nil && change.Server.Description != "" {
+		body = fmt.Sprintf("%s - %s", body, change.Server.Description)
+	}
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		body = fmt.Sprintf("%s (%s -> %s)", body, change.PreviousVersion, change.NewVersion)
+	}
+
+	return title, body
+}
+
+// ntfyTag maps a change type to an ntfy emoji tag.
+// See https://docs.ntfy.sh/publish/#tags-emojis.
+func ntfyTag(changeType types.ChangeType) string {
+	switch changeType {
+	case types.ChangeTypeNew:
+		return "sparkles"
+	case types.ChangeTypeUpdated:
+		return "memo"
+	case types.ChangeTypeRemoved:
+		return "wastebasket"
+	case types.ChangeTypeSummary:
+		return "clipboard"
+	default:
+		return ""
+	}
+}
+
+// gotifyMessage is the Gotify message-creation request body.
+type gotifyMessage struct {
+	Title    string `json:"title,omitempty"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority,omitempty"`
+}
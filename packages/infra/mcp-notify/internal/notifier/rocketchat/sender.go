@@ -0,0 +1,186 @@
+// Package rocketchat provides Rocket.Chat incoming-webhook notification sending.
+package rocketchat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds Rocket.Chat sender configuration.
+type Config struct {
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender sends notifications via Rocket.Chat incoming webhooks.
+type Sender struct {
+	httpClient    *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new Rocket.Chat sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelRocketChat
+}
+
+// Send sends a notification via Rocket.Chat incoming webhook.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	webhookURL := channel.Config.RocketChatWebhookURL
+	if webhookURL == "" {
+		return fmt.Errorf("rocketchat webhook URL not configured")
+	}
+
+	payload := s.buildPayload(change, channel.Config.RocketChatChannel)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying Rocket.Chat notification")
+		}
+
+		err := s.sendRequest(ctx, webhookURL, payload)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+func (s *Sender) sendRequest(ctx context.Context, webhookURL string, payload RocketChatPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// buildPayload builds a Rocket.Chat incoming-webhook payload. Rocket.Chat's
+// attachment fields are similar to Slack's legacy attachments but use
+// title_link instead of an actions block, and have no support for Slack's
+// block kit.
+func (s *Sender) buildPayload(change *types.Change, channelOverride string) RocketChatPayload {
+	var color, title string
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		color = "#43B581"
+		title = "🆕 New MCP Server"
+	case types.ChangeTypeUpdated:
+		color = "#5865F2"
+		title = "📝 Server Updated"
+	case types.ChangeTypeRemoved:
+		color = "#ED4245"
+		title = "🗑️ Server Removed"
+	case types.ChangeTypeSummary:
+		color = "#FAA61A"
+		title = "📋 Subscription Summary"
+	}
+
+	text := change.ServerName
+	if change.Server != nil && change.Server.Description != "" {
+		text = fmt.Sprintf("%s\n%s", text, change.Server.Description)
+	}
+
+	var fields []RocketChatField
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		fields = append(fields, RocketChatField{
+			Title: "Version",
+			Value: fmt.Sprintf("`%s` → `%s`", change.PreviousVersion, change.NewVersion),
+			Short: true,
+		})
+	} else if change.NewVersion != "" {
+		fields = append(fields, RocketChatField{
+			Title: "Version",
+			Value: fmt.Sprintf("`%s`", change.NewVersion),
+			Short: true,
+		})
+	}
+
+	var titleLink string
+	if change.Server != nil && change.Server.Repository != nil {
+		titleLink = change.Server.Repository.URL
+	}
+
+	return RocketChatPayload{
+		Channel: channelOverride,
+		Attachments: []RocketChatAttachment{
+			{
+				Color:     color,
+				Title:     title,
+				TitleLink: titleLink,
+				Text:      text,
+				Fields:    fields,
+				Ts:        change.DetectedAt.Format(time.RFC3339),
+			},
+		},
+	}
+}
+
+// Rocket.Chat payload types (incoming webhook attachments format).
+// See https://docs.rocket.chat/use-rocket.chat/workspace-administration/integrations#incoming-webhook-script
+
+type RocketChatPayload struct {
+	Channel     string                 `json:"channel,omitempty"`
+	Alias       string                 `json:"alias,omitempty"`
+	Avatar      string                 `json:"avatar,omitempty"`
+	Text        string                 `json:"text,omitempty"`
+	Attachments []RocketChatAttachment `json:"attachments,omitempty"`
+}
+
+type RocketChatAttachment struct {
+	Color     string            `json:"color,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	TitleLink string            `json:"title_link,omitempty"`
+	Text      string            `json:"text,omitempty"`
+	Fields    []RocketChatField `json:"fields,omitempty"`
+	Ts        string            `json:"ts,omitempty"`
+}
+
+type RocketChatField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short,omitempty"`
+}
@@ -0,0 +1,126 @@
+package notifier
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// samplingState tracks per-channel sampling progress.
+type samplingState struct {
+	// every_n
+	counter int
+
+	// top_k_hourly
+	windowStart time.Time
+	window      []float64
+}
+
+// Sampler throttles notification volume for channels configured with a
+// sampling mode, so firehose subscriptions can be piped into chat channels
+// without flooding them. Channels without sampling configured always allow.
+type Sampler struct {
+	mu     sync.Mutex
+	states map[uuid.UUID]*samplingState
+}
+
+// NewSampler creates a new Sampler.
+func NewSampler() *Sampler {
+	return &Sampler{
+		states: make(map[uuid.UUID]*samplingState),
+	}
+}
+
+// Allow reports whether change should be delivered to channel given its
+// configured sampling mode.
+func (s *Sampler) Allow(channel *types.Channel, change *types.Change) bool {
+	switch channel.Config.SamplingMode {
+	case types.SamplingEveryN:
+		return s.allowEveryN(channel.ID, channel.Config.SamplingRate)
+	case types.SamplingTopKHourly:
+		return s.allowTopK(channel.ID, channel.Config.SamplingTopK, change)
+	default:
+		return true
+	}
+}
+
+func (s *Sampler) allowEveryN(channelID uuid.UUID, n int) bool {
+	if n <= 1 {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stateFor(channelID)
+	st.counter++
+	return st.counter%n == 0
+}
+
+// allowTopK approximates top-K-by-relevance-per-hour in a single streaming
+// pass: within each rolling hour it keeps the K highest relevance scores
+// admitted so far and only lets a new change through while it outranks the
+// current lowest. Because a notification can't be recalled once sent, this
+// is a greedy approximation rather than a retroactive re-ranking of the hour.
+func (s *Sampler) allowTopK(channelID uuid.UUID, k int, change *types.Change) bool {
+	if k <= 0 {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stateFor(channelID)
+
+	now := time.Now()
+	if now.Sub(st.windowStart) >= time.Hour {
+		st.windowStart = now
+		st.window = st.window[:0]
+	}
+
+	score := relevanceScore(change)
+
+	if len(st.window) < k {
+		st.window = append(st.window, score)
+		return true
+	}
+
+	minIdx := 0
+	for i, existing := range st.window {
+		if existing < st.window[minIdx] {
+			minIdx = i
+		}
+	}
+
+	if score <= st.window[minIdx] {
+		return false
+	}
+
+	st.window[minIdx] = score
+	return true
+}
+
+func (s *Sampler) stateFor(channelID uuid.UUID) *samplingState {
+	st, ok := s.states[channelID]
+	if !ok {
+		st = &samplingState{windowStart: time.Now()}
+		s.states[channelID] = st
+	}
+	return st
+}
+
+// relevanceScore ranks a change for capacity-aware sampling: new servers are
+// the most actionable, followed by removals, then routine version updates.
+func relevanceScore(change *types.Change) float64 {
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		return 3
+	case types.ChangeTypeRemoved:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -4,14 +4,22 @@ package slack
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/time/rate"
 
+	"github.com/nirholas/mcp-notify/internal/i18n"
+	"github.com/nirholas/mcp-notify/internal/notifier/template"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
@@ -20,6 +28,10 @@ type Config struct {
 	RateLimit     string
 	RetryAttempts int
 	RetryDelay    time.Duration
+
+	// SiteBaseURL, if set, is used to build the "View History" button link
+	// (SiteBaseURL + "/servers/{name}"). Empty omits that button.
+	SiteBaseURL string
 }
 
 // Sender sends notifications via Slack webhooks.
@@ -28,6 +40,7 @@ type Sender struct {
 	limiter       *rate.Limiter
 	retryAttempts int
 	retryDelay    time.Duration
+	siteBaseURL   string
 }
 
 // NewSender creates a new Slack sender.
@@ -41,6 +54,7 @@ func NewSender(cfg Config) *Sender {
 		limiter:       limiter,
 		retryAttempts: cfg.RetryAttempts,
 		retryDelay:    cfg.RetryDelay,
+		siteBaseURL:   strings.TrimSuffix(cfg.SiteBaseURL, "/"),
 	}
 }
 
@@ -60,7 +74,10 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 		return fmt.Errorf("slack webhook URL not configured")
 	}
 
-	payload := s.buildPayload(change, channel.Config.SlackChannel)
+	payload, err := s.RenderPayload(channel, change)
+	if err != nil {
+		return err
+	}
 
 	var lastErr error
 	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
@@ -109,7 +126,22 @@ func (s *Sender) sendRequest(ctx context.Context, webhookURL string, payload Sla
 	return nil
 }
 
-func (s *Sender) buildPayload(change *types.Change, channelOverride string) SlackPayload {
+// RenderPayload builds the Slack webhook payload for a change without
+// sending it, so previews and tests can inspect exactly what Send would
+// deliver.
+func (s *Sender) RenderPayload(channel *types.Channel, change *types.Change) (SlackPayload, error) {
+	if channel.Config.MessageTemplate != "" {
+		text, err := template.Render(channel.Config.MessageTemplate, change)
+		if err != nil {
+			return SlackPayload{}, err
+		}
+		return SlackPayload{Channel: channel.Config.SlackChannel, Text: text}, nil
+	}
+	return s.buildPayload(channel, change, i18n.Locale(channel.Config.Locale)), nil
+}
+
+func (s *Sender) buildPayload(channel *types.Channel, change *types.Change, locale i18n.Locale) SlackPayload {
+	channelOverride := channel.Config.SlackChannel
 	payload := SlackPayload{
 		Channel: channelOverride,
 	}
@@ -122,13 +154,16 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 	switch change.ChangeType {
 	case types.ChangeTypeNew:
 		emoji = "🆕"
-		title = "New MCP Server"
+		title = i18n.T(locale, i18n.KeyNewServerTitle)
 	case types.ChangeTypeUpdated:
 		emoji = "📝"
-		title = "Server Updated"
+		title = i18n.T(locale, i18n.KeyServerUpdatedTitle)
 	case types.ChangeTypeRemoved:
 		emoji = "🗑️"
-		title = "Server Removed"
+		title = i18n.T(locale, i18n.KeyServerRemovedTitle)
+	case types.ChangeTypeSummary:
+		emoji = "📋"
+		title = i18n.T(locale, i18n.KeySubscriptionSummaryTitle)
 	}
 
 	blocks = append(blocks, SlackBlock{
@@ -164,12 +199,12 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
 		fields = append(fields, SlackText{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Version*\n`%s` → `%s`", change.PreviousVersion, change.NewVersion),
+			Text: fmt.Sprintf("*%s*\n`%s` → `%s`", i18n.T(locale, i18n.KeyVersionLabel), change.PreviousVersion, change.NewVersion),
 		})
 	} else if change.NewVersion != "" {
 		fields = append(fields, SlackText{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Version*\n`%s`", change.NewVersion),
+			Text: fmt.Sprintf("*%s*\n`%s`", i18n.T(locale, i18n.KeyVersionLabel), change.NewVersion),
 		})
 	}
 
@@ -184,7 +219,7 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 		}
 		fields = append(fields, SlackText{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Packages*\n%s", pkgText),
+			Text: fmt.Sprintf("*%s*\n%s", i18n.T(locale, i18n.KeyPackagesLabel), pkgText),
 		})
 	}
 
@@ -203,7 +238,7 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 			Type: "button",
 			Text: &SlackText{
 				Type:  "plain_text",
-				Text:  "View Repository",
+				Text:  i18n.T(locale, i18n.KeyViewRepository),
 				Emoji: true,
 			},
 			URL: change.Server.Repository.URL,
@@ -216,12 +251,40 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 		Type: "button",
 		Text: &SlackText{
 			Type:  "plain_text",
-			Text:  "View in Registry",
+			Text:  i18n.T(locale, i18n.KeyViewInRegistry),
 			Emoji: true,
 		},
 		URL: registryURL,
 	})
 
+	if s.siteBaseURL != "" && change.ServerName != "" {
+		elements = append(elements, SlackElement{
+			Type: "button",
+			Text: &SlackText{
+				Type:  "plain_text",
+				Text:  i18n.T(locale, i18n.KeyViewHistory),
+				Emoji: true,
+			},
+			URL: fmt.Sprintf("%s/servers/%s", s.siteBaseURL, change.ServerName),
+		})
+	}
+
+	// Mute button, only meaningful for a change tied to one server and a
+	// real subscription (not a synthetic digest/warm-up summary).
+	if change.ServerName != "" && change.ChangeType != types.ChangeTypeSummary && channel.SubscriptionID != uuid.Nil {
+		elements = append(elements, SlackElement{
+			Type: "button",
+			Text: &SlackText{
+				Type:  "plain_text",
+				Text:  i18n.T(locale, i18n.KeyMuteServer),
+				Emoji: true,
+			},
+			ActionID: ActionMuteServer,
+			Value:    MuteButtonValue(channel.SubscriptionID.String(), change.ServerName),
+			Style:    "danger",
+		})
+	}
+
 	if len(elements) > 0 {
 		blocks = append(blocks, SlackBlock{
 			Type:     "actions",
@@ -237,7 +300,7 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 				Type: "mrkdwn",
 				Text: &SlackText{
 					Type: "mrkdwn",
-					Text: fmt.Sprintf("Detected at %s • MCP Notify", change.DetectedAt.Format("Jan 2, 2006 3:04 PM")),
+					Text: fmt.Sprintf("%s %s • MCP Notify", i18n.T(locale, i18n.KeyDetectedAtLabel), i18n.FormatTime(locale, change.DetectedAt)),
 				},
 			},
 		},
@@ -248,6 +311,57 @@ func (s *Sender) buildPayload(change *types.Change, channelOverride string) Slac
 	return payload
 }
 
+// ActionMuteServer identifies the "Mute this server" button's action_id in
+// Slack's block_actions interaction payload.
+const ActionMuteServer = "mute_server"
+
+// MuteButtonValue encodes the subscription and server a "Mute this server"
+// button click applies to, so the interactivity callback endpoint doesn't
+// need any other state to act on the click.
+func MuteButtonValue(subscriptionID, serverName string) string {
+	return subscriptionID + "|" + serverName
+}
+
+// ParseMuteButtonValue decodes a value produced by MuteButtonValue.
+func ParseMuteButtonValue(value string) (subscriptionID, serverName string, err error) {
+	subscriptionID, serverName, ok := strings.Cut(value, "|")
+	if !ok || subscriptionID == "" || serverName == "" {
+		return "", "", fmt.Errorf("malformed mute button value: %q", value)
+	}
+	return subscriptionID, serverName, nil
+}
+
+// maxInteractionAge bounds how old an inbound Slack request's timestamp may
+// be before VerifySignature rejects it as a possible replay.
+const maxInteractionAge = 5 * time.Minute
+
+// VerifySignature checks that an inbound request to the Slack interactivity
+// callback endpoint really came from Slack, per Slack's request signing
+// scheme (https://api.slack.com/authentication/verifying-requests-from-slack):
+// the signature is HMAC-SHA256("v0:{timestamp}:{body}", signingSecret),
+// hex-encoded and prefixed with "v0=". timestamp and signature come from the
+// X-Slack-Request-Timestamp and X-Slack-Signature headers respectively.
+func VerifySignature(signingSecret, timestamp, signature string, body []byte) bool {
+	if signingSecret == "" || timestamp == "" || signature == "" {
+		return false
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := time.Since(time.Unix(ts, 0)); age > maxInteractionAge || age < -maxInteractionAge {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
 // Slack payload types
 
 type SlackPayload struct {
@@ -271,7 +385,10 @@ type SlackText struct {
 }
 
 type SlackElement struct {
-	Type string     `json:"type"`
-	Text *SlackText `json:"text,omitempty"`
-	URL  string     `json:"url,omitempty"`
+	Type     string     `json:"type"`
+	Text     *SlackText `json:"text,omitempty"`
+	URL      string     `json:"url,omitempty"`
+	ActionID string     `json:"action_id,omitempty"`
+	Value    string     `json:"value,omitempty"`
+	Style    string     `json:"style,omitempty"`
 }
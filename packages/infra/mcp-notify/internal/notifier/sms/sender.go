@@ -0,0 +1,156 @@
+// Package sms provides SMS notification sending for critical subscriptions,
+// with a pluggable provider (Twilio by default) and strict per-subscription
+// rate limiting to keep runaway change volume from generating a large SMS
+// bill.
+package sms
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// maxMessageLen is the length of a single GSM-7 SMS segment. Longer bodies
+// are truncated rather than sent as (billable, easy to lose track of)
+// multi-part messages.
+const maxMessageLen = 160
+
+// Provider sends a single SMS message. Twilio is the only built-in
+// implementation, but Send takes just the essentials so another provider
+// (e.g. Vonage, SNS) can be dropped in without changing Sender.
+type Provider interface {
+	Send(ctx context.Context, to, body string) error
+}
+
+// Config holds SMS sender configuration.
+type Config struct {
+	Provider Provider
+
+	// MaxPerSubscriptionPerHour caps how many SMS a single subscription can
+	// trigger per hour, regardless of how many matching changes occur.
+	// Defaults to defaultMaxPerSubscriptionPerHour if unset.
+	MaxPerSubscriptionPerHour int
+
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+const defaultMaxPerSubscriptionPerHour = 10
+
+// Sender sends notifications via SMS.
+type Sender struct {
+	provider      Provider
+	retryAttempts int
+	retryDelay    time.Duration
+
+	rateLimit float64 // per second, for lazily-created per-subscription limiters
+	mu        sync.Mutex
+	limiters  map[uuid.UUID]*rate.Limiter
+}
+
+// NewSender creates a new SMS sender.
+func NewSender(cfg Config) *Sender {
+	maxPerHour := cfg.MaxPerSubscriptionPerHour
+	if maxPerHour <= 0 {
+		maxPerHour = defaultMaxPerSubscriptionPerHour
+	}
+
+	return &Sender{
+		provider:      cfg.Provider,
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+		rateLimit:     float64(maxPerHour) / time.Hour.Seconds(),
+		limiters:      make(map[uuid.UUID]*rate.Limiter),
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelSMS
+}
+
+// Send sends a notification via SMS, subject to a strict per-subscription
+// rate limit so a single noisy subscription can't run up an SMS bill.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	if channel.Config.SMSPhoneNumber == "" {
+		return fmt.Errorf("SMS phone number not configured")
+	}
+	if s.provider == nil {
+		return fmt.Errorf("no SMS provider configured")
+	}
+
+	if !s.limiterFor(channel.SubscriptionID).Allow() {
+		return fmt.Errorf("SMS rate limit exceeded for subscription %s", channel.SubscriptionID)
+	}
+
+	body := renderMessage(change)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying SMS notification")
+		}
+
+		err := s.provider.Send(ctx, channel.Config.SMSPhoneNumber, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+// limiterFor returns the rate limiter for a subscription, creating it on
+// first use. Limiters are never removed; the memory cost is one small
+// struct per subscription that has ever sent an SMS.
+func (s *Sender) limiterFor(subscriptionID uuid.UUID) *rate.Limiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter, ok := s.limiters[subscriptionID]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(s.rateLimit), 1)
+		s.limiters[subscriptionID] = limiter
+	}
+	return limiter
+}
+
+// renderMessage builds a short, plain-text SMS body, since SMS has no room
+// for the richer formatting other channels use.
+func renderMessage(change *types.Change) string {
+	var verb string
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		verb = "New"
+	case types.ChangeTypeUpdated:
+		verb = "Updated"
+	case types.ChangeTypeRemoved:
+		verb = "Removed"
+	case types.ChangeTypeSummary:
+		verb = "Summary"
+	}
+
+	body := fmt.Sprintf("MCP Notify: %s - %s", verb, change.ServerName)
+	if change.ChangeType == types.ChangeTypeUpdated && change.NewVersion != "" {
+		body = fmt.Sprintf("%s (%s)", body, change.NewVersion)
+	}
+
+	if len(body) > maxMessageLen {
+		body = body[:maxMessageLen-3] + "..."
+	}
+
+	return body
+}
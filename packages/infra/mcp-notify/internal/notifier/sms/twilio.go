@@ -0,0 +1,69 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TwilioProvider sends SMS via the Twilio Messages API.
+// See https://www.twilio.com/docs/sms/api/message-resource#create-a-message-resource.
+type TwilioProvider struct {
+	httpClient *http.Client
+	accountSID string
+	authToken  string
+	fromNumber string
+}
+
+// NewTwilioProvider creates a new Twilio SMS provider.
+func NewTwilioProvider(accountSID, authToken, fromNumber string) *TwilioProvider {
+	return &TwilioProvider{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		accountSID: accountSID,
+		authToken:  authToken,
+		fromNumber: fromNumber,
+	}
+}
+
+// Send sends a single SMS via Twilio.
+func (p *TwilioProvider) Send(ctx context.Context, to, body string) error {
+	if p.accountSID == "" || p.authToken == "" {
+		return fmt.Errorf("twilio credentials not configured")
+	}
+	if p.fromNumber == "" {
+		return fmt.Errorf("twilio from number not configured")
+	}
+
+	endpoint := fmt.Sprintf("https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json", p.accountSID)
+
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", p.fromNumber)
+	form.Set("Body", body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(p.accountSID, p.authToken)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
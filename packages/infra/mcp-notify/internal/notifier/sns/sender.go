@@ -0,0 +1,120 @@
+// Package sns publishes registry changes to an AWS SNS topic, so AWS-native
+// teams can fan out changes into their own infrastructure (SQS, Lambda,
+// HTTP subscribers) without a custom webhook receiver.
+package sns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds SNS sender configuration. Credentials are resolved from the
+// environment via the AWS SDK's default credential chain (env vars, shared
+// config/credentials files, or an EC2/ECS role), not read from this Config.
+type Config struct {
+	// Region overrides the region resolved from the default credential
+	// chain, if set.
+	Region        string
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender publishes notifications to an AWS SNS topic.
+type Sender struct {
+	client        *sns.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new SNS sender, resolving AWS credentials from the
+// environment.
+func NewSender(ctx context.Context, cfg Config) (*Sender, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	if cfg.Region != "" {
+		opts = append(opts, awsconfig.WithRegion(cfg.Region))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &Sender{
+		client:        sns.NewFromConfig(awsCfg),
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}, nil
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelSNS
+}
+
+// Send publishes a notification to the channel's configured SNS topic.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	topicARN := channel.Config.SNSTopicARN
+	if topicARN == "" {
+		return fmt.Errorf("SNS topic ARN not configured")
+	}
+
+	body, err := json.Marshal(buildMessage(change))
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	message := string(body)
+	subject := fmt.Sprintf("[mcp-notify] %s: %s", change.ChangeType, change.ServerName)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+		}
+
+		_, err := s.client.Publish(ctx, &sns.PublishInput{
+			TopicArn: &topicARN,
+			Message:  &message,
+			Subject:  &subject,
+		})
+		if err == nil {
+			return nil
+		}
+		lastErr = fmt.Errorf("failed to publish to SNS topic %s: %w", topicARN, err)
+	}
+
+	return lastErr
+}
+
+// message is the JSON body published to SNS.
+type message struct {
+	EventType       string        `json:"event_type"`
+	EventID         string        `json:"event_id"`
+	ServerName      string        `json:"server_name"`
+	Timestamp       time.Time     `json:"timestamp"`
+	PreviousVersion string        `json:"previous_version,omitempty"`
+	NewVersion      string        `json:"new_version,omitempty"`
+	Server          *types.Server `json:"server,omitempty"`
+}
+
+func buildMessage(change *types.Change) message {
+	return message{
+		EventType:       string(change.ChangeType),
+		EventID:         change.ID.String(),
+		ServerName:      change.ServerName,
+		Timestamp:       change.DetectedAt,
+		PreviousVersion: change.PreviousVersion,
+		NewVersion:      change.NewVersion,
+		Server:          change.Server,
+	}
+}
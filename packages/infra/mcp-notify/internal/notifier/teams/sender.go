@@ -129,24 +129,28 @@ func (s *Sender) buildAdaptiveCard(change *types.Change) AdaptiveCard {
 		title = "MCP Server Removed"
 		color = "attention" // Red
 		emoji = "🗑️"
+	case types.ChangeTypeSummary:
+		title = "Subscription Summary"
+		color = "warning" // Yellow
+		emoji = "📋"
 	}
 
 	// Build card body
 	body := []AdaptiveElement{
 		{
-			Type: "TextBlock",
-			Text: fmt.Sprintf("%s %s", emoji, title),
-			Size: "Large",
+			Type:   "TextBlock",
+			Text:   fmt.Sprintf("%s %s", emoji, title),
+			Size:   "Large",
 			Weight: "Bolder",
-			Wrap: true,
+			Wrap:   true,
 		},
 		{
-			Type: "TextBlock",
-			Text: change.ServerName,
-			Size: "Medium",
+			Type:   "TextBlock",
+			Text:   change.ServerName,
+			Size:   "Medium",
 			Weight: "Bolder",
-			Color: color,
-			Wrap: true,
+			Color:  color,
+			Wrap:   true,
 		},
 	}
 
@@ -241,7 +245,7 @@ func (s *Sender) buildAdaptiveCard(change *types.Change) AdaptiveCard {
 	}
 
 	return AdaptiveCard{
-		Type:    "message",
+		Type: "message",
 		Attachments: []AdaptiveAttachment{
 			{
 				ContentType: "application/vnd.microsoft.card.adaptive",
@@ -291,10 +295,10 @@ func (s *Sender) buildDigestCard(changes []types.Change) AdaptiveCard {
 			Wrap:   true,
 		},
 		{
-			Type:   "TextBlock",
-			Text:   fmt.Sprintf("%d changes detected", len(changes)),
-			Size:   "Medium",
-			Wrap:   true,
+			Type: "TextBlock",
+			Text: fmt.Sprintf("%d changes detected", len(changes)),
+			Size: "Medium",
+			Wrap: true,
 		},
 	}
 
@@ -351,10 +355,10 @@ func (s *Sender) buildDigestCard(changes []types.Change) AdaptiveCard {
 
 	if len(changes) > limit {
 		body = append(body, AdaptiveElement{
-			Type:   "TextBlock",
-			Text:   fmt.Sprintf("... and %d more changes", len(changes)-limit),
+			Type:     "TextBlock",
+			Text:     fmt.Sprintf("... and %d more changes", len(changes)-limit),
 			IsSubtle: true,
-			Wrap:   true,
+			Wrap:     true,
 		})
 	}
 
@@ -387,7 +391,7 @@ func (s *Sender) buildDigestCard(changes []types.Change) AdaptiveCard {
 
 // AdaptiveCard is the top-level message format for Teams.
 type AdaptiveCard struct {
-	Type        string              `json:"type"`
+	Type        string               `json:"type"`
 	Attachments []AdaptiveAttachment `json:"attachments"`
 }
 
@@ -400,11 +404,11 @@ type AdaptiveAttachment struct {
 
 // AdaptiveCardContent is the actual card content.
 type AdaptiveCardContent struct {
-	Schema  string           `json:"$schema"`
-	Type    string           `json:"type"`
-	Version string           `json:"version"`
+	Schema  string            `json:"$schema"`
+	Type    string            `json:"type"`
+	Version string            `json:"version"`
 	Body    []AdaptiveElement `json:"body"`
-	Actions []AdaptiveAction `json:"actions,omitempty"`
+	Actions []AdaptiveAction  `json:"actions,omitempty"`
 }
 
 // AdaptiveElement represents an element in the card body.
@@ -79,9 +79,9 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 
 	// Build payload
 	payload := TelegramPayload{
-		ChatID:    chatID,
-		Text:      message,
-		ParseMode: "MarkdownV2",
+		ChatID:                chatID,
+		Text:                  message,
+		ParseMode:             "MarkdownV2",
 		DisableWebPagePreview: false,
 	}
 
@@ -154,12 +154,20 @@ func (s *Sender) buildMessage(change *types.Change) string {
 	case types.ChangeTypeRemoved:
 		emoji = "🗑️"
 		changeTypeText = "Removed"
+	case types.ChangeTypeSummary:
+		emoji = "📋"
+		changeTypeText = "Subscription"
 	}
 
 	// Build message in MarkdownV2 format
 	// Note: MarkdownV2 requires escaping special characters
-	sb.WriteString(fmt.Sprintf("%s *%s MCP Server*\n\n", emoji, changeTypeText))
-	sb.WriteString(fmt.Sprintf("*Server:* `%s`\n", escapeMarkdownV2(change.ServerName)))
+	if change.ChangeType == types.ChangeTypeSummary {
+		sb.WriteString(fmt.Sprintf("%s *Subscription Summary*\n\n", emoji))
+		sb.WriteString(fmt.Sprintf("*Subscription:* `%s`\n", escapeMarkdownV2(change.ServerName)))
+	} else {
+		sb.WriteString(fmt.Sprintf("%s *%s MCP Server*\n\n", emoji, changeTypeText))
+		sb.WriteString(fmt.Sprintf("*Server:* `%s`\n", escapeMarkdownV2(change.ServerName)))
+	}
 
 	// Description
 	if change.Server != nil && change.Server.Description != "" {
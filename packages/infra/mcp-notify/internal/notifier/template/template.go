@@ -0,0 +1,102 @@
+// Package template renders the per-channel custom message templates that a
+// subscription can attach to a ChannelConfig, so teams can brand
+// notifications and include only the fields they care about instead of the
+// sender's built-in layout.
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// funcs are the template helpers available to a custom message template.
+// Kept in sync with the function map email templates use internally, so
+// authors don't need to learn a second set of helpers.
+var funcs = template.FuncMap{
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"truncate": func(s string, max int) string {
+		if len(s) <= max {
+			return s
+		}
+		return s[:max-3] + "..."
+	},
+	"formatTime": func(t time.Time) string {
+		return t.Format("Jan 2, 2006 at 3:04 PM UTC")
+	},
+}
+
+// Data is the value a custom message template is executed against.
+type Data struct {
+	ServerName      string
+	Description     string
+	ChangeType      string
+	Severity        string
+	NewVersion      string
+	PreviousVersion string
+	VersionChange   string
+	RepositoryURL   string
+	DetectedAt      time.Time
+	ChangedFields   []string
+}
+
+// BuildData converts a change into the Data a custom template renders.
+func BuildData(change *types.Change) Data {
+	data := Data{
+		ServerName:      change.ServerName,
+		ChangeType:      string(change.ChangeType),
+		Severity:        string(change.Severity),
+		NewVersion:      change.NewVersion,
+		PreviousVersion: change.PreviousVersion,
+		DetectedAt:      change.DetectedAt,
+	}
+
+	if change.PreviousVersion != "" && change.NewVersion != "" {
+		data.VersionChange = fmt.Sprintf("%s → %s", change.PreviousVersion, change.NewVersion)
+	}
+
+	server := change.Server
+	if server == nil {
+		server = change.PreviousServer
+	}
+	if server != nil {
+		data.Description = server.Description
+		if server.Repository != nil {
+			data.RepositoryURL = server.Repository.URL
+		}
+	}
+
+	for _, fc := range change.FieldChanges {
+		data.ChangedFields = append(data.ChangedFields, fc.Field)
+	}
+
+	return data
+}
+
+// Validate parses tmplStr without executing it, so a subscription is
+// rejected at create/update time instead of silently failing to render
+// on the first matching change.
+func Validate(tmplStr string) error {
+	_, err := template.New("message").Funcs(funcs).Parse(tmplStr)
+	return err
+}
+
+// Render executes tmplStr against change and returns the result.
+func Render(tmplStr string, change *types.Change) (string, error) {
+	tmpl, err := template.New("message").Funcs(funcs).Parse(tmplStr)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse message template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, BuildData(change)); err != nil {
+		return "", fmt.Errorf("failed to render message template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,9 +16,24 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/nirholas/mcp-notify/internal/notifier/template"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
+// ErrProtobufNotSupported is returned when a channel negotiates protobuf
+// encoding before generated types are vendored from proto/mcpnotify/v1
+// (see `make proto`). Until then, only application/json is deliverable.
+var ErrProtobufNotSupported = errors.New("protobuf webhook encoding not yet supported: run `make proto` and wire the generated types")
+
+// Payload version identifiers, sent in the X-MCP-Notify-Payload-Version
+// header so a consumer can tell which schema a delivery uses without
+// inspecting its body. PayloadVersionV1 is frozen indefinitely; new fields
+// are only ever added to PayloadVersionV2 or a later version.
+const (
+	PayloadVersionV1 = "v1"
+	PayloadVersionV2 = "v2"
+)
+
 // Config holds webhook sender configuration.
 type Config struct {
 	Timeout       time.Duration
@@ -63,8 +79,13 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 		method = http.MethodPost
 	}
 
-	// Build payload
-	payload := s.buildPayload(change)
+	body, contentType, payloadVersion, err := RenderBody(channel, change)
+	if err != nil {
+		return err
+	}
+	if contentType == "application/x-protobuf" {
+		return ErrProtobufNotSupported
+	}
 
 	var lastErr error
 	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
@@ -77,7 +98,7 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 			log.Debug().Int("attempt", attempt).Msg("Retrying webhook notification")
 		}
 
-		err := s.sendRequest(ctx, method, webhookURL, payload, channel.Config)
+		err := s.sendRequest(ctx, method, webhookURL, body, contentType, payloadVersion, channel.Config)
 		if err == nil {
 			return nil
 		}
@@ -87,20 +108,18 @@ func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types
 	return lastErr
 }
 
-func (s *Sender) sendRequest(ctx context.Context, method, url string, payload WebhookPayload, config types.ChannelConfig) error {
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
-	}
-
+func (s *Sender) sendRequest(ctx context.Context, method, url string, body []byte, contentType, payloadVersion string, config types.ChannelConfig) error {
 	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("User-Agent", "MCP-Notify/1.0")
+	if payloadVersion != "" {
+		req.Header.Set("X-MCP-Notify-Payload-Version", payloadVersion)
+	}
 
 	// Add custom headers
 	for k, v := range config.WebhookHeaders {
@@ -141,7 +160,54 @@ func (s *Sender) computeSignature(body []byte, secret string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-func (s *Sender) buildPayload(change *types.Change) WebhookPayload {
+// RenderBody builds the request body, content type and payload version
+// (see PayloadVersionV1/V2) Send would deliver for a change, without
+// sending it, so previews and tests can inspect the exact payload.
+// payloadVersion is empty when it doesn't apply, e.g. a MessageTemplate or
+// the cloudevents format, which don't have a versioned schema of their own.
+func RenderBody(channel *types.Channel, change *types.Change) (body []byte, contentType, payloadVersion string, err error) {
+	contentType = channel.Config.WebhookContentType
+	if channel.Config.MessageTemplate != "" {
+		rendered, err := template.Render(channel.Config.MessageTemplate, change)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if contentType == "" {
+			contentType = "text/plain"
+		}
+		return []byte(rendered), contentType, "", nil
+	}
+
+	if channel.Config.WebhookFormat == "cloudevents" {
+		body, err = json.Marshal(buildCloudEvent(change))
+		if err != nil {
+			return nil, "", "", fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		if contentType == "" {
+			contentType = "application/cloudevents+json"
+		}
+		return body, contentType, "", nil
+	}
+
+	payloadVersion = channel.Config.WebhookPayloadVersion
+	if payloadVersion == "" {
+		payloadVersion = PayloadVersionV1
+	}
+	if payloadVersion == PayloadVersionV2 {
+		body, err = json.Marshal(buildPayloadV2(change))
+	} else {
+		body, err = json.Marshal(buildPayload(change))
+	}
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	return body, contentType, payloadVersion, nil
+}
+
+func buildPayload(change *types.Change) WebhookPayload {
 	// Determine event type
 	var eventType string
 	switch change.ChangeType {
@@ -151,6 +217,8 @@ func (s *Sender) buildPayload(change *types.Change) WebhookPayload {
 		eventType = "server.updated"
 	case types.ChangeTypeRemoved:
 		eventType = "server.removed"
+	case types.ChangeTypeSummary:
+		eventType = "subscription.summary"
 	}
 
 	// Build field changes
@@ -167,13 +235,73 @@ func (s *Sender) buildPayload(change *types.Change) WebhookPayload {
 		EventType:   eventType,
 		EventID:     change.ID.String(),
 		Timestamp:   change.DetectedAt,
-		Server:      s.buildServerPayload(change),
+		Server:      buildServerPayload(change),
 		Changes:     fieldChanges,
 		RegistryURL: fmt.Sprintf("https://registry.modelcontextprotocol.io/v0/servers/%s", change.ServerName),
 	}
 }
 
-func (s *Sender) buildServerPayload(change *types.Change) *ServerPayload {
+// buildPayloadV2 builds the PayloadVersionV2 payload: WebhookPayload plus
+// severity, richer per-field diffs (each tagged with its kind: added,
+// removed or modified) and the registry id changed servers are identified
+// by, so consumers no longer have to infer them from the v1 body.
+func buildPayloadV2(change *types.Change) WebhookPayloadV2 {
+	v1 := buildPayload(change)
+
+	fieldChanges := make([]FieldChangeV2, 0, len(change.FieldChanges))
+	for _, fc := range change.FieldChanges {
+		fieldChanges = append(fieldChanges, FieldChangeV2{
+			Field:    fc.Field,
+			Kind:     fieldChangeKind(fc),
+			OldValue: fc.OldValue,
+			NewValue: fc.NewValue,
+		})
+	}
+
+	return WebhookPayloadV2{
+		EventType:   v1.EventType,
+		EventID:     v1.EventID,
+		Timestamp:   v1.Timestamp,
+		Severity:    change.Severity,
+		RegistryID:  change.ServerName,
+		Server:      v1.Server,
+		Changes:     fieldChanges,
+		RegistryURL: v1.RegistryURL,
+	}
+}
+
+// fieldChangeKind classifies a field diff by which of its old/new values is
+// present: "added" (no old value), "removed" (no new value), or "modified"
+// (both present).
+func fieldChangeKind(fc types.FieldChange) string {
+	switch {
+	case fc.OldValue == nil:
+		return "added"
+	case fc.NewValue == nil:
+		return "removed"
+	default:
+		return "modified"
+	}
+}
+
+// buildCloudEvent wraps the default JSON payload in a CloudEvents 1.0
+// structured-mode envelope (https://cloudevents.io), so routers like
+// Knative or EventBridge can dispatch on type/source without a
+// channel-specific adapter.
+func buildCloudEvent(change *types.Change) CloudEvent {
+	payload := buildPayload(change)
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		Type:            "dev.mcp-notify." + payload.EventType,
+		Source:          "mcp-notify",
+		ID:              payload.EventID,
+		Time:            payload.Timestamp,
+		DataContentType: "application/json",
+		Data:            payload,
+	}
+}
+
+func buildServerPayload(change *types.Change) *ServerPayload {
 	server := change.Server
 	if server == nil {
 		server = change.PreviousServer
@@ -219,6 +347,18 @@ func (s *Sender) buildServerPayload(change *types.Change) *ServerPayload {
 
 // Webhook payload types
 
+// CloudEvent is the CloudEvents 1.0 structured-mode JSON envelope. See
+// https://github.com/cloudevents/spec/blob/main/cloudevents/formats/json-format.md.
+type CloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	Type            string      `json:"type"`
+	Source          string      `json:"source"`
+	ID              string      `json:"id"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            interface{} `json:"data"`
+}
+
 type WebhookPayload struct {
 	EventType   string         `json:"event_type"`
 	EventID     string         `json:"event_id"`
@@ -228,14 +368,46 @@ type WebhookPayload struct {
 	RegistryURL string         `json:"registry_url"`
 }
 
+// WebhookPayloadV2 is PayloadVersionV2's schema: WebhookPayload plus
+// severity, richer per-field diffs and the registry id, so consumers that
+// need them don't have to wait for a v1-breaking change. Delivered when a
+// channel's WebhookPayloadVersion is "v2".
+type WebhookPayloadV2 struct {
+	EventType string    `json:"event_type"`
+	EventID   string    `json:"event_id"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Severity classifies how impactful this change is (see types.Severity),
+	// so consumers can filter or route without re-deriving it themselves.
+	Severity types.Severity `json:"severity"`
+
+	// RegistryID is the id the MCP registry identifies the affected server
+	// by. Servers in this registry are identified by name, so this is
+	// currently always equal to Server.Name.
+	RegistryID  string          `json:"registry_id"`
+	Server      *ServerPayload  `json:"server"`
+	Changes     []FieldChangeV2 `json:"changes,omitempty"`
+	RegistryURL string          `json:"registry_url"`
+}
+
+// FieldChangeV2 is a single field diff tagged with its Kind ("added",
+// "removed" or "modified"), so a consumer doesn't have to infer it from
+// which of OldValue/NewValue is present.
+type FieldChangeV2 struct {
+	Field    string      `json:"field"`
+	Kind     string      `json:"kind"`
+	OldValue interface{} `json:"old_value,omitempty"`
+	NewValue interface{} `json:"new_value,omitempty"`
+}
+
 type ServerPayload struct {
-	Name            string            `json:"name"`
-	Description     string            `json:"description,omitempty"`
-	Version         string            `json:"version,omitempty"`
-	PreviousVersion string            `json:"previous_version,omitempty"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description,omitempty"`
+	Version         string             `json:"version,omitempty"`
+	PreviousVersion string             `json:"previous_version,omitempty"`
 	Repository      *RepositoryPayload `json:"repository,omitempty"`
-	Packages        []PackagePayload  `json:"packages,omitempty"`
-	Remotes         []RemotePayload   `json:"remotes,omitempty"`
+	Packages        []PackagePayload   `json:"packages,omitempty"`
+	Remotes         []RemotePayload    `json:"remotes,omitempty"`
 }
 
 type RepositoryPayload struct {
@@ -0,0 +1,157 @@
+// Package zulip provides Zulip notification sending via its REST API,
+// posting messages to a stream/topic as a bot user.
+package zulip
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Config holds Zulip sender configuration.
+type Config struct {
+	// Site is the organization's Zulip server URL, e.g. https://example.zulipchat.com.
+	Site string
+	// BotEmail and BotAPIKey authenticate as a Zulip bot user.
+	BotEmail      string
+	BotAPIKey     string
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Sender sends notifications via the Zulip REST API.
+type Sender struct {
+	httpClient    *http.Client
+	site          string
+	botEmail      string
+	botAPIKey     string
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewSender creates a new Zulip sender.
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		site:          strings.TrimRight(cfg.Site, "/"),
+		botEmail:      cfg.BotEmail,
+		botAPIKey:     cfg.BotAPIKey,
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+	}
+}
+
+// Type returns the channel type.
+func (s *Sender) Type() types.ChannelType {
+	return types.ChannelZulip
+}
+
+// Send sends a notification as a Zulip stream message.
+func (s *Sender) Send(ctx context.Context, channel *types.Channel, change *types.Change) error {
+	if s.site == "" || s.botEmail == "" || s.botAPIKey == "" {
+		return fmt.Errorf("zulip bot credentials not configured")
+	}
+
+	stream := channel.Config.ZulipStream
+	if stream == "" {
+		return fmt.Errorf("zulip stream not configured")
+	}
+	topic := channel.Config.ZulipTopic
+	if topic == "" {
+		topic = "MCP Registry"
+	}
+
+	content := s.buildMessage(change)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying Zulip notification")
+		}
+
+		err := s.sendRequest(ctx, stream, topic, content)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+func (s *Sender) sendRequest(ctx context.Context, stream, topic, content string) error {
+	form := url.Values{}
+	form.Set("type", "stream")
+	form.Set("to", stream)
+	form.Set("topic", topic)
+	form.Set("content", content)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.site+"/api/v1/messages", strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(s.botEmail, s.botAPIKey)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// buildMessage renders the change as Zulip-flavored Markdown.
+func (s *Sender) buildMessage(change *types.Change) string {
+	var title string
+	switch change.ChangeType {
+	case types.ChangeTypeNew:
+		title = "🆕 **New MCP Server**"
+	case types.ChangeTypeUpdated:
+		title = "📝 **Server Updated**"
+	case types.ChangeTypeRemoved:
+		title = "🗑️ **Server Removed**"
+	case types.ChangeTypeSummary:
+		title = "📋 **Subscription Summary**"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", title)
+	fmt.Fprintf(&b, "**%s**\n", change.ServerName)
+
+	if change.Server != nil && change.Server.Description != "" {
+		fmt.Fprintf(&b, "%s\n", change.Server.Description)
+	}
+
+	if change.ChangeType == types.ChangeTypeUpdated && change.PreviousVersion != "" && change.NewVersion != "" {
+		fmt.Fprintf(&b, "* Version: `%s` → `%s`\n", change.PreviousVersion, change.NewVersion)
+	} else if change.NewVersion != "" {
+		fmt.Fprintf(&b, "* Version: `%s`\n", change.NewVersion)
+	}
+
+	fmt.Fprintf(&b, "* Detected at: %s\n", change.DetectedAt.Format("Jan 2, 2006 3:04 PM UTC"))
+	fmt.Fprintf(&b, "[View in Registry](https://registry.modelcontextprotocol.io/servers/%s)", change.ServerName)
+
+	return b.String()
+}
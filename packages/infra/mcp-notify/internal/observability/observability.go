@@ -0,0 +1,158 @@
+// Package observability generates Prometheus alert rules and a Grafana
+// dashboard for the metrics this binary actually emits (see
+// internal/telemetry), so the exported artifacts can never drift from the
+// code the way a hand-maintained ops/ directory can.
+package observability
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nirholas/mcp-notify/internal/telemetry"
+)
+
+// AlertRules returns a Prometheus rule group YAML document covering the
+// core failure modes: the poller stalling, notification delivery failing,
+// and the registry going quiet.
+func AlertRules() []byte {
+	yaml := fmt.Sprintf(`groups:
+  - name: mcp-notify
+    rules:
+      - alert: MCPNotifyPollerStalled
+        expr: increase(%[1]s[15m]) == 0
+        for: 15m
+        labels:
+          severity: critical
+        annotations:
+          summary: "MCP Notify has not polled the registry in 15 minutes"
+          description: "%[1]s has not increased in the last 15 minutes. The poller may be stuck or the process may be down."
+
+      - alert: MCPNotifyNotificationFailureRateHigh
+        expr: |
+          sum(rate(%[2]s[15m])) / (sum(rate(%[3]s[15m])) + sum(rate(%[2]s[15m]))) > 0.25
+        for: 15m
+        labels:
+          severity: warning
+        annotations:
+          summary: "MCP Notify notification failure rate is above 25%%"
+          description: "More than 25%% of notification deliveries have failed over the last 15 minutes."
+
+      - alert: MCPNotifyNoActiveSubscriptions
+        expr: %[4]s == 0
+        for: 30m
+        labels:
+          severity: info
+        annotations:
+          summary: "MCP Notify has no active subscriptions"
+          description: "%[4]s has been 0 for 30 minutes. No one will be notified of registry changes."
+
+      - alert: MCPNotifyPollDurationHigh
+        expr: histogram_quantile(0.95, sum(rate(%[5]s_bucket[15m])) by (le)) > 10
+        for: 15m
+        labels:
+          severity: warning
+        annotations:
+          summary: "MCP Notify registry polls are slow"
+          description: "p95 poll duration has exceeded 10s over the last 15 minutes, which may indicate registry API degradation."
+`,
+		telemetry.MetricPollsTotal,
+		telemetry.MetricNotificationsFailedTotal,
+		telemetry.MetricNotificationsSentTotal,
+		telemetry.MetricSubscriptionsActive,
+		telemetry.MetricPollDurationSeconds,
+	)
+	return []byte(yaml)
+}
+
+// Dashboard returns a Grafana dashboard definition (the JSON model expected
+// by Grafana's dashboard import API) with one panel per core metric.
+func Dashboard() []byte {
+	type target struct {
+		Expr  string `json:"expr"`
+		RefID string `json:"refId"`
+	}
+	type panel struct {
+		Title      string   `json:"title"`
+		Type       string   `json:"type"`
+		GridPos    gridPos  `json:"gridPos"`
+		Targets    []target `json:"targets"`
+		Datasource string   `json:"datasource"`
+	}
+
+	panels := []panel{
+		{
+			Title:      "Registry Polls",
+			Type:       "timeseries",
+			GridPos:    gridPos{H: 8, W: 12, X: 0, Y: 0},
+			Datasource: "Prometheus",
+			Targets:    []target{{Expr: fmt.Sprintf("rate(%s[5m])", telemetry.MetricPollsTotal), RefID: "A"}},
+		},
+		{
+			Title:      "Poll Duration (p95)",
+			Type:       "timeseries",
+			GridPos:    gridPos{H: 8, W: 12, X: 12, Y: 0},
+			Datasource: "Prometheus",
+			Targets: []target{{
+				Expr:  fmt.Sprintf("histogram_quantile(0.95, sum(rate(%s_bucket[5m])) by (le))", telemetry.MetricPollDurationSeconds),
+				RefID: "A",
+			}},
+		},
+		{
+			Title:      "Changes Detected",
+			Type:       "timeseries",
+			GridPos:    gridPos{H: 8, W: 12, X: 0, Y: 8},
+			Datasource: "Prometheus",
+			Targets:    []target{{Expr: fmt.Sprintf("rate(%s[5m])", telemetry.MetricChangesDetectedTotal), RefID: "A"}},
+		},
+		{
+			Title:      "Notifications Sent vs Failed",
+			Type:       "timeseries",
+			GridPos:    gridPos{H: 8, W: 12, X: 12, Y: 8},
+			Datasource: "Prometheus",
+			Targets: []target{
+				{Expr: fmt.Sprintf("rate(%s[5m])", telemetry.MetricNotificationsSentTotal), RefID: "A"},
+				{Expr: fmt.Sprintf("rate(%s[5m])", telemetry.MetricNotificationsFailedTotal), RefID: "B"},
+			},
+		},
+		{
+			Title:      "Active Subscriptions",
+			Type:       "stat",
+			GridPos:    gridPos{H: 8, W: 12, X: 0, Y: 16},
+			Datasource: "Prometheus",
+			Targets:    []target{{Expr: telemetry.MetricSubscriptionsActive, RefID: "A"}},
+		},
+		{
+			Title:      "Registry Servers Tracked",
+			Type:       "stat",
+			GridPos:    gridPos{H: 8, W: 12, X: 12, Y: 16},
+			Datasource: "Prometheus",
+			Targets:    []target{{Expr: telemetry.MetricRegistryServersTotal, RefID: "A"}},
+		},
+	}
+
+	dashboard := map[string]interface{}{
+		"title":         "MCP Notify",
+		"uid":           "mcp-notify",
+		"schemaVersion": 39,
+		"panels":        panels,
+		"time": map[string]string{
+			"from": "now-6h",
+			"to":   "now",
+		},
+	}
+
+	data, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		// Only fails if the literal above is malformed, which is a
+		// programming error, not a runtime condition.
+		panic(fmt.Sprintf("observability: failed to marshal dashboard: %v", err))
+	}
+	return data
+}
+
+type gridPos struct {
+	H int `json:"h"`
+	W int `json:"w"`
+	X int `json:"x"`
+	Y int `json:"y"`
+}
@@ -0,0 +1,208 @@
+// Package organization provides organization/team management: subscriptions
+// and channels owned by a team instead of a single user, with member roles
+// and an org-level subscription quota.
+package organization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Manager handles organization operations.
+type Manager struct {
+	db db.Database
+}
+
+// NewManager creates a new organization manager.
+func NewManager(database db.Database) *Manager {
+	return &Manager{db: database}
+}
+
+// Create creates a new organization and adds ownerID as its first member,
+// with OrgRoleOwner.
+func (m *Manager) Create(ctx context.Context, req types.CreateOrganizationRequest, ownerID uuid.UUID) (*types.Organization, error) {
+	existing, err := m.db.GetOrganizationBySlug(ctx, req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check organization slug: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("organization slug %q is already taken", req.Slug)
+	}
+
+	now := time.Now().UTC()
+	org := &types.Organization{
+		ID:        uuid.New(),
+		Name:      req.Name,
+		Slug:      req.Slug,
+		Tier:      req.Tier,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	applyTierDefaults(org)
+
+	if err := m.db.CreateOrganization(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	member := &types.OrganizationMember{
+		OrgID:     org.ID,
+		UserID:    ownerID,
+		Role:      types.OrgRoleOwner,
+		CreatedAt: now,
+	}
+	if err := m.db.AddOrganizationMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add organization owner: %w", err)
+	}
+
+	log.Info().
+		Str("org_id", org.ID.String()).
+		Str("slug", org.Slug).
+		Msg("Created organization")
+
+	return org, nil
+}
+
+// Update applies req to an existing organization.
+func (m *Manager) Update(ctx context.Context, id uuid.UUID, req types.UpdateOrganizationRequest) (*types.Organization, error) {
+	org, err := m.db.GetOrganizationByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org == nil {
+		return nil, fmt.Errorf("organization not found")
+	}
+
+	if req.Name != nil {
+		org.Name = *req.Name
+	}
+	if req.Tier != nil {
+		org.Tier = *req.Tier
+		applyTierDefaults(org)
+	}
+	if req.MaxSubscriptions != nil {
+		org.MaxSubscriptions = *req.MaxSubscriptions
+	}
+	if req.MaxNotificationsPerMonth != nil {
+		org.MaxNotificationsPerMonth = *req.MaxNotificationsPerMonth
+	}
+	if req.MaxChannelsPerSubscription != nil {
+		org.MaxChannelsPerSubscription = *req.MaxChannelsPerSubscription
+	}
+	if req.UsageWebhookURL != nil {
+		org.UsageWebhookURL = *req.UsageWebhookURL
+	}
+	if req.UsageWebhookSecret != nil {
+		org.UsageWebhookSecret = *req.UsageWebhookSecret
+	}
+
+	if err := m.db.UpdateOrganization(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	return org, nil
+}
+
+// Delete deletes an organization. Its subscriptions are orphaned (org_id set
+// to NULL), not deleted with it.
+func (m *Manager) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := m.db.DeleteOrganization(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	log.Info().Str("org_id", id.String()).Msg("Deleted organization")
+	return nil
+}
+
+// CheckSubscriptionQuota returns an error if orgID has already reached its
+// Organization.MaxSubscriptions, so subscription creation can be rejected
+// before it happens rather than silently exceeding the quota.
+func (m *Manager) CheckSubscriptionQuota(ctx context.Context, orgID uuid.UUID) error {
+	org, err := m.db.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org == nil {
+		return fmt.Errorf("organization not found")
+	}
+	if org.MaxSubscriptions == 0 {
+		return nil
+	}
+
+	count, err := m.db.CountSubscriptionsForOrganization(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to count organization subscriptions: %w", err)
+	}
+	if count >= org.MaxSubscriptions {
+		return fmt.Errorf("organization has reached its subscription quota of %d", org.MaxSubscriptions)
+	}
+
+	return nil
+}
+
+// AddMember adds userID to orgID with role, or updates their role if already
+// a member.
+func (m *Manager) AddMember(ctx context.Context, orgID, userID uuid.UUID, role types.OrgRole) error {
+	member := &types.OrganizationMember{
+		OrgID:     orgID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := m.db.AddOrganizationMember(ctx, member); err != nil {
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember removes userID from orgID. It refuses to remove the last
+// remaining owner, so an organization is never left without one.
+func (m *Manager) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	member, err := m.db.GetOrganizationMember(ctx, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization member: %w", err)
+	}
+	if member == nil {
+		return fmt.Errorf("organization member not found")
+	}
+
+	if member.Role == types.OrgRoleOwner {
+		members, err := m.db.ListOrganizationMembers(ctx, orgID)
+		if err != nil {
+			return fmt.Errorf("failed to list organization members: %w", err)
+		}
+		owners := 0
+		for _, m := range members {
+			if m.Role == types.OrgRoleOwner {
+				owners++
+			}
+		}
+		if owners <= 1 {
+			return fmt.Errorf("cannot remove the last owner of an organization")
+		}
+	}
+
+	if err := m.db.RemoveOrganizationMember(ctx, orgID, userID); err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	return nil
+}
+
+// RoleOf returns the role userID holds in orgID, or ("", false) if they are
+// not a member.
+func (m *Manager) RoleOf(ctx context.Context, orgID, userID uuid.UUID) (types.OrgRole, bool, error) {
+	member, err := m.db.GetOrganizationMember(ctx, orgID, userID)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	if member == nil {
+		return "", false, nil
+	}
+	return member.Role, true, nil
+}
@@ -0,0 +1,99 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// tierQuotas is the set of limits a QuotaTier grants an organization that
+// hasn't overridden them individually.
+type tierQuotas struct {
+	maxSubscriptions           int
+	maxNotificationsPerMonth   int
+	maxChannelsPerSubscription int
+}
+
+// DefaultQuotaTiers maps each QuotaTier to the limits a new organization on
+// that tier starts with. A limit of 0 means unlimited.
+var DefaultQuotaTiers = map[types.QuotaTier]tierQuotas{
+	types.QuotaTierFree: {
+		maxSubscriptions:           3,
+		maxNotificationsPerMonth:   1000,
+		maxChannelsPerSubscription: 2,
+	},
+	types.QuotaTierPro: {
+		maxSubscriptions:           25,
+		maxNotificationsPerMonth:   50000,
+		maxChannelsPerSubscription: 10,
+	},
+	types.QuotaTierEnterprise: {
+		// Unlimited across the board; enterprise accounts are metered via
+		// the usage webhook rather than hard-capped at the API.
+		maxSubscriptions:           0,
+		maxNotificationsPerMonth:   0,
+		maxChannelsPerSubscription: 0,
+	},
+}
+
+// applyTierDefaults sets org's quota fields from its Tier, defaulting the
+// tier itself to QuotaTierFree if unset. Used when creating an organization
+// or explicitly resetting one to a tier's defaults.
+func applyTierDefaults(org *types.Organization) {
+	if org.Tier == "" {
+		org.Tier = types.QuotaTierFree
+	}
+	quotas, ok := DefaultQuotaTiers[org.Tier]
+	if !ok {
+		quotas = DefaultQuotaTiers[types.QuotaTierFree]
+	}
+	org.MaxSubscriptions = quotas.maxSubscriptions
+	org.MaxNotificationsPerMonth = quotas.maxNotificationsPerMonth
+	org.MaxChannelsPerSubscription = quotas.maxChannelsPerSubscription
+}
+
+// CheckChannelQuota returns an error if adding a subscription with
+// channelCount channels would put orgID over its MaxChannelsPerSubscription.
+func (m *Manager) CheckChannelQuota(ctx context.Context, orgID uuid.UUID, channelCount int) error {
+	org, err := m.db.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org == nil {
+		return fmt.Errorf("organization not found")
+	}
+	if org.MaxChannelsPerSubscription == 0 {
+		return nil
+	}
+	if channelCount > org.MaxChannelsPerSubscription {
+		return fmt.Errorf("organization's %s tier allows at most %d channels per subscription", org.Tier, org.MaxChannelsPerSubscription)
+	}
+	return nil
+}
+
+// CheckNotificationQuota returns an error if orgID has already reached its
+// MaxNotificationsPerMonth for the current calendar month.
+func (m *Manager) CheckNotificationQuota(ctx context.Context, orgID uuid.UUID) error {
+	org, err := m.db.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org == nil {
+		return fmt.Errorf("organization not found")
+	}
+	if org.MaxNotificationsPerMonth == 0 {
+		return nil
+	}
+
+	count, err := m.db.CountNotificationsForOrganizationSince(ctx, orgID, currentMonthStart())
+	if err != nil {
+		return fmt.Errorf("failed to count organization notifications: %w", err)
+	}
+	if count >= org.MaxNotificationsPerMonth {
+		return fmt.Errorf("organization has reached its monthly notification quota of %d", org.MaxNotificationsPerMonth)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package organization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// fakeQuotaDB embeds db.Database (nil) so it satisfies the interface, and
+// overrides only the two methods CheckChannelQuota/CheckNotificationQuota
+// actually call.
+type fakeQuotaDB struct {
+	db.Database
+
+	org                     *types.Organization
+	orgErr                  error
+	notificationCount       int
+	notificationCountErr    error
+	countNotificationsSince time.Time
+}
+
+func (f *fakeQuotaDB) GetOrganizationByID(ctx context.Context, id uuid.UUID) (*types.Organization, error) {
+	return f.org, f.orgErr
+}
+
+func (f *fakeQuotaDB) CountNotificationsForOrganizationSince(ctx context.Context, orgID uuid.UUID, since time.Time) (int, error) {
+	f.countNotificationsSince = since
+	return f.notificationCount, f.notificationCountErr
+}
+
+func TestApplyTierDefaults(t *testing.T) {
+	org := &types.Organization{Tier: types.QuotaTierPro}
+	applyTierDefaults(org)
+
+	assert.Equal(t, 25, org.MaxSubscriptions)
+	assert.Equal(t, 50000, org.MaxNotificationsPerMonth)
+	assert.Equal(t, 10, org.MaxChannelsPerSubscription)
+}
+
+func TestApplyTierDefaults_DefaultsToFree(t *testing.T) {
+	org := &types.Organization{}
+	applyTierDefaults(org)
+
+	assert.Equal(t, types.QuotaTierFree, org.Tier)
+	assert.Equal(t, 3, org.MaxSubscriptions)
+}
+
+func TestApplyTierDefaults_UnknownTierFallsBackToFree(t *testing.T) {
+	org := &types.Organization{Tier: types.QuotaTier("nonexistent")}
+	applyTierDefaults(org)
+
+	assert.Equal(t, DefaultQuotaTiers[types.QuotaTierFree].maxSubscriptions, org.MaxSubscriptions)
+}
+
+func TestCheckChannelQuota_WithinLimit(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{org: &types.Organization{MaxChannelsPerSubscription: 5}}}
+
+	err := mgr.CheckChannelQuota(context.Background(), uuid.New(), 5)
+	assert.NoError(t, err)
+}
+
+func TestCheckChannelQuota_OverLimit(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{org: &types.Organization{Tier: types.QuotaTierFree, MaxChannelsPerSubscription: 2}}}
+
+	err := mgr.CheckChannelQuota(context.Background(), uuid.New(), 3)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "at most 2 channels")
+}
+
+func TestCheckChannelQuota_ZeroMeansUnlimited(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{org: &types.Organization{MaxChannelsPerSubscription: 0}}}
+
+	err := mgr.CheckChannelQuota(context.Background(), uuid.New(), 1000)
+	assert.NoError(t, err)
+}
+
+func TestCheckChannelQuota_OrganizationNotFound(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{org: nil}}
+
+	err := mgr.CheckChannelQuota(context.Background(), uuid.New(), 1)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestCheckNotificationQuota_UnderLimit(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{
+		org:               &types.Organization{MaxNotificationsPerMonth: 1000},
+		notificationCount: 500,
+	}}
+
+	err := mgr.CheckNotificationQuota(context.Background(), uuid.New())
+	assert.NoError(t, err)
+}
+
+func TestCheckNotificationQuota_AtLimit(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{
+		org:               &types.Organization{Tier: types.QuotaTierFree, MaxNotificationsPerMonth: 1000},
+		notificationCount: 1000,
+	}}
+
+	err := mgr.CheckNotificationQuota(context.Background(), uuid.New())
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "monthly notification quota")
+}
+
+func TestCheckNotificationQuota_ZeroMeansUnlimited(t *testing.T) {
+	mgr := &Manager{db: &fakeQuotaDB{
+		org:               &types.Organization{MaxNotificationsPerMonth: 0},
+		notificationCount: 1_000_000,
+	}}
+
+	err := mgr.CheckNotificationQuota(context.Background(), uuid.New())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,106 @@
+package organization
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// currentMonthStart returns the start (UTC, midnight) of the calendar month
+// containing now, the period NotificationsThisMonth and the notification
+// quota are computed over.
+func currentMonthStart() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Usage returns orgID's current standing against its quota tier: how many
+// subscriptions it owns and how many notifications it has sent this
+// calendar month, against each quota's max (0 meaning unlimited).
+func (m *Manager) Usage(ctx context.Context, orgID uuid.UUID) (*types.OrganizationUsage, error) {
+	org, err := m.db.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org == nil {
+		return nil, fmt.Errorf("organization not found")
+	}
+
+	periodStart := currentMonthStart()
+
+	subscriptionCount, err := m.db.CountSubscriptionsForOrganization(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count organization subscriptions: %w", err)
+	}
+
+	notificationCount, err := m.db.CountNotificationsForOrganizationSince(ctx, orgID, periodStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count organization notifications: %w", err)
+	}
+
+	return &types.OrganizationUsage{
+		OrgID:       org.ID,
+		Tier:        org.Tier,
+		PeriodStart: periodStart,
+		Subscriptions: types.UsageCount{
+			Used: subscriptionCount,
+			Max:  org.MaxSubscriptions,
+		},
+		NotificationsThisMonth: types.UsageCount{
+			Used: notificationCount,
+			Max:  org.MaxNotificationsPerMonth,
+		},
+	}, nil
+}
+
+// SendUsageWebhook POSTs usage to org.UsageWebhookURL, HMAC-signing the body
+// with org.UsageWebhookSecret the same way a notification channel's webhook
+// secret signs its deliveries (see internal/notifier/webhook). It's a no-op
+// if org.UsageWebhookURL is unset.
+func (m *Manager) SendUsageWebhook(ctx context.Context, org *types.Organization, usage *types.OrganizationUsage) error {
+	if org.UsageWebhookURL == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(usage)
+	if err != nil {
+		return fmt.Errorf("failed to marshal usage payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, org.UsageWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create usage webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MCP-Notify/1.0")
+	if org.UsageWebhookSecret != "" {
+		req.Header.Set("X-Signature-256", "sha256="+signUsagePayload(body, org.UsageWebhookSecret))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send usage webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("usage webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func signUsagePayload(body []byte, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -2,38 +2,212 @@
 package poller
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 
+	"github.com/nirholas/mcp-notify/internal/category"
 	"github.com/nirholas/mcp-notify/internal/db"
 	"github.com/nirholas/mcp-notify/internal/diff"
+	"github.com/nirholas/mcp-notify/internal/eventbus"
+	"github.com/nirholas/mcp-notify/internal/heartbeat"
 	"github.com/nirholas/mcp-notify/internal/notifier"
+	"github.com/nirholas/mcp-notify/internal/organization"
+	"github.com/nirholas/mcp-notify/internal/provenance"
 	"github.com/nirholas/mcp-notify/internal/registry"
+	"github.com/nirholas/mcp-notify/internal/security"
 	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/internal/summarizer"
+	"github.com/nirholas/mcp-notify/internal/telemetry"
+	"github.com/nirholas/mcp-notify/internal/transparency"
+	"github.com/nirholas/mcp-notify/internal/typosquat"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
+// opsAlertTimeout bounds how long a stall/recovery alert POST to
+// NotificationsConfig.OpsWebhookURL may take, independent of any per-channel
+// notifier timeout since this path never goes through the dispatcher.
+const opsAlertTimeout = 10 * time.Second
+
+// cachedSnapshotTTL bounds how long a snapshot cached in Redis for degraded-
+// mode API reads (see internal/api/handlers.GetStats) stays valid, so a
+// stale cache doesn't outlive an extended database outage indefinitely.
+const cachedSnapshotTTL = 1 * time.Hour
+
+// defaultNotificationOutboxInterval is how often the notification outbox is
+// drained when Config's NotificationsConfig.OutboxFlushInterval isn't set.
+const defaultNotificationOutboxInterval = 5 * time.Second
+
+// notificationOutboxBatchSize bounds how many outbox entries are dispatched
+// per flush, so one flush can't run unboundedly long behind a large backlog.
+const notificationOutboxBatchSize = 100
+
+// maxNotificationRetryBackoff caps the backoff applied to a notification
+// outbox entry after a failed dispatch attempt.
+const maxNotificationRetryBackoff = 5 * time.Minute
+
 var (
 	tracer = otel.Tracer("poller")
 	meter  = otel.Meter("poller")
 )
 
+const (
+	// defaultMaxPollInterval caps the adaptive scheduler's growth when
+	// Config.MaxPollInterval isn't set.
+	defaultMaxPollInterval = 30 * time.Minute
+
+	// quietPollsBeforeGrowth is the hysteresis on lengthening the interval:
+	// a single quiet poll doesn't grow it, avoiding oscillation around a
+	// registry that changes every other poll.
+	quietPollsBeforeGrowth = 3
+
+	// growthFactor scales the interval each time it lengthens during a
+	// quiet period. Activity resets the interval to the floor immediately
+	// rather than shrinking gradually, so freshness recovers as soon as
+	// something changes.
+	growthFactor = 2.0
+
+	// defaultShardConcurrency bounds how many namespace shards are fetched
+	// at once when Config.ShardConcurrency isn't set.
+	defaultShardConcurrency = 4
+)
+
+// shardedLister is implemented by registry.Client to fetch a large
+// registry's listing as concurrent per-namespace shards instead of one
+// paginated fetch. Adapters that don't implement it (npm, pypi, github)
+// always use the single-fetch path.
+type shardedLister interface {
+	ListServersSharded(ctx context.Context, prefixes []string, concurrency int) ([]types.Server, error)
+}
+
 // Config holds poller configuration.
 type Config struct {
-	Client          *registry.Client
+	Client          registry.Adapter
 	Database        db.Database
 	Cache           db.Cache
 	Dispatcher      *notifier.Dispatcher
 	SubscriptionMgr *subscription.Manager
-	PollInterval    time.Duration
+
+	// OrganizationMgr, when set, is consulted before dispatching to an
+	// org-owned subscription (Subscription.OrgID) so an organization that
+	// has already hit its MaxNotificationsPerMonth stops receiving new
+	// notifications for the rest of the month instead of the cap being
+	// purely advisory. nil skips the check, e.g. in tests or deployments
+	// without organizations configured.
+	OrganizationMgr *organization.Manager
+
+	// PollInterval is the adaptive scheduler's floor: the shortest interval
+	// it will use, and the interval it starts and resets to when changes
+	// are detected.
+	PollInterval time.Duration
+
+	// MaxPollInterval caps how far the interval may lengthen during quiet
+	// periods. A zero value falls back to defaultMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// TransparencyLog appends every saved change to a hash-chained,
+	// optionally signed audit log. nil disables it (the default).
+	TransparencyLog *transparency.Log
+
+	// EventBus queues every saved change for delivery to an external event
+	// bus (Kafka/NATS). nil disables it (the default).
+	EventBus *eventbus.Bus
+
+	// RegistryURL scopes derived change IDs to this registry, so the same
+	// server/version transition observed from two different registries
+	// never collides. See diff.NewEngine.
+	RegistryURL string
+
+	// ShardPrefixes, if non-empty, splits each poll into one fetch per
+	// namespace prefix (run with up to ShardConcurrency in flight at once)
+	// instead of a single full-listing fetch, for registries too large to
+	// list in one request cheaply. Only takes effect if Client also
+	// implements shardedLister; ignored otherwise. A server whose name
+	// doesn't start with any prefix is silently excluded, so prefixes
+	// should cover the registry's full namespace.
+	ShardPrefixes    []string
+	ShardConcurrency int
+
+	// VerifyChanges re-fetches a changed server after VerificationDelay
+	// before it's saved/dispatched, to rule out a transient registry
+	// listing glitch (most valuable for removals).
+	VerifyChanges     bool
+	VerificationDelay time.Duration
+
+	// SecurityScorer, when set, scores every fetched server's declared
+	// packages against known vulnerabilities before diffing, so
+	// Server.SecurityScore/Vulnerabilities are populated and a score drop
+	// can be flagged by the diff engine's anomaly detection. nil disables
+	// scoring (the default).
+	SecurityScorer *security.Scorer
+
+	// SecurityScoreThreshold flags an updated server as suspicious once its
+	// score is at or below this value. Only meaningful when SecurityScorer
+	// is set; a zero value falls back to the diff engine's own default.
+	SecurityScoreThreshold int
+
+	// Summarizer, when set, generates a short natural-language summary of
+	// each updated server's field changes (see internal/summarizer),
+	// populating Change.AISummary for digests and notifications. nil
+	// disables it (the default).
+	Summarizer *summarizer.Client
+
+	// ProvenanceVerifier, when set, checks every fetched server's declared
+	// npm/PyPI packages for a published Sigstore/SLSA provenance attestation
+	// before diffing, so Server.Provenance is populated and subscribers can
+	// filter to only provenance-attested updates. This is not cryptographic
+	// verification (see internal/provenance's package doc comment). nil
+	// disables the check (the default).
+	ProvenanceVerifier *provenance.Verifier
+
+	// HeartbeatURL, if set, receives a GET ping after every poll attempt so
+	// an external dead-man's-switch monitor notices if this process stops
+	// polling entirely. See internal/heartbeat.
+	HeartbeatURL string
+
+	// StallThreshold flags the poller as stalled once this long has passed
+	// since its last successful poll, alerting OpsWebhookURL if set. Zero
+	// disables stall detection (the default).
+	StallThreshold time.Duration
+
+	// OpsWebhookURL, if set, receives a JSON {"text": "..."} POST when the
+	// poller stalls or recovers from a stall. Only meaningful when
+	// StallThreshold is also set.
+	OpsWebhookURL string
+
+	// BackfillOnStartup, when true, makes Run diff the registry against the
+	// latest stored snapshot once before entering its regular poll loop, so
+	// changes that happened while the process was down are caught up
+	// instead of being silently lost to the "no previous snapshot" first-poll
+	// branch. Resulting changes are marked Change.Backfilled.
+	BackfillOnStartup bool
+
+	// BackfillNotify controls whether backfilled changes are dispatched to
+	// subscriptions like any other change, or saved silently. Only
+	// meaningful when BackfillOnStartup is set.
+	BackfillNotify bool
 }
 
+// maxBufferedSnapshots and maxBufferedChanges bound the in-memory buffer
+// used to survive a database outage (see enterDegraded/leaveDegraded): once
+// full, the oldest buffered write is dropped and logged rather than growing
+// without limit.
+const (
+	maxBufferedSnapshots = 20
+	maxBufferedChanges   = 500
+)
+
 // Poller polls the MCP Registry for changes.
 type Poller struct {
 	config       Config
@@ -42,18 +216,70 @@ type Poller struct {
 	lastPollTime time.Time
 	mu           sync.RWMutex
 
+	// Adaptive interval state: currentInterval shortens to PollInterval as
+	// soon as changes are seen, and lengthens (with hysteresis, tracked by
+	// quietStreak) toward maxInterval during quiet periods.
+	maxInterval     time.Duration
+	currentInterval time.Duration
+	quietStreak     int
+
+	// Self-monitoring: heartbeat pings an external dead-man's-switch on
+	// every poll attempt; lastSuccessAt/stalled track this process's own
+	// view of whether polling has stalled, for the ops webhook alert.
+	heartbeat     *heartbeat.Client
+	opsHTTPClient *http.Client
+	lastSuccessAt time.Time
+	stalled       bool
+
+	// Degraded mode: while the database is unreachable, snapshots/changes
+	// that fail to save are buffered here instead of being dropped, and
+	// replayed in order once a save succeeds again. Guarded by mu, like the
+	// rest of the poller's mutable state.
+	degraded          bool
+	bufferedSnapshots []*types.Snapshot
+	bufferedChanges   []types.Change
+
 	// Metrics
-	pollCount       metric.Int64Counter
-	pollDuration    metric.Float64Histogram
-	changesDetected metric.Int64Counter
-	pollErrors      metric.Int64Counter
+	pollCount              metric.Int64Counter
+	pollDuration           metric.Float64Histogram
+	changesDetected        metric.Int64Counter
+	pollErrors             metric.Int64Counter
+	registryServers        metric.Int64Gauge
+	lastSuccessfulPoll     metric.Int64Gauge
+	currentIntervalSeconds metric.Float64Gauge
 }
 
 // New creates a new poller.
 func New(cfg Config) *Poller {
+	maxInterval := cfg.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+	if maxInterval < cfg.PollInterval {
+		maxInterval = cfg.PollInterval
+	}
+	if len(cfg.ShardPrefixes) > 0 && cfg.ShardConcurrency <= 0 {
+		cfg.ShardConcurrency = defaultShardConcurrency
+	}
+
+	var engineOpts []diff.Option
+	if cfg.SecurityScoreThreshold > 0 {
+		engineOpts = append(engineOpts, diff.WithSecurityScoreThreshold(cfg.SecurityScoreThreshold))
+	}
+
 	p := &Poller{
-		config:     cfg,
-		diffEngine: diff.NewEngine(),
+		config:          cfg,
+		diffEngine:      diff.NewEngine(cfg.RegistryURL, engineOpts...),
+		maxInterval:     maxInterval,
+		currentInterval: cfg.PollInterval,
+		opsHTTPClient:   &http.Client{Timeout: opsAlertTimeout},
+	}
+
+	if cfg.HeartbeatURL != "" {
+		p.heartbeat = heartbeat.NewClient(heartbeat.Config{
+			URL:     cfg.HeartbeatURL,
+			Timeout: opsAlertTimeout,
+		})
 	}
 
 	// Initialize metrics
@@ -92,45 +318,217 @@ func (p *Poller) initMetrics() {
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to create poll errors metric")
 	}
+
+	p.registryServers, err = meter.Int64Gauge("poller.registry.server_count",
+		metric.WithDescription("Number of servers seen in the most recent successful poll"),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to create registry server count metric")
+	}
+
+	p.lastSuccessfulPoll, err = meter.Int64Gauge("poller.last_successful_poll_timestamp",
+		metric.WithDescription("Unix timestamp of the last successful poll"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to create last successful poll metric")
+	}
+
+	p.currentIntervalSeconds, err = meter.Float64Gauge("poller.interval_seconds",
+		metric.WithDescription("Current adaptive poll interval"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to create poll interval metric")
+	}
 }
 
-// Run starts the polling loop.
+// Run starts the polling loop. Unlike a fixed-interval ticker, the wait
+// between polls adapts to registry activity: see adjustInterval.
 func (p *Poller) Run(ctx context.Context) error {
 	log.Info().
 		Dur("interval", p.config.PollInterval).
+		Dur("max_interval", p.maxInterval).
 		Msg("Starting registry poller")
 
+	if p.config.BackfillOnStartup {
+		if err := p.Backfill(ctx); err != nil {
+			log.Error().Err(err).Msg("Startup backfill failed")
+			// Don't fail startup on a failed backfill; the regular poll loop
+			// below will still establish a fresh snapshot to diff against.
+		}
+	}
+
 	// Do initial poll immediately
 	if err := p.poll(ctx); err != nil {
 		log.Error().Err(err).Msg("Initial poll failed")
 		// Don't fail startup on initial poll failure
 	}
 
-	ticker := time.NewTicker(p.config.PollInterval)
-	defer ticker.Stop()
+	timer := time.NewTimer(p.nextInterval())
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			log.Info().Msg("Stopping registry poller")
 			return ctx.Err()
-		case <-ticker.C:
+		case <-timer.C:
 			if err := p.poll(ctx); err != nil {
 				log.Error().Err(err).Msg("Poll failed")
 				if p.pollErrors != nil {
 					p.pollErrors.Add(ctx, 1)
 				}
 			}
+			timer.Reset(p.nextInterval())
+		}
+	}
+}
+
+// Backfill diffs the registry's current listing against the latest snapshot
+// stored in the database (rather than p.lastSnapshot, which is always nil
+// right after a restart) so changes that happened while the process was down
+// are caught up instead of silently disappearing into poll's regular
+// "no previous snapshot" first-poll branch. Resulting changes are saved with
+// Backfilled set, and dispatched only if p.config.BackfillNotify is true.
+// A missing stored snapshot (fresh install) is not an error: there's nothing
+// to backfill against, so it's treated the same as no changes found.
+func (p *Poller) Backfill(ctx context.Context) error {
+	previousSnapshot, err := p.config.Database.GetLatestSnapshot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load latest snapshot for backfill: %w", err)
+	}
+	if previousSnapshot == nil {
+		log.Debug().Msg("No stored snapshot to backfill against, skipping")
+		return nil
+	}
+
+	servers, err := p.fetchServers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch servers for backfill: %w", err)
+	}
+
+	categorizeServers(servers)
+	if p.config.SecurityScorer != nil {
+		p.scoreServers(ctx, servers)
+	}
+	if p.config.ProvenanceVerifier != nil {
+		p.verifyProvenance(ctx, servers)
+	}
+
+	newSnapshot := p.diffEngine.CreateSnapshot(servers)
+
+	p.mu.Lock()
+	p.lastSnapshot = newSnapshot
+	p.lastPollTime = time.Now()
+	p.mu.Unlock()
+
+	if !p.diffEngine.HasChanges(previousSnapshot, newSnapshot) {
+		log.Debug().Msg("No changes found during startup backfill")
+		return nil
+	}
+
+	// Persist newSnapshot as the latest stored snapshot before enqueuing its
+	// changes/outbox entry below, the same order poll() uses. Otherwise a
+	// crash after the outbox enqueue but before the next poll's SaveSnapshot
+	// call would leave the stored "latest snapshot" as previousSnapshot, so
+	// a restart would recompute and re-enqueue the identical backfill diff
+	// under a fresh outbox entry ID, duplicating notifications every
+	// crash-loop restart.
+	if err := p.config.Database.SaveSnapshot(ctx, newSnapshot); err != nil {
+		log.Error().Err(err).Msg("Failed to save backfill snapshot, entering degraded mode")
+		p.enterDegraded(ctx)
+		p.bufferSnapshot(newSnapshot)
+	} else {
+		p.leaveDegraded(ctx)
+	}
+
+	diffResult := p.diffEngine.Compare(previousSnapshot, newSnapshot)
+	markBackfilled(diffResult.NewServers)
+	markBackfilled(diffResult.UpdatedServers)
+	markBackfilled(diffResult.RemovedServers)
+	markConfirmed(diffResult.NewServers)
+	markConfirmed(diffResult.UpdatedServers)
+	markConfirmed(diffResult.RemovedServers)
+
+	allChanges := append(append(diffResult.NewServers, diffResult.UpdatedServers...), diffResult.RemovedServers...)
+
+	log.Info().
+		Int("new", len(diffResult.NewServers)).
+		Int("updated", len(diffResult.UpdatedServers)).
+		Int("removed", len(diffResult.RemovedServers)).
+		Msg("Backfilled changes from before startup")
+
+	var outboxEntry *types.NotificationOutboxEntry
+	if p.config.BackfillNotify {
+		entry, err := buildNotificationOutboxEntry(diffResult)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to build backfilled notification outbox entry")
+		} else {
+			outboxEntry = entry
+		}
+	}
+
+	if err := p.config.Database.SaveChangesAndEnqueueNotification(ctx, allChanges, outboxEntry); err != nil {
+		log.Error().Err(err).Int("count", len(allChanges)).Msg("Failed to save backfilled changes")
+	}
+
+	return nil
+}
+
+// fetchServers fetches the full current listing, splitting the work across
+// ShardPrefixes when configured and supported by the client. Sharded fetches
+// bypass conditional (ErrNotModified) short-circuiting, since they don't
+// track a single listing-wide ETag/Last-Modified pair.
+func (p *Poller) fetchServers(ctx context.Context) ([]types.Server, error) {
+	if len(p.config.ShardPrefixes) > 0 {
+		if sharded, ok := p.config.Client.(shardedLister); ok {
+			return sharded.ListServersSharded(ctx, p.config.ShardPrefixes, p.config.ShardConcurrency)
 		}
 	}
+	return p.config.Client.ListServers(ctx)
+}
+
+// scoreServers annotates each server in place with its current
+// SecurityScore/Vulnerabilities. A failed lookup is logged and leaves that
+// server unscored rather than failing the poll.
+func (p *Poller) scoreServers(ctx context.Context, servers []types.Server) {
+	for i := range servers {
+		score, vulns, err := p.config.SecurityScorer.Score(ctx, servers[i])
+		if err != nil {
+			log.Warn().Err(err).Str("server", servers[i].Name).Msg("Failed to compute security score")
+			continue
+		}
+		servers[i].SecurityScore = &score
+		servers[i].Vulnerabilities = vulns
+	}
+}
+
+// verifyProvenance annotates each server in place with its current
+// Provenance results. A failed lookup is logged and leaves that server's
+// provenance unset rather than failing the poll.
+func (p *Poller) verifyProvenance(ctx context.Context, servers []types.Server) {
+	for i := range servers {
+		servers[i].Provenance = p.config.ProvenanceVerifier.Verify(ctx, servers[i])
+	}
+}
+
+// categorizeServers annotates each server in place with its derived
+// Tags (see internal/category). Unlike scoring and provenance, this is a
+// pure, local computation, so it always runs.
+func categorizeServers(servers []types.Server) {
+	for i := range servers {
+		servers[i].Tags = category.Categorize(servers[i])
+	}
 }
 
 // poll performs a single poll of the registry.
-func (p *Poller) poll(ctx context.Context) error {
+func (p *Poller) poll(ctx context.Context) (err error) {
 	ctx, span := tracer.Start(ctx, "poll")
 	defer span.End()
 
 	start := time.Now()
+	serverCount := 0
 	defer func() {
 		duration := time.Since(start).Seconds()
 		if p.pollDuration != nil {
@@ -139,19 +537,48 @@ func (p *Poller) poll(ctx context.Context) error {
 		if p.pollCount != nil {
 			p.pollCount.Add(ctx, 1)
 		}
+		p.recordPoll(ctx, start, err, serverCount)
+		p.pingHeartbeat(ctx, err)
+		p.checkStall(ctx, err)
 	}()
 
 	log.Debug().Msg("Starting registry poll")
 
 	// Fetch all servers from registry
-	servers, err := p.config.Client.ListServers(ctx)
+	servers, err := p.fetchServers(ctx)
+	if errors.Is(err, registry.ErrNotModified) {
+		log.Debug().Msg("Registry unchanged since last poll, skipping snapshot")
+		p.mu.RLock()
+		if p.lastSnapshot != nil {
+			serverCount = p.lastSnapshot.ServerCount
+		}
+		p.mu.RUnlock()
+		p.adjustInterval(ctx, false)
+		return nil
+	}
 	if err != nil {
 		span.RecordError(err)
 		return err
 	}
+	serverCount = len(servers)
+
+	categorizeServers(servers)
+	if p.config.SecurityScorer != nil {
+		p.scoreServers(ctx, servers)
+	}
+	if p.config.ProvenanceVerifier != nil {
+		p.verifyProvenance(ctx, servers)
+	}
 
 	span.SetAttributes(attribute.Int("server_count", len(servers)))
 
+	if p.registryServers != nil {
+		p.registryServers.Record(ctx, int64(len(servers)))
+	}
+	if p.lastSuccessfulPoll != nil {
+		p.lastSuccessfulPoll.Record(ctx, time.Now().Unix())
+	}
+
 	// Create new snapshot
 	newSnapshot := p.diffEngine.CreateSnapshot(servers)
 
@@ -186,16 +613,39 @@ func (p *Poller) poll(ctx context.Context) error {
 		}
 	}
 
+	if previousSnapshot != nil && len(diffResult.NewServers) > 0 {
+		typosquats := p.detectTyposquats(ctx, diffResult.NewServers)
+		diffResult.NewServers = append(diffResult.NewServers, typosquats...)
+		diffResult.TotalChanges += len(typosquats)
+	}
+
+	if p.config.Summarizer != nil {
+		p.summarizeChanges(ctx, diffResult.UpdatedServers)
+	}
+
 	// Store snapshot
 	p.mu.Lock()
 	p.lastSnapshot = newSnapshot
 	p.lastPollTime = time.Now()
 	p.mu.Unlock()
 
-	// Save snapshot to database
+	p.adjustInterval(ctx, diffResult.TotalChanges > 0)
+
+	// Save snapshot to database. The in-memory copy above already lets the
+	// poller keep running against p.lastSnapshot regardless of this outcome;
+	// on failure, buffer it for replay instead of losing it outright.
 	if err := p.config.Database.SaveSnapshot(ctx, newSnapshot); err != nil {
-		log.Error().Err(err).Msg("Failed to save snapshot")
-		// Continue anyway - this is not critical
+		log.Error().Err(err).Msg("Failed to save snapshot, entering degraded mode")
+		p.enterDegraded(ctx)
+		p.bufferSnapshot(newSnapshot)
+	} else {
+		p.leaveDegraded(ctx)
+	}
+
+	if p.config.Cache != nil {
+		if err := p.config.Cache.SetCachedSnapshot(ctx, newSnapshot, cachedSnapshotTTL); err != nil {
+			log.Warn().Err(err).Msg("Failed to cache snapshot")
+		}
 	}
 
 	// Process changes if any
@@ -204,17 +654,75 @@ func (p *Poller) poll(ctx context.Context) error {
 			p.changesDetected.Add(ctx, int64(diffResult.TotalChanges))
 		}
 
-		// Save changes to database
+		// Verify (or mark confirmed) and save changes to database
+		if p.config.VerifyChanges {
+			p.verifyChangeSlice(ctx, diffResult.NewServers)
+			p.verifyChangeSlice(ctx, diffResult.UpdatedServers)
+			p.verifyChangeSlice(ctx, diffResult.RemovedServers)
+		} else {
+			markConfirmed(diffResult.NewServers)
+			markConfirmed(diffResult.UpdatedServers)
+			markConfirmed(diffResult.RemovedServers)
+		}
+
+		// Stamp this poll's trace context onto every change it detected, so
+		// a dispatch that happens on a later notification outbox flush can
+		// still continue (or at least link back to) this same trace.
+		stampTraceContext(ctx, diffResult.NewServers)
+		stampTraceContext(ctx, diffResult.UpdatedServers)
+		stampTraceContext(ctx, diffResult.RemovedServers)
+
 		allChanges := append(append(diffResult.NewServers, diffResult.UpdatedServers...), diffResult.RemovedServers...)
 		for _, change := range allChanges {
-			if err := p.config.Database.SaveChange(ctx, &change); err != nil {
-				log.Error().Err(err).Str("server", change.ServerName).Msg("Failed to save change")
+			if !change.Confirmed {
+				log.Warn().Str("server", change.ServerName).Str("change_type", string(change.ChangeType)).
+					Msg("Change did not survive verification replay, skipping dispatch")
 			}
 		}
 
-		// Dispatch notifications
-		if err := p.dispatchNotifications(ctx, diffResult); err != nil {
-			log.Error().Err(err).Msg("Failed to dispatch notifications")
+		// Only dispatch changes that survived verification.
+		dispatchDiff := &types.DiffResult{
+			FromSnapshot:   diffResult.FromSnapshot,
+			ToSnapshot:     diffResult.ToSnapshot,
+			NewServers:     confirmedOnly(diffResult.NewServers),
+			UpdatedServers: confirmedOnly(diffResult.UpdatedServers),
+			RemovedServers: confirmedOnly(diffResult.RemovedServers),
+		}
+		dispatchDiff.TotalChanges = len(dispatchDiff.NewServers) + len(dispatchDiff.UpdatedServers) + len(dispatchDiff.RemovedServers)
+
+		// Build the outbox entry (if any) before saving, so it can be
+		// written in the same transaction as allChanges below instead of a
+		// separate round trip that could be lost to a crash in between.
+		var outboxEntry *types.NotificationOutboxEntry
+		if dispatchDiff.TotalChanges > 0 {
+			entry, err := buildNotificationOutboxEntry(dispatchDiff)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to build notification outbox entry")
+			} else {
+				outboxEntry = entry
+			}
+		}
+
+		if err := p.config.Database.SaveChangesAndEnqueueNotification(ctx, allChanges, outboxEntry); err != nil {
+			log.Error().Err(err).Int("count", len(allChanges)).Msg("Failed to save changes, entering degraded mode")
+			p.enterDegraded(ctx)
+			for _, change := range allChanges {
+				p.bufferChange(change)
+			}
+		} else {
+			p.leaveDegraded(ctx)
+			for _, change := range allChanges {
+				if p.config.TransparencyLog != nil {
+					if _, err := p.config.TransparencyLog.Append(ctx, &change); err != nil {
+						log.Error().Err(err).Str("server", change.ServerName).Msg("Failed to append change to transparency log")
+					}
+				}
+				if p.config.EventBus != nil {
+					if err := p.config.EventBus.Enqueue(ctx, &change); err != nil {
+						log.Error().Err(err).Str("server", change.ServerName).Msg("Failed to enqueue change for event bus delivery")
+					}
+				}
+			}
 		}
 	}
 
@@ -226,6 +734,196 @@ func (p *Poller) poll(ctx context.Context) error {
 	return nil
 }
 
+// summarizeChanges populates AISummary on each update with a short
+// natural-language description of its field changes, so digests and
+// notifications can show a sentence instead of a raw field diff. Mutates
+// changes in place by index; a summarization failure is logged and skipped
+// rather than blocking the change from being saved/dispatched.
+func (p *Poller) summarizeChanges(ctx context.Context, changes []types.Change) {
+	for i := range changes {
+		if len(changes[i].FieldChanges) == 0 {
+			continue
+		}
+		summary, err := p.config.Summarizer.Summarize(ctx, changes[i].ServerName, changes[i].FieldChanges)
+		if err != nil {
+			log.Warn().Err(err).Str("server", changes[i].ServerName).Msg("Failed to generate change summary")
+			continue
+		}
+		changes[i].AISummary = summary
+	}
+}
+
+// detectTyposquats compares each newly registered server's name against
+// every active subscription's watched server names and raises a synthetic
+// ChangeTypeTyposquatSuspected change for any that come back suspiciously
+// close, so maintainers learn about a lookalike registration even though
+// it's a different server name than the one they're actually watching.
+func (p *Poller) detectTyposquats(ctx context.Context, newServers []types.Change) []types.Change {
+	subscriptions, err := p.config.SubscriptionMgr.GetActiveSubscriptions(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get subscriptions for typosquat detection")
+		return nil
+	}
+
+	watchedSet := make(map[string]struct{})
+	for _, sub := range subscriptions {
+		for _, name := range sub.Filters.Servers {
+			watchedSet[name] = struct{}{}
+		}
+	}
+	if len(watchedSet) == 0 {
+		return nil
+	}
+	watched := make([]string, 0, len(watchedSet))
+	for name := range watchedSet {
+		watched = append(watched, name)
+	}
+
+	var flagged []types.Change
+	for _, change := range newServers {
+		match, ok := typosquat.Detect(change.ServerName, watched)
+		if !ok {
+			continue
+		}
+
+		reason := fmt.Sprintf("newly registered server %q closely resembles watched server %q (edit distance %d)",
+			change.ServerName, match.WatchedName, match.Distance)
+		if match.Homoglyph {
+			reason = fmt.Sprintf("newly registered server %q is a homoglyph of watched server %q",
+				change.ServerName, match.WatchedName)
+		}
+
+		flagged = append(flagged, types.Change{
+			ID:                uuid.New(),
+			SnapshotID:        change.SnapshotID,
+			ServerName:        change.ServerName,
+			ChangeType:        types.ChangeTypeTyposquatSuspected,
+			NewVersion:        change.NewVersion,
+			Server:            change.Server,
+			Severity:          types.SeverityMajor,
+			DetectedAt:        time.Now().UTC(),
+			Suspicious:        true,
+			SuspiciousReasons: []string{reason},
+		})
+
+		log.Warn().
+			Str("server", change.ServerName).
+			Str("watched", match.WatchedName).
+			Int("distance", match.Distance).
+			Msg("Suspected typosquat registration detected")
+	}
+
+	return flagged
+}
+
+// recordPoll persists a record of this poll attempt so monitoring gaps
+// (the poller stalled, or every attempt in a window failed) can later be
+// computed from consecutive records instead of looking like "nothing
+// changed". Failures to record are logged but never fail the poll itself.
+func (p *Poller) recordPoll(ctx context.Context, startedAt time.Time, pollErr error, serverCount int) {
+	record := &types.PollRecord{
+		ID:          uuid.New(),
+		StartedAt:   startedAt,
+		CompletedAt: time.Now(),
+		Success:     pollErr == nil,
+		ServerCount: serverCount,
+	}
+	if pollErr != nil {
+		record.Error = pollErr.Error()
+	}
+
+	if err := p.config.Database.RecordPoll(ctx, record); err != nil {
+		log.Error().Err(err).Msg("Failed to record poll history")
+	}
+}
+
+// pingHeartbeat notifies the configured dead-man's-switch monitor of this
+// poll's outcome, so an external service notices if polling stops or starts
+// failing even if this process's own alerting (checkStall, logs) never
+// fires because the process itself has died or deadlocked.
+func (p *Poller) pingHeartbeat(ctx context.Context, pollErr error) {
+	if p.heartbeat == nil {
+		return
+	}
+
+	var err error
+	if pollErr == nil {
+		err = p.heartbeat.Success(ctx)
+	} else {
+		err = p.heartbeat.Fail(ctx)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to send heartbeat ping")
+	}
+}
+
+// checkStall tracks time since the last successful poll and alerts
+// config.OpsWebhookURL on the edge transitions into and out of a stall, so
+// operators are told once a registry that's failing every poll (a token
+// expired, the registry moved) rather than on every failed poll after that.
+func (p *Poller) checkStall(ctx context.Context, pollErr error) {
+	if p.config.StallThreshold <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	if pollErr == nil {
+		p.lastSuccessAt = time.Now()
+	}
+	lastSuccess := p.lastSuccessAt
+	wasStalled := p.stalled
+	stalled := !lastSuccess.IsZero() && time.Since(lastSuccess) > p.config.StallThreshold
+	p.stalled = stalled
+	p.mu.Unlock()
+
+	switch {
+	case stalled && !wasStalled:
+		p.alertOps(ctx, fmt.Sprintf(
+			"Registry poller has not completed a successful poll in over %s (last success: %s).",
+			p.config.StallThreshold, lastSuccess.Format(time.RFC3339),
+		))
+	case !stalled && wasStalled:
+		p.alertOps(ctx, "Registry poller has recovered and is polling successfully again.")
+	}
+}
+
+// alertOps posts a plain-text alert to config.OpsWebhookURL, if configured,
+// using a bare {"text": "..."} payload — the lowest common denominator most
+// chat webhook receivers, including Slack's, accept. This bypasses the
+// notifier/dispatcher stack entirely since a stall alert has no owning
+// subscription or channel record to persist delivery stats against.
+func (p *Poller) alertOps(ctx context.Context, message string) {
+	log.Warn().Msg(message)
+
+	if p.config.OpsWebhookURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(map[string]string{"text": message})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal ops alert payload")
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.OpsWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to build ops alert request")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.opsHTTPClient.Do(req)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to send ops alert")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		log.Error().Int("status", resp.StatusCode).Msg("Ops alert webhook returned non-2xx status")
+	}
+}
+
 // dispatchNotifications sends notifications for detected changes.
 func (p *Poller) dispatchNotifications(ctx context.Context, diffResult *types.DiffResult) error {
 	ctx, span := tracer.Start(ctx, "dispatchNotifications")
@@ -241,6 +939,30 @@ func (p *Poller) dispatchNotifications(ctx context.Context, diffResult *types.Di
 
 	// For each subscription, filter changes and dispatch
 	for _, sub := range subscriptions {
+		if sub.WarmUpUntil != nil {
+			now := time.Now()
+			if now.Before(*sub.WarmUpUntil) {
+				// Still warming up: suppress normal dispatch entirely.
+				continue
+			}
+			if !sub.WarmUpSummarySent {
+				if err := p.sendWarmUpSummary(ctx, &sub); err != nil {
+					log.Error().Err(err).Str("subscription", sub.ID.String()).Msg("Failed to send warm-up summary")
+				}
+				// The summary already covers everything up to now; resume
+				// normal per-change delivery starting next poll.
+				continue
+			}
+		}
+
+		if sub.OrgID != nil && p.config.OrganizationMgr != nil {
+			if err := p.config.OrganizationMgr.CheckNotificationQuota(ctx, *sub.OrgID); err != nil {
+				log.Warn().Err(err).Str("subscription", sub.ID.String()).Str("org", sub.OrgID.String()).
+					Msg("Organization notification quota reached, skipping dispatch")
+				continue
+			}
+		}
+
 		// Filter changes based on subscription filters
 		filteredResult := diff.FilterChanges(diffResult, sub.Filters)
 
@@ -255,13 +977,56 @@ func (p *Poller) dispatchNotifications(ctx context.Context, diffResult *types.Di
 			filteredResult.RemovedServers...,
 		)
 
-		// Dispatch to each channel
-		for _, channel := range sub.Channels {
-			if !channel.Enabled {
+		// If the subscription's delivery schedule is closed (quiet hours or
+		// an off day), hold every matched change instead of dispatching it;
+		// the digest scheduler flushes held notifications as a batch once
+		// the window reopens.
+		if !sub.DeliverySchedule.InWindow(time.Now()) {
+			for _, change := range allChanges {
+				held := &types.HeldNotification{
+					ID:             uuid.New(),
+					SubscriptionID: sub.ID,
+					ChangeID:       change.ID,
+					CreatedAt:      time.Now().UTC(),
+				}
+				if err := p.config.Database.SaveHeldNotification(ctx, held); err != nil {
+					log.Error().
+						Err(err).
+						Str("subscription", sub.ID.String()).
+						Str("server", change.ServerName).
+						Msg("Failed to hold notification for closed delivery window")
+				}
+			}
+			continue
+		}
+
+		// Dispatch each change to the channels its namespace routes to (or
+		// every channel, for subscriptions without a routing map).
+		for _, change := range allChanges {
+			// Debounce rapid successive updates to the same server instead
+			// of dispatching each one immediately; the digest scheduler
+			// flushes the combined burst once CoalesceWindow elapses with
+			// no further update. New/removed changes are never coalesced.
+			if change.ChangeType == types.ChangeTypeUpdated && sub.CoalesceWindow > 0 {
+				if err := p.config.Database.UpsertCoalescedUpdate(ctx, sub.ID, sub.CoalesceWindow, &change); err != nil {
+					log.Error().
+						Err(err).
+						Str("subscription", sub.ID.String()).
+						Str("server", change.ServerName).
+						Msg("Failed to record coalesced update")
+				}
 				continue
 			}
 
-			for _, change := range allChanges {
+			for _, channel := range p.config.SubscriptionMgr.ResolveChannels(&sub, &change) {
+				if !channel.Enabled {
+					continue
+				}
+
+				if !p.config.Dispatcher.Sampler.Allow(&channel, &change) {
+					continue
+				}
+
 				if err := p.config.Dispatcher.Dispatch(ctx, &channel, &change); err != nil {
 					log.Error().
 						Err(err).
@@ -282,6 +1047,338 @@ func (p *Poller) dispatchNotifications(ctx context.Context, diffResult *types.Di
 	return nil
 }
 
+// notificationOutboxPayload is the outbox row's stored form of a dispatch
+// batch: just the changes to dispatch, since FromSnapshot/ToSnapshot aren't
+// used by dispatchNotifications' filtering and would needlessly bloat the
+// row with a full server listing.
+type notificationOutboxPayload struct {
+	NewServers     []types.Change `json:"new_servers"`
+	UpdatedServers []types.Change `json:"updated_servers"`
+	RemovedServers []types.Change `json:"removed_servers"`
+}
+
+// buildNotificationOutboxEntry marshals diffResult's changes into a
+// notification outbox row instead of dispatching them inline, so a crash
+// between saving a change and dispatching its notification can't silently
+// drop the notification: the row is just picked up by the next outbox
+// flush. It does not itself write the row — the caller saves it in the
+// same transaction as the underlying Changes, via
+// db.Database.SaveChangesAndEnqueueNotification.
+func buildNotificationOutboxEntry(diffResult *types.DiffResult) (*types.NotificationOutboxEntry, error) {
+	payload, err := json.Marshal(notificationOutboxPayload{
+		NewServers:     diffResult.NewServers,
+		UpdatedServers: diffResult.UpdatedServers,
+		RemovedServers: diffResult.RemovedServers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notification outbox payload: %w", err)
+	}
+
+	return &types.NotificationOutboxEntry{
+		ID:          uuid.New(),
+		Payload:     payload,
+		NextAttempt: time.Now(),
+	}, nil
+}
+
+// ProcessNotificationOutbox dispatches a batch of due notification outbox
+// entries, deleting each once dispatchNotifications completes without
+// error. A failed dispatch reschedules that entry with a backoff
+// proportional to its attempt count instead of blocking the rest of the
+// batch, mirroring internal/eventbus's outbox relay.
+func (p *Poller) ProcessNotificationOutbox(ctx context.Context) error {
+	entries, err := p.config.Database.GetPendingNotificationOutboxEntries(ctx, notificationOutboxBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to load pending notification outbox entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	log.Debug().Int("count", len(entries)).Msg("Flushing notification outbox")
+
+	for i := range entries {
+		entry := entries[i]
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		var payload notificationOutboxPayload
+		if err := json.Unmarshal(entry.Payload, &payload); err != nil {
+			log.Error().Err(err).Str("entry_id", entry.ID.String()).Msg("Failed to unmarshal notification outbox payload, discarding")
+			if delErr := p.config.Database.DeleteNotificationOutboxEntry(ctx, entry.ID); delErr != nil {
+				log.Error().Err(delErr).Str("entry_id", entry.ID.String()).Msg("Failed to delete unreadable notification outbox entry")
+			}
+			continue
+		}
+
+		diffResult := &types.DiffResult{
+			NewServers:     payload.NewServers,
+			UpdatedServers: payload.UpdatedServers,
+			RemovedServers: payload.RemovedServers,
+		}
+		diffResult.TotalChanges = len(diffResult.NewServers) + len(diffResult.UpdatedServers) + len(diffResult.RemovedServers)
+
+		// Continue the poll trace that detected these changes rather than
+		// starting a disconnected one, so a single trace covers poll, diff,
+		// subscription matching and dispatch even though the actual send
+		// happens on a later outbox flush. All changes in one entry were
+		// stamped from the same poll, so the first one's trace context
+		// speaks for the whole batch.
+		dispatchCtx := ctx
+		if tc := firstTraceContext(diffResult); tc != "" {
+			dispatchCtx = telemetry.ExtractTraceContext(ctx, tc)
+		}
+
+		if err := p.dispatchNotifications(dispatchCtx, diffResult); err != nil {
+			backoff := time.Duration(entry.Attempts+1) * time.Second
+			if backoff > maxNotificationRetryBackoff {
+				backoff = maxNotificationRetryBackoff
+			}
+			if markErr := p.config.Database.MarkNotificationOutboxEntryFailed(ctx, entry.ID, time.Now().Add(backoff), err.Error()); markErr != nil {
+				log.Error().Err(markErr).Str("entry_id", entry.ID.String()).Msg("Failed to record notification outbox dispatch failure")
+			}
+			log.Warn().Err(err).Str("entry_id", entry.ID.String()).Msg("Failed to dispatch notification batch, will retry")
+			continue
+		}
+
+		if err := p.config.Database.DeleteNotificationOutboxEntry(ctx, entry.ID); err != nil {
+			log.Error().Err(err).Str("entry_id", entry.ID.String()).Msg("Failed to delete dispatched notification outbox entry")
+		}
+	}
+
+	return nil
+}
+
+// RunNotificationOutbox drains the notification outbox on a ticker until
+// ctx is canceled, mirroring internal/eventbus.Bus.Run.
+func (p *Poller) RunNotificationOutbox(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = defaultNotificationOutboxInterval
+	}
+	log.Info().Dur("interval", interval).Msg("Starting notification outbox flusher")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := p.ProcessNotificationOutbox(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to process notification outbox")
+			}
+		}
+	}
+}
+
+// sendWarmUpSummary delivers a subscription's post-warm-up catch-up
+// notification: a single aggregate message covering every change matching
+// its filters since it was created, in place of the individual
+// notifications that were suppressed during warm-up.
+func (p *Poller) sendWarmUpSummary(ctx context.Context, sub *types.Subscription) error {
+	changes, err := p.config.Database.GetChangesSince(ctx, sub.CreatedAt, 1000)
+	if err != nil {
+		return fmt.Errorf("failed to load changes for warm-up summary: %w", err)
+	}
+
+	var newCount, updatedCount, removedCount int
+	for _, change := range changes {
+		if !p.config.SubscriptionMgr.MatchesFilters(&change, sub.Filters) {
+			continue
+		}
+		switch change.ChangeType {
+		case types.ChangeTypeNew:
+			newCount++
+		case types.ChangeTypeUpdated:
+			updatedCount++
+		case types.ChangeTypeRemoved:
+			removedCount++
+		}
+	}
+
+	summary := types.Change{
+		ServerName: sub.Name,
+		ChangeType: types.ChangeTypeSummary,
+		Server: &types.Server{
+			Description: fmt.Sprintf(
+				"Here's what happened since you subscribed: %d new, %d updated, %d removed.",
+				newCount, updatedCount, removedCount,
+			),
+		},
+		DetectedAt: time.Now(),
+		Confirmed:  true,
+	}
+
+	for _, channel := range sub.Channels {
+		if !channel.Enabled {
+			continue
+		}
+		if err := p.config.Dispatcher.Dispatch(ctx, &channel, &summary); err != nil {
+			log.Error().
+				Err(err).
+				Str("subscription", sub.ID.String()).
+				Str("channel", string(channel.Type)).
+				Msg("Failed to dispatch warm-up summary")
+		}
+	}
+
+	return p.config.SubscriptionMgr.MarkWarmUpSummarySent(ctx, sub.ID)
+}
+
+// verifyChangeSlice re-fetches each change's server after
+// p.config.VerificationDelay and sets its Confirmed field in place.
+func (p *Poller) verifyChangeSlice(ctx context.Context, changes []types.Change) {
+	for i := range changes {
+		changes[i].Confirmed = p.verifyChange(ctx, &changes[i])
+	}
+}
+
+// verifyChange waits out the configured verification delay, then re-fetches
+// the server to confirm the detected change wasn't a transient registry
+// listing glitch (most valuable for removals, which are otherwise
+// irreversible once notified). Adapter errors fail open (confirmed=true) so
+// a flaky verification fetch doesn't silently swallow a real change.
+func (p *Poller) verifyChange(ctx context.Context, change *types.Change) bool {
+	if p.config.VerificationDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return true
+		case <-time.After(p.config.VerificationDelay):
+		}
+	}
+
+	server, err := p.config.Client.GetServer(ctx, change.ServerName)
+	if err != nil {
+		log.Warn().Err(err).Str("server", change.ServerName).Msg("Verification re-fetch failed, keeping change unconfirmed-checked")
+		return true
+	}
+
+	switch change.ChangeType {
+	case types.ChangeTypeRemoved:
+		return server == nil
+	default:
+		return server != nil
+	}
+}
+
+func markConfirmed(changes []types.Change) {
+	for i := range changes {
+		changes[i].Confirmed = true
+	}
+}
+
+// stampTraceContext records ctx's current span as each change's
+// TraceContext, so a dispatch on a later notification outbox flush can
+// continue (or link back to) the trace that detected it.
+func stampTraceContext(ctx context.Context, changes []types.Change) {
+	traceContext := telemetry.InjectTraceContext(ctx)
+	if traceContext == "" {
+		return
+	}
+	for i := range changes {
+		changes[i].TraceContext = traceContext
+	}
+}
+
+// markBackfilled flags each change as produced by Backfill rather than a
+// regular poll; see types.Change.Backfilled.
+func markBackfilled(changes []types.Change) {
+	for i := range changes {
+		changes[i].Backfilled = true
+	}
+}
+
+// firstTraceContext returns the TraceContext of the first change in result
+// that has one, or "" if none do.
+func firstTraceContext(result *types.DiffResult) string {
+	for _, group := range [][]types.Change{result.NewServers, result.UpdatedServers, result.RemovedServers} {
+		for _, c := range group {
+			if c.TraceContext != "" {
+				return c.TraceContext
+			}
+		}
+	}
+	return ""
+}
+
+func confirmedOnly(changes []types.Change) []types.Change {
+	out := make([]types.Change, 0, len(changes))
+	for _, c := range changes {
+		if c.Confirmed {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// UpdateInterval applies a new poll interval floor and ceiling from a
+// reloaded configuration, so operators can tighten or relax polling without
+// restarting the process. It clamps the same way New does, and resets the
+// current adaptive interval to the new floor whenever it falls outside the
+// new floor/ceiling range, so a raised PollInterval or a lowered
+// MaxPollInterval takes effect on the very next poll. A lowered PollInterval
+// alone leaves the current interval as it is until activity resets it.
+func (p *Poller) UpdateInterval(pollInterval, maxPollInterval time.Duration) {
+	maxInterval := maxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+	if maxInterval < pollInterval {
+		maxInterval = pollInterval
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config.PollInterval = pollInterval
+	p.config.MaxPollInterval = maxPollInterval
+	p.maxInterval = maxInterval
+	if p.currentInterval < pollInterval || p.currentInterval > maxInterval {
+		p.currentInterval = pollInterval
+	}
+}
+
+// nextInterval returns the interval to wait before the next poll.
+func (p *Poller) nextInterval() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.currentInterval
+}
+
+// adjustInterval adapts the poll interval to registry activity: it snaps
+// back to the configured floor as soon as changes are seen, so activity is
+// caught quickly, and lengthens toward maxInterval after quietPollsBeforeGrowth
+// consecutive quiet polls, so a registry that's gone quiet is polled less
+// often. Hysteresis on the growth side avoids oscillating the interval
+// around a registry that changes every other poll.
+func (p *Poller) adjustInterval(ctx context.Context, hasChanges bool) {
+	p.mu.Lock()
+	if hasChanges {
+		p.currentInterval = p.config.PollInterval
+		p.quietStreak = 0
+	} else {
+		p.quietStreak++
+		if p.quietStreak >= quietPollsBeforeGrowth {
+			p.quietStreak = 0
+			grown := time.Duration(float64(p.currentInterval) * growthFactor)
+			if grown > p.maxInterval {
+				grown = p.maxInterval
+			}
+			p.currentInterval = grown
+		}
+	}
+	interval := p.currentInterval
+	p.mu.Unlock()
+
+	if p.currentIntervalSeconds != nil {
+		p.currentIntervalSeconds.Record(ctx, interval.Seconds())
+	}
+}
+
 // GetLastSnapshot returns the most recent snapshot.
 func (p *Poller) GetLastSnapshot() *types.Snapshot {
 	p.mu.RLock()
@@ -289,6 +1386,95 @@ func (p *Poller) GetLastSnapshot() *types.Snapshot {
 	return p.lastSnapshot
 }
 
+// Degraded reports whether the poller is currently buffering writes because
+// the database is unreachable. The API surfaces this so a client can tell a
+// deliberately empty response apart from one served from a stale fallback.
+func (p *Poller) Degraded() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.degraded
+}
+
+// enterDegraded marks the poller as degraded and alerts ops on the
+// transition into that state, so a single database blip doesn't spam the
+// ops webhook once per failed write.
+func (p *Poller) enterDegraded(ctx context.Context) {
+	p.mu.Lock()
+	wasDegraded := p.degraded
+	p.degraded = true
+	p.mu.Unlock()
+
+	if !wasDegraded {
+		p.alertOps(ctx, "Database unreachable: poller is now buffering writes and serving stats from its in-memory snapshot.")
+	}
+}
+
+// leaveDegraded clears degraded mode and replays any writes buffered while
+// the database was unreachable, in the order they were buffered. Replay
+// failures are logged and left unbuffered rather than retried again, since a
+// write that fails immediately after the database reports itself reachable
+// is more likely a bad record than another outage.
+func (p *Poller) leaveDegraded(ctx context.Context) {
+	p.mu.Lock()
+	wasDegraded := p.degraded
+	p.degraded = false
+	snapshots := p.bufferedSnapshots
+	changes := p.bufferedChanges
+	p.bufferedSnapshots = nil
+	p.bufferedChanges = nil
+	p.mu.Unlock()
+
+	if !wasDegraded {
+		return
+	}
+
+	log.Info().
+		Int("snapshots", len(snapshots)).
+		Int("changes", len(changes)).
+		Msg("Database reachable again, replaying buffered writes")
+
+	for _, snapshot := range snapshots {
+		if err := p.config.Database.SaveSnapshot(ctx, snapshot); err != nil {
+			log.Error().Err(err).Msg("Failed to replay buffered snapshot")
+		}
+	}
+	for i := range changes {
+		if err := p.config.Database.SaveChange(ctx, &changes[i]); err != nil {
+			log.Error().Err(err).Str("server", changes[i].ServerName).Msg("Failed to replay buffered change")
+		}
+	}
+
+	p.alertOps(ctx, "Database reachable again: replayed buffered writes, exiting degraded mode.")
+}
+
+// bufferSnapshot buffers a snapshot that failed to save for later replay,
+// dropping the oldest buffered snapshot once maxBufferedSnapshots is
+// reached rather than growing without bound.
+func (p *Poller) bufferSnapshot(snapshot *types.Snapshot) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.bufferedSnapshots) >= maxBufferedSnapshots {
+		log.Warn().Msg("Degraded-mode snapshot buffer full, dropping oldest buffered snapshot")
+		p.bufferedSnapshots = p.bufferedSnapshots[1:]
+	}
+	p.bufferedSnapshots = append(p.bufferedSnapshots, snapshot)
+}
+
+// bufferChange buffers a change that failed to save for later replay,
+// dropping the oldest buffered change once maxBufferedChanges is reached
+// rather than growing without bound.
+func (p *Poller) bufferChange(change types.Change) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.bufferedChanges) >= maxBufferedChanges {
+		log.Warn().Msg("Degraded-mode change buffer full, dropping oldest buffered change")
+		p.bufferedChanges = p.bufferedChanges[1:]
+	}
+	p.bufferedChanges = append(p.bufferedChanges, change)
+}
+
 // GetStats returns current poller statistics.
 func (p *Poller) GetStats(ctx context.Context) (*PollerStats, error) {
 	p.mu.RLock()
@@ -0,0 +1,128 @@
+package poller
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// fakeBackfillClient is a minimal registry.Adapter returning a fixed server
+// listing, enough to drive Backfill's fetch/diff without a real registry.
+type fakeBackfillClient struct {
+	servers []types.Server
+}
+
+func (f *fakeBackfillClient) ListServers(ctx context.Context) ([]types.Server, error) {
+	return f.servers, nil
+}
+
+func (f *fakeBackfillClient) GetServer(ctx context.Context, name string) (*types.Server, error) {
+	return nil, nil
+}
+
+func (f *fakeBackfillClient) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// fakeBackfillDB embeds db.Database (nil) so it satisfies the interface,
+// overriding only the methods Backfill actually calls, and records the
+// order SaveSnapshot/SaveChangesAndEnqueueNotification are invoked in so
+// tests can assert the snapshot is persisted first.
+type fakeBackfillDB struct {
+	db.Database
+
+	mu       sync.Mutex
+	latest   *types.Snapshot
+	calls    []string
+	entryIDs []string
+}
+
+func (f *fakeBackfillDB) GetLatestSnapshot(ctx context.Context) (*types.Snapshot, error) {
+	return f.latest, nil
+}
+
+func (f *fakeBackfillDB) SaveSnapshot(ctx context.Context, snapshot *types.Snapshot) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "SaveSnapshot")
+	f.latest = snapshot
+	return nil
+}
+
+func (f *fakeBackfillDB) SaveChangesAndEnqueueNotification(ctx context.Context, changes []types.Change, entry *types.NotificationOutboxEntry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "SaveChangesAndEnqueueNotification")
+	if entry != nil {
+		f.entryIDs = append(f.entryIDs, entry.ID.String())
+	}
+	return nil
+}
+
+func newBackfillPoller(fakeDB *fakeBackfillDB, client *fakeBackfillClient) *Poller {
+	return New(Config{
+		Client:         client,
+		Database:       fakeDB,
+		BackfillNotify: true,
+	})
+}
+
+// TestBackfill_PersistsSnapshotBeforeEnqueueingNotification ensures a
+// crash-loop restart can't re-derive and re-enqueue the same backfilled
+// diff twice: SaveSnapshot must land before the outbox entry is built, so a
+// restart sees the new snapshot as the latest one instead of recomputing an
+// identical diff against a stale one.
+func TestBackfill_PersistsSnapshotBeforeEnqueueingNotification(t *testing.T) {
+	previous := &types.Snapshot{
+		Hash:    "previous-hash",
+		Servers: map[string]types.Server{"server-a": {Name: "server-a"}},
+	}
+	fakeDB := &fakeBackfillDB{latest: previous}
+	client := &fakeBackfillClient{servers: []types.Server{{Name: "server-a"}, {Name: "server-b"}}}
+	p := newBackfillPoller(fakeDB, client)
+
+	require.NoError(t, p.Backfill(context.Background()))
+
+	require.Equal(t, []string{"SaveSnapshot", "SaveChangesAndEnqueueNotification"}, fakeDB.calls)
+	assert.NotNil(t, fakeDB.latest)
+	assert.NotEqual(t, "previous-hash", fakeDB.latest.Hash)
+}
+
+// TestBackfill_RestartAfterCrashDoesNotReenqueue simulates a crash right
+// after Backfill returns (before Run's first poll would have run): a
+// second Backfill against the same registry listing must see no changes,
+// since the first Backfill already persisted the new snapshot as latest.
+func TestBackfill_RestartAfterCrashDoesNotReenqueue(t *testing.T) {
+	previous := &types.Snapshot{
+		Hash:    "previous-hash",
+		Servers: map[string]types.Server{"server-a": {Name: "server-a"}},
+	}
+	fakeDB := &fakeBackfillDB{latest: previous}
+	client := &fakeBackfillClient{servers: []types.Server{{Name: "server-a"}, {Name: "server-b"}}}
+
+	first := newBackfillPoller(fakeDB, client)
+	require.NoError(t, first.Backfill(context.Background()))
+	require.Len(t, fakeDB.entryIDs, 1)
+
+	// Simulate the process restarting: a fresh Poller with no in-memory
+	// state, reading whatever GetLatestSnapshot now returns.
+	second := newBackfillPoller(fakeDB, client)
+	require.NoError(t, second.Backfill(context.Background()))
+
+	assert.Len(t, fakeDB.entryIDs, 1, "restart re-diffed against a stale snapshot and enqueued a duplicate notification")
+}
+
+func TestBackfill_NoStoredSnapshotSkips(t *testing.T) {
+	fakeDB := &fakeBackfillDB{}
+	client := &fakeBackfillClient{servers: []types.Server{{Name: "server-a"}}}
+	p := newBackfillPoller(fakeDB, client)
+
+	require.NoError(t, p.Backfill(context.Background()))
+	assert.Empty(t, fakeDB.calls)
+}
@@ -0,0 +1,197 @@
+// Package provenance checks whether a server's declared npm/PyPI packages
+// have a published Sigstore/SLSA provenance attestation, by querying the
+// package registry's own attestation-listing endpoint for an entry with a
+// matching predicateType.
+//
+// This is NOT cryptographic verification: it doesn't fetch the
+// attestation's Sigstore bundle, check Rekor transparency-log inclusion,
+// validate a Fulcio certificate chain, or confirm the attestation's subject
+// digest matches the actual package artifact. A compromised or simply
+// buggy registry response is trusted as-is. Treat ProvenanceStatus as "an
+// attestation was published," not "this package's build was verified."
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// defaultNPMRegistryURL and defaultPyPIRegistryURL are the public registries
+// used when Config leaves the corresponding field empty.
+const (
+	defaultNPMRegistryURL  = "https://registry.npmjs.org"
+	defaultPyPIRegistryURL = "https://pypi.org"
+)
+
+// Config holds provenance verifier configuration.
+type Config struct {
+	// NPMRegistryURL is the npm registry to query attestations from.
+	// Defaults to https://registry.npmjs.org.
+	NPMRegistryURL string
+
+	// PyPIRegistryURL is the PyPI instance to query attestations from.
+	// Defaults to https://pypi.org.
+	PyPIRegistryURL string
+
+	Timeout       time.Duration
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Verifier checks a server's declared packages for a published Sigstore or
+// SLSA provenance attestation entry, without cryptographically validating
+// it (see the package doc comment).
+type Verifier struct {
+	npmBaseURL    string
+	pypiBaseURL   string
+	retryAttempts int
+	retryDelay    time.Duration
+	httpClient    *http.Client
+}
+
+// NewVerifier creates a new provenance verifier.
+func NewVerifier(cfg Config) *Verifier {
+	npmBaseURL := cfg.NPMRegistryURL
+	if npmBaseURL == "" {
+		npmBaseURL = defaultNPMRegistryURL
+	}
+	pypiBaseURL := cfg.PyPIRegistryURL
+	if pypiBaseURL == "" {
+		pypiBaseURL = defaultPyPIRegistryURL
+	}
+
+	return &Verifier{
+		npmBaseURL:    npmBaseURL,
+		pypiBaseURL:   pypiBaseURL,
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+		httpClient:    &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// npmAttestationsResponse is the shape of npm's provenance attestations
+// endpoint. A package with no published attestations returns an empty
+// Attestations slice rather than an error.
+type npmAttestationsResponse struct {
+	Attestations []struct {
+		PredicateType string `json:"predicateType"`
+	} `json:"attestations"`
+}
+
+// pypiProvenanceResponse is the shape of PyPI's PEP 740 provenance endpoint.
+type pypiProvenanceResponse struct {
+	AttestationBundles []struct {
+		Attestations []struct {
+			PredicateType string `json:"predicate_type"`
+		} `json:"attestations"`
+	} `json:"attestation_bundles"`
+}
+
+// Verify checks for a published provenance attestation for each of the
+// server's declared packages on a supported registry (npm, pypi), skipping
+// packages on registries this package doesn't cover. A lookup failure for
+// an individual package is reported as no attestation published rather
+// than failing the whole call.
+func (v *Verifier) Verify(ctx context.Context, server types.Server) []types.ProvenanceStatus {
+	var results []types.ProvenanceStatus
+	for _, pkg := range server.Packages {
+		switch pkg.RegistryType {
+		case "npm":
+			results = append(results, v.verifyNPM(ctx, pkg))
+		case "pypi":
+			results = append(results, v.verifyPyPI(ctx, pkg))
+		}
+	}
+	return results
+}
+
+func (v *Verifier) verifyNPM(ctx context.Context, pkg types.Package) types.ProvenanceStatus {
+	url := fmt.Sprintf("%s/-/npm/v1/attestations/%s@%s", v.npmBaseURL, pkg.Name, pkg.Version)
+
+	var resp npmAttestationsResponse
+	if err := v.getWithRetry(ctx, url, &resp); err != nil {
+		log.Warn().Err(err).Str("package", pkg.Name).Msg("Failed to fetch npm attestations")
+		return types.ProvenanceStatus{PackageName: pkg.Name, AttestationPublished: false, Reason: "failed to query npm attestations"}
+	}
+
+	for _, a := range resp.Attestations {
+		if a.PredicateType == "https://slsa.dev/provenance/v1" || a.PredicateType == "https://slsa.dev/provenance/v0.2" {
+			return types.ProvenanceStatus{PackageName: pkg.Name, AttestationPublished: true, Reason: "SLSA provenance attestation found"}
+		}
+	}
+	return types.ProvenanceStatus{PackageName: pkg.Name, AttestationPublished: false, Reason: "no provenance attestation published"}
+}
+
+func (v *Verifier) verifyPyPI(ctx context.Context, pkg types.Package) types.ProvenanceStatus {
+	url := fmt.Sprintf("%s/integrity/%s/%s/provenance", v.pypiBaseURL, pkg.Name, pkg.Version)
+
+	var resp pypiProvenanceResponse
+	if err := v.getWithRetry(ctx, url, &resp); err != nil {
+		log.Warn().Err(err).Str("package", pkg.Name).Msg("Failed to fetch PyPI provenance")
+		return types.ProvenanceStatus{PackageName: pkg.Name, AttestationPublished: false, Reason: "failed to query PyPI provenance"}
+	}
+
+	for _, bundle := range resp.AttestationBundles {
+		for _, a := range bundle.Attestations {
+			if a.PredicateType == "https://slsa.dev/provenance/v1" {
+				return types.ProvenanceStatus{PackageName: pkg.Name, AttestationPublished: true, Reason: "SLSA provenance attestation found"}
+			}
+		}
+	}
+	return types.ProvenanceStatus{PackageName: pkg.Name, AttestationPublished: false, Reason: "no provenance attestation published"}
+}
+
+// getWithRetry fetches url and decodes its JSON body into out, retrying
+// transient failures the same way the security scorer's OSV client does.
+func (v *Verifier) getWithRetry(ctx context.Context, url string, out interface{}) error {
+	var lastErr error
+	for attempt := 0; attempt <= v.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(v.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Str("url", url).Msg("Retrying provenance lookup")
+		}
+
+		if err := v.doGet(ctx, url, out); err == nil {
+			return nil
+		} else {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (v *Verifier) doGet(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
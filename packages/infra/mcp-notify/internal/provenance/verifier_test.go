@@ -0,0 +1,87 @@
+package provenance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+func TestVerifier_VerifyNPM_AttestationPublished(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"attestations":[{"predicateType":"https://slsa.dev/provenance/v1"}]}`))
+	}))
+	defer server.Close()
+
+	v := NewVerifier(Config{NPMRegistryURL: server.URL})
+	status := v.verifyNPM(context.Background(), types.Package{Name: "example-pkg", Version: "1.0.0"})
+
+	assert.True(t, status.AttestationPublished)
+	assert.Equal(t, "example-pkg", status.PackageName)
+}
+
+func TestVerifier_VerifyNPM_NoAttestations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"attestations":[]}`))
+	}))
+	defer server.Close()
+
+	v := NewVerifier(Config{NPMRegistryURL: server.URL})
+	status := v.verifyNPM(context.Background(), types.Package{Name: "example-pkg", Version: "1.0.0"})
+
+	assert.False(t, status.AttestationPublished)
+}
+
+func TestVerifier_VerifyNPM_RegistryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	v := NewVerifier(Config{NPMRegistryURL: server.URL})
+	status := v.verifyNPM(context.Background(), types.Package{Name: "example-pkg", Version: "1.0.0"})
+
+	assert.False(t, status.AttestationPublished)
+	assert.Equal(t, "failed to query npm attestations", status.Reason)
+}
+
+func TestVerifier_VerifyPyPI_AttestationPublished(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"attestation_bundles":[{"attestations":[{"predicate_type":"https://slsa.dev/provenance/v1"}]}]}`))
+	}))
+	defer server.Close()
+
+	v := NewVerifier(Config{PyPIRegistryURL: server.URL})
+	status := v.verifyPyPI(context.Background(), types.Package{Name: "example-pkg", Version: "1.0.0"})
+
+	assert.True(t, status.AttestationPublished)
+}
+
+func TestVerifier_VerifyPyPI_NoAttestations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"attestation_bundles":[]}`))
+	}))
+	defer server.Close()
+
+	v := NewVerifier(Config{PyPIRegistryURL: server.URL})
+	status := v.verifyPyPI(context.Background(), types.Package{Name: "example-pkg", Version: "1.0.0"})
+
+	assert.False(t, status.AttestationPublished)
+}
+
+func TestVerifier_Verify_SkipsUnsupportedRegistries(t *testing.T) {
+	v := NewVerifier(Config{})
+	server := types.Server{Packages: []types.Package{{RegistryType: "nuget", Name: "example-pkg"}}}
+
+	results := v.Verify(context.Background(), server)
+	require.Empty(t, results)
+}
@@ -0,0 +1,25 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Adapter is the interface the diff/notify pipeline depends on to discover
+// and describe servers in a watched registry. The MCP Registry client
+// (Client, above) is the default implementation; internal/registry/npm,
+// internal/registry/pypi, and internal/registry/github provide adapters for
+// watching package ecosystems that aren't MCP registries themselves.
+type Adapter interface {
+	// ListServers returns the full current listing for the watched source.
+	// Poller diffs this against the previous snapshot to detect changes.
+	ListServers(ctx context.Context) ([]types.Server, error)
+
+	// GetServer fetches a single entry by name. Implementations return a nil
+	// server and a nil error when the name doesn't exist, matching Client.
+	GetServer(ctx context.Context, name string) (*types.Server, error)
+
+	// HealthCheck reports whether the upstream source is reachable.
+	HealthCheck(ctx context.Context) error
+}
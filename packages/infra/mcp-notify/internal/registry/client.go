@@ -4,11 +4,13 @@ package registry
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -22,6 +24,12 @@ import (
 
 var tracer = otel.Tracer("registry-client")
 
+// ErrNotModified is returned by ListServers when the registry confirms,
+// via a 304 response to a conditional request, that its listing hasn't
+// changed since the last successful poll. Callers should treat this as "no
+// changes" rather than an error.
+var ErrNotModified = errors.New("registry listing not modified since last poll")
+
 // Client is a client for the MCP Registry API.
 type Client struct {
 	baseURL       string
@@ -29,6 +37,14 @@ type Client struct {
 	userAgent     string
 	retryAttempts int
 	retryDelay    time.Duration
+
+	// condMu guards etag/lastModified, the validators from the most recent
+	// successful full listing fetch, sent back on the next poll via
+	// If-None-Match/If-Modified-Since so an unchanged registry can answer
+	// with a cheap 304 instead of the full listing.
+	condMu       sync.Mutex
+	etag         string
+	lastModified string
 }
 
 // NewClient creates a new registry client.
@@ -44,7 +60,10 @@ func NewClient(cfg config.RegistryConfig) *Client {
 	}
 }
 
-// ListServers fetches all servers from the registry with pagination.
+// ListServers fetches all servers from the registry with pagination. If the
+// registry supports conditional requests and confirms via a 304 that its
+// listing hasn't changed since the last successful call, ListServers
+// returns ErrNotModified instead of re-fetching every page.
 func (c *Client) ListServers(ctx context.Context) ([]types.Server, error) {
 	ctx, span := tracer.Start(ctx, "ListServers")
 	defer span.End()
@@ -60,8 +79,14 @@ func (c *Client) ListServers(ctx context.Context) ([]types.Server, error) {
 			attribute.String("cursor", cursor),
 		))
 
-		servers, nextCursor, err := c.listServersPage(ctx, cursor, 100)
+		// Only the first page fetches the full listing, so only it is worth
+		// making conditional: an unchanged registry answers with 304 before
+		// any page is paginated through.
+		servers, nextCursor, err := c.listServersPage(ctx, cursor, 100, pageCount == 1, "")
 		if err != nil {
+			if errors.Is(err, ErrNotModified) {
+				return nil, ErrNotModified
+			}
 			span.RecordError(err)
 			return nil, fmt.Errorf("failed to fetch page %d: %w", pageCount, err)
 		}
@@ -86,8 +111,131 @@ func (c *Client) ListServers(ctx context.Context) ([]types.Server, error) {
 	return allServers, nil
 }
 
-// listServersPage fetches a single page of servers.
-func (c *Client) listServersPage(ctx context.Context, cursor string, limit int) ([]types.Server, string, error) {
+// ListServersByPrefix fetches every server whose name matches the given
+// search/namespace prefix, using the registry's search filter server-side
+// rather than fetching (and discarding) the full listing.
+func (c *Client) ListServersByPrefix(ctx context.Context, prefix string) ([]types.Server, error) {
+	ctx, span := tracer.Start(ctx, "ListServersByPrefix", trace.WithAttributes(
+		attribute.String("prefix", prefix),
+	))
+	defer span.End()
+
+	var shardServers []types.Server
+	var cursor string
+	pageCount := 0
+
+	for {
+		pageCount++
+		servers, nextCursor, err := c.listServersPage(ctx, cursor, 100, false, prefix)
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("failed to fetch shard %q page %d: %w", prefix, pageCount, err)
+		}
+
+		shardServers = append(shardServers, servers...)
+
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+
+		if pageCount > 1000 {
+			log.Warn().Str("prefix", prefix).Msg("Reached maximum page limit")
+			break
+		}
+	}
+
+	return shardServers, nil
+}
+
+// ListServersSharded fetches the registry listing split into per-prefix
+// shards fetched concurrently (up to concurrency at a time) instead of one
+// large paginated fetch, so large registries don't serialize on a single
+// slow listing endpoint. Results are merged and deduplicated by server
+// name; a server whose name doesn't start with any prefix is silently
+// excluded, so prefixes should cover the registry's full namespace.
+func (c *Client) ListServersSharded(ctx context.Context, prefixes []string, concurrency int) ([]types.Server, error) {
+	ctx, span := tracer.Start(ctx, "ListServersSharded", trace.WithAttributes(
+		attribute.Int("shards", len(prefixes)),
+	))
+	defer span.End()
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	type shardResult struct {
+		prefix  string
+		servers []types.Server
+		err     error
+	}
+
+	jobs := make(chan string)
+	results := make(chan shardResult, len(prefixes))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for prefix := range jobs {
+				servers, err := c.ListServersByPrefix(ctx, prefix)
+				results <- shardResult{prefix: prefix, servers: servers, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, prefix := range prefixes {
+			select {
+			case jobs <- prefix:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	seen := make(map[string]bool)
+	var merged []types.Server
+	var firstErr error
+	for res := range results {
+		if res.err != nil {
+			span.RecordError(res.err)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("shard %q: %w", res.prefix, res.err)
+			}
+			continue
+		}
+		for _, server := range res.servers {
+			if seen[server.Name] {
+				continue
+			}
+			seen[server.Name] = true
+			merged = append(merged, server)
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	span.SetAttributes(attribute.Int("total_servers", len(merged)))
+	log.Debug().Int("server_count", len(merged)).Int("shards", len(prefixes)).Msg("Fetched sharded server listing")
+
+	return merged, nil
+}
+
+// listServersPage fetches a single page of servers. When conditional is
+// true, it sends back the validators from the last successful full-listing
+// fetch and may return ErrNotModified in place of a page. A non-empty
+// search restricts the page to servers matching it server-side, used to
+// fetch a namespace shard instead of the whole listing.
+func (c *Client) listServersPage(ctx context.Context, cursor string, limit int, conditional bool, search string) ([]types.Server, string, error) {
 	ctx, span := tracer.Start(ctx, "listServersPage")
 	defer span.End()
 
@@ -102,11 +250,15 @@ func (c *Client) listServersPage(ctx context.Context, cursor string, limit int)
 	if cursor != "" {
 		q.Set("cursor", cursor)
 	}
+	if search != "" {
+		q.Set("search", search)
+	}
 	u.RawQuery = q.Encode()
 
 	// Make request with retries
 	var resp *http.Response
 	var lastErr error
+	notModified := false
 
 	for attempt := 0; attempt <= c.retryAttempts; attempt++ {
 		if attempt > 0 {
@@ -126,8 +278,20 @@ func (c *Client) listServersPage(ctx context.Context, cursor string, limit int)
 		req.Header.Set("User-Agent", c.userAgent)
 		req.Header.Set("Accept", "application/json")
 
+		if conditional {
+			c.condMu.Lock()
+			if c.etag != "" {
+				req.Header.Set("If-None-Match", c.etag)
+			}
+			if c.lastModified != "" {
+				req.Header.Set("If-Modified-Since", c.lastModified)
+			}
+			c.condMu.Unlock()
+		}
+
 		resp, lastErr = c.httpClient.Do(req)
-		if lastErr == nil && resp.StatusCode == http.StatusOK {
+		if lastErr == nil && (resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotModified) {
+			notModified = resp.StatusCode == http.StatusNotModified
 			break
 		}
 
@@ -145,11 +309,22 @@ func (c *Client) listServersPage(ctx context.Context, cursor string, limit int)
 	}
 	defer resp.Body.Close()
 
+	if notModified {
+		return nil, "", ErrNotModified
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
 		return nil, "", fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
 	}
 
+	if conditional {
+		c.condMu.Lock()
+		c.etag = resp.Header.Get("ETag")
+		c.lastModified = resp.Header.Get("Last-Modified")
+		c.condMu.Unlock()
+	}
+
 	// Parse response - the registry API has a nested structure
 	var result struct {
 		Servers []struct {
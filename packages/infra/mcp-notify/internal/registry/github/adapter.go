@@ -0,0 +1,230 @@
+// Package github provides a registry.Adapter that watches repositories
+// matching a GitHub topic search, so the diff/notify pipeline can surface
+// new and updated projects tagged with a topic of interest.
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+var tracer = otel.Tracer("registry-github-adapter")
+
+// Config holds GitHub topic search adapter configuration.
+type Config struct {
+	// APIURL is the GitHub REST API root. Defaults to https://api.github.com
+	// when empty.
+	APIURL string
+	// Topic is the GitHub topic to search for, e.g. "mcp-server".
+	Topic string
+	// Token is an optional personal access token used to raise the search
+	// API's rate limit above the unauthenticated 10 req/min.
+	Token     string
+	Limit     int
+	UserAgent string
+	Timeout   time.Duration
+}
+
+// Adapter watches a GitHub topic search as a registry.Adapter.
+type Adapter struct {
+	apiURL     string
+	topic      string
+	token      string
+	limit      int
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewAdapter creates a new GitHub topic search adapter.
+func NewAdapter(cfg Config) *Adapter {
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = "https://api.github.com"
+	}
+	limit := cfg.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = "mcp-notify/1.0"
+	}
+
+	return &Adapter{
+		apiURL:    apiURL,
+		topic:     cfg.Topic,
+		token:     cfg.Token,
+		limit:     limit,
+		userAgent: userAgent,
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+// ListServers searches repositories tagged with the configured topic.
+func (a *Adapter) ListServers(ctx context.Context) ([]types.Server, error) {
+	ctx, span := tracer.Start(ctx, "ListServers", trace.WithAttributes(
+		attribute.String("topic", a.topic),
+	))
+	defer span.End()
+
+	u, err := url.Parse(a.apiURL + "/search/repositories")
+	if err != nil {
+		return nil, fmt.Errorf("invalid API URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("q", "topic:"+a.topic)
+	q.Set("per_page", strconv.Itoa(a.limit))
+	u.RawQuery = q.Encode()
+
+	var result githubSearchResponse
+	if err := a.getJSON(ctx, u.String(), &result); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("github topic search failed: %w", err)
+	}
+
+	servers := make([]types.Server, 0, len(result.Items))
+	for _, repo := range result.Items {
+		servers = append(servers, repoToServer(repo))
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(servers)))
+	return servers, nil
+}
+
+// GetServer fetches a single repository by "owner/name".
+func (a *Adapter) GetServer(ctx context.Context, name string) (*types.Server, error) {
+	ctx, span := tracer.Start(ctx, "GetServer", trace.WithAttributes(
+		attribute.String("repo", name),
+	))
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.apiURL+"/repos/"+name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	a.setHeaders(req)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var repo githubRepo
+	if err := json.NewDecoder(resp.Body).Decode(&repo); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	server := repoToServer(repo)
+	return &server, nil
+}
+
+// HealthCheck confirms the GitHub search API is reachable.
+func (a *Adapter) HealthCheck(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "HealthCheck")
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.apiURL+"/rate_limit", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	a.setHeaders(req)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("github health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unhealthy status: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (a *Adapter) setHeaders(req *http.Request) {
+	req.Header.Set("User-Agent", a.userAgent)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	}
+}
+
+func (a *Adapter) getJSON(ctx context.Context, requestURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	a.setHeaders(req)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func repoToServer(repo githubRepo) types.Server {
+	server := types.Server{
+		Name:        repo.FullName,
+		Description: repo.Description,
+		Repository: &types.Repository{
+			URL:    repo.HTMLURL,
+			Source: "github",
+		},
+		CreatedAt: repo.CreatedAt,
+		UpdatedAt: repo.UpdatedAt,
+	}
+	if repo.DefaultBranch != "" {
+		server.VersionDetail = &types.VersionDetail{
+			Version:  repo.DefaultBranch,
+			IsLatest: true,
+		}
+	}
+	return server
+}
+
+type githubSearchResponse struct {
+	TotalCount int          `json:"total_count"`
+	Items      []githubRepo `json:"items"`
+}
+
+type githubRepo struct {
+	FullName      string    `json:"full_name"`
+	Description   string    `json:"description"`
+	HTMLURL       string    `json:"html_url"`
+	DefaultBranch string    `json:"default_branch"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,227 @@
+// Package npm provides a registry.Adapter that watches npm packages
+// matching a search query, so the diff/notify pipeline can treat an npm
+// ecosystem search the same way it treats the MCP Registry.
+package npm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+var tracer = otel.Tracer("registry-npm-adapter")
+
+// Config holds npm search adapter configuration.
+type Config struct {
+	// SearchURL is the npm registry search endpoint. Defaults to
+	// https://registry.npmjs.org/-/v1/search when empty.
+	SearchURL string
+	// Query is the npm search text, e.g. "keywords:mcp-server".
+	Query     string
+	Limit     int
+	UserAgent string
+	Timeout   time.Duration
+}
+
+// Adapter watches npm search results as a registry.Adapter.
+type Adapter struct {
+	searchURL  string
+	query      string
+	limit      int
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewAdapter creates a new npm search adapter.
+func NewAdapter(cfg Config) *Adapter {
+	searchURL := cfg.SearchURL
+	if searchURL == "" {
+		searchURL = "https://registry.npmjs.org/-/v1/search"
+	}
+	limit := cfg.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = "mcp-notify/1.0"
+	}
+
+	return &Adapter{
+		searchURL: searchURL,
+		query:     cfg.Query,
+		limit:     limit,
+		userAgent: userAgent,
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+// ListServers runs the configured search query and maps matching packages
+// to types.Server so they can flow through the existing diff engine.
+func (a *Adapter) ListServers(ctx context.Context) ([]types.Server, error) {
+	ctx, span := tracer.Start(ctx, "ListServers", trace.WithAttributes(
+		attribute.String("query", a.query),
+	))
+	defer span.End()
+
+	u, err := url.Parse(a.searchURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("text", a.query)
+	q.Set("size", strconv.Itoa(a.limit))
+	u.RawQuery = q.Encode()
+
+	var result npmSearchResponse
+	if err := a.getJSON(ctx, u.String(), &result); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("npm search failed: %w", err)
+	}
+
+	servers := make([]types.Server, 0, len(result.Objects))
+	for _, obj := range result.Objects {
+		servers = append(servers, packageToServer(obj.Package))
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(servers)))
+	return servers, nil
+}
+
+// GetServer fetches a single package by name from the npm registry.
+func (a *Adapter) GetServer(ctx context.Context, name string) (*types.Server, error) {
+	ctx, span := tracer.Start(ctx, "GetServer", trace.WithAttributes(
+		attribute.String("package", name),
+	))
+	defer span.End()
+
+	var pkg npmPackageDoc
+	err := a.getJSON(ctx, "https://registry.npmjs.org/"+url.PathEscape(name), &pkg)
+	if err != nil {
+		if httpErr, ok := err.(*httpStatusError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		span.RecordError(err)
+		return nil, fmt.Errorf("failed to fetch npm package: %w", err)
+	}
+
+	latest := pkg.DistTags["latest"]
+	version := pkg.Versions[latest]
+	server := packageToServer(npmPackage{
+		Name:        pkg.Name,
+		Description: version.Description,
+		Version:     latest,
+		Links:       version.Links,
+	})
+	return &server, nil
+}
+
+// HealthCheck confirms the npm registry search endpoint is reachable.
+func (a *Adapter) HealthCheck(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "HealthCheck")
+	defer span.End()
+
+	var result npmSearchResponse
+	if err := a.getJSON(ctx, a.searchURL+"?text=mcp&size=1", &result); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("npm health check failed: %w", err)
+	}
+	return nil
+}
+
+func (a *Adapter) getJSON(ctx context.Context, requestURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", a.userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &httpStatusError{StatusCode: resp.StatusCode}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+type httpStatusError struct {
+	StatusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.StatusCode)
+}
+
+func packageToServer(pkg npmPackage) types.Server {
+	server := types.Server{
+		Name:        pkg.Name,
+		Description: pkg.Description,
+	}
+	if pkg.Version != "" {
+		server.VersionDetail = &types.VersionDetail{
+			Version:  pkg.Version,
+			IsLatest: true,
+		}
+	}
+	if pkg.Links.Repository != "" {
+		server.Repository = &types.Repository{
+			URL:    pkg.Links.Repository,
+			Source: "npm",
+		}
+	}
+	server.Packages = []types.Package{{
+		RegistryType: "npm",
+		Name:         pkg.Name,
+		Version:      pkg.Version,
+		URL:          pkg.Links.NPM,
+	}}
+	return server
+}
+
+type npmSearchResponse struct {
+	Objects []struct {
+		Package npmPackage `json:"package"`
+	} `json:"objects"`
+}
+
+type npmPackage struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Version     string   `json:"version"`
+	Links       npmLinks `json:"links"`
+}
+
+type npmLinks struct {
+	NPM        string `json:"npm"`
+	Repository string `json:"repository"`
+}
+
+type npmPackageDoc struct {
+	Name     string `json:"name"`
+	DistTags map[string]string `json:"dist-tags"`
+	Versions map[string]struct {
+		Description string   `json:"description"`
+		Links       npmLinks `json:"links"`
+	} `json:"versions"`
+}
@@ -0,0 +1,189 @@
+// Package pypi provides a registry.Adapter that watches a fixed list of
+// PyPI projects, since PyPI's public JSON API has no search endpoint. The
+// package list is expected to come from wherever the operator tracks the
+// ecosystem they care about (a subscription filter, a config file, etc.).
+package pypi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+var tracer = otel.Tracer("registry-pypi-adapter")
+
+// Config holds PyPI adapter configuration.
+type Config struct {
+	// BaseURL is the PyPI JSON API root. Defaults to https://pypi.org/pypi
+	// when empty.
+	BaseURL string
+	// Projects is the fixed set of project names to watch.
+	Projects  []string
+	UserAgent string
+	Timeout   time.Duration
+}
+
+// Adapter watches a fixed list of PyPI projects as a registry.Adapter.
+type Adapter struct {
+	baseURL    string
+	projects   []string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewAdapter creates a new PyPI adapter.
+func NewAdapter(cfg Config) *Adapter {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "https://pypi.org/pypi"
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = "mcp-notify/1.0"
+	}
+
+	return &Adapter{
+		baseURL:   baseURL,
+		projects:  cfg.Projects,
+		userAgent: userAgent,
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+// ListServers fetches the current metadata for every configured project.
+// Projects that have been deleted from PyPI (404) are skipped rather than
+// failing the whole listing.
+func (a *Adapter) ListServers(ctx context.Context) ([]types.Server, error) {
+	ctx, span := tracer.Start(ctx, "ListServers", trace.WithAttributes(
+		attribute.Int("project_count", len(a.projects)),
+	))
+	defer span.End()
+
+	servers := make([]types.Server, 0, len(a.projects))
+	for _, name := range a.projects {
+		server, err := a.GetServer(ctx, name)
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("failed to fetch %s: %w", name, err)
+		}
+		if server != nil {
+			servers = append(servers, *server)
+		}
+	}
+
+	span.SetAttributes(attribute.Int("result_count", len(servers)))
+	return servers, nil
+}
+
+// GetServer fetches a single project's metadata from the PyPI JSON API.
+func (a *Adapter) GetServer(ctx context.Context, name string) (*types.Server, error) {
+	ctx, span := tracer.Start(ctx, "GetServer", trace.WithAttributes(
+		attribute.String("project", name),
+	))
+	defer span.End()
+
+	requestURL := a.baseURL + "/" + url.PathEscape(name) + "/json"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", a.userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var doc pypiProjectDoc
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	server := types.Server{
+		Name:        doc.Info.Name,
+		Description: doc.Info.Summary,
+		VersionDetail: &types.VersionDetail{
+			Version:  doc.Info.Version,
+			IsLatest: true,
+		},
+		Packages: []types.Package{{
+			RegistryType: "pypi",
+			Name:         doc.Info.Name,
+			Version:      doc.Info.Version,
+			URL:          doc.Info.PackageURL,
+		}},
+	}
+	if repoURL := doc.repositoryURL(); repoURL != "" {
+		server.Repository = &types.Repository{
+			URL:    repoURL,
+			Source: "pypi",
+		}
+	}
+
+	return &server, nil
+}
+
+// HealthCheck confirms the PyPI JSON API is reachable.
+func (a *Adapter) HealthCheck(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "HealthCheck")
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.baseURL+"/pip/json", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", a.userAgent)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("pypi health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unhealthy status: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type pypiProjectDoc struct {
+	Info struct {
+		Name        string            `json:"name"`
+		Summary     string            `json:"summary"`
+		Version     string            `json:"version"`
+		PackageURL  string            `json:"package_url"`
+		ProjectURLs map[string]string `json:"project_urls"`
+	} `json:"info"`
+}
+
+func (d pypiProjectDoc) repositoryURL() string {
+	for _, key := range []string{"Source", "Source Code", "Repository", "Homepage"} {
+		if url, ok := d.Info.ProjectURLs[key]; ok && url != "" {
+			return url
+		}
+	}
+	return ""
+}
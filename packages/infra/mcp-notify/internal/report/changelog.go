@@ -0,0 +1,149 @@
+// Package report builds human-readable summaries of registry activity,
+// such as the changelog rendered by GET /api/v1/reports/changelog and the
+// CLI's `report changelog` command.
+package report
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// Changelog groups a set of changes over a time window by change type, for
+// rendering as a human-readable weekly/monthly summary.
+type Changelog struct {
+	Since          time.Time
+	Until          time.Time
+	NewServers     []types.Change
+	UpdatedServers []types.Change
+	RemovedServers []types.Change
+}
+
+// BuildChangelog groups changes by type into a Changelog, sorting each
+// group by server name for stable, diffable output.
+func BuildChangelog(changes []types.Change, since, until time.Time) *Changelog {
+	cl := &Changelog{Since: since, Until: until}
+	for _, c := range changes {
+		switch c.ChangeType {
+		case types.ChangeTypeNew:
+			cl.NewServers = append(cl.NewServers, c)
+		case types.ChangeTypeUpdated:
+			cl.UpdatedServers = append(cl.UpdatedServers, c)
+		case types.ChangeTypeRemoved:
+			cl.RemovedServers = append(cl.RemovedServers, c)
+		}
+	}
+	sortChangesByName(cl.NewServers)
+	sortChangesByName(cl.UpdatedServers)
+	sortChangesByName(cl.RemovedServers)
+	return cl
+}
+
+func sortChangesByName(changes []types.Change) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].ServerName < changes[j].ServerName
+	})
+}
+
+// TotalChanges is the number of changes across all groups.
+func (c *Changelog) TotalChanges() int {
+	return len(c.NewServers) + len(c.UpdatedServers) + len(c.RemovedServers)
+}
+
+// RenderMarkdown renders the changelog as Markdown, suitable for pasting
+// into a newsletter or release notes.
+func (c *Changelog) RenderMarkdown() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Registry Changelog: %s to %s\n\n", c.Since.Format("2006-01-02"), c.Until.Format("2006-01-02"))
+
+	if c.TotalChanges() == 0 {
+		sb.WriteString("No changes in this period.\n")
+		return strings.TrimRight(sb.String(), "\n")
+	}
+
+	writeMarkdownSection(&sb, "New Servers", c.NewServers, func(ch types.Change) string {
+		desc := ""
+		if ch.Server != nil && ch.Server.Description != "" {
+			desc = ": " + ch.Server.Description
+		}
+		return fmt.Sprintf("- **%s**%s", ch.ServerName, desc)
+	})
+	writeMarkdownSection(&sb, "Updated Servers", c.UpdatedServers, func(ch types.Change) string {
+		if ch.AISummary != "" {
+			return fmt.Sprintf("- **%s**: %s", ch.ServerName, ch.AISummary)
+		}
+		if ch.PreviousVersion != "" && ch.NewVersion != "" {
+			return fmt.Sprintf("- **%s**: %s → %s", ch.ServerName, ch.PreviousVersion, ch.NewVersion)
+		}
+		return fmt.Sprintf("- **%s**: updated", ch.ServerName)
+	})
+	writeMarkdownSection(&sb, "Removed Servers", c.RemovedServers, func(ch types.Change) string {
+		return fmt.Sprintf("- **%s**", ch.ServerName)
+	})
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func writeMarkdownSection(sb *strings.Builder, title string, changes []types.Change, line func(types.Change) string) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "## %s (%d)\n\n", title, len(changes))
+	for _, ch := range changes {
+		sb.WriteString(line(ch))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+}
+
+// RenderHTML renders the changelog as a self-contained HTML fragment.
+// Server names and descriptions come from the registry, external and
+// untrusted, so every value is HTML-escaped before being embedded.
+func (c *Changelog) RenderHTML() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<h1>Registry Changelog: %s to %s</h1>\n",
+		html.EscapeString(c.Since.Format("2006-01-02")), html.EscapeString(c.Until.Format("2006-01-02")))
+
+	if c.TotalChanges() == 0 {
+		sb.WriteString("<p>No changes in this period.</p>\n")
+		return sb.String()
+	}
+
+	writeHTMLSection(&sb, "New Servers", c.NewServers, func(ch types.Change) string {
+		desc := ""
+		if ch.Server != nil && ch.Server.Description != "" {
+			desc = ": " + html.EscapeString(ch.Server.Description)
+		}
+		return fmt.Sprintf("<strong>%s</strong>%s", html.EscapeString(ch.ServerName), desc)
+	})
+	writeHTMLSection(&sb, "Updated Servers", c.UpdatedServers, func(ch types.Change) string {
+		if ch.AISummary != "" {
+			return fmt.Sprintf("<strong>%s</strong>: %s", html.EscapeString(ch.ServerName), html.EscapeString(ch.AISummary))
+		}
+		if ch.PreviousVersion != "" && ch.NewVersion != "" {
+			return fmt.Sprintf("<strong>%s</strong>: %s &rarr; %s",
+				html.EscapeString(ch.ServerName), html.EscapeString(ch.PreviousVersion), html.EscapeString(ch.NewVersion))
+		}
+		return fmt.Sprintf("<strong>%s</strong>: updated", html.EscapeString(ch.ServerName))
+	})
+	writeHTMLSection(&sb, "Removed Servers", c.RemovedServers, func(ch types.Change) string {
+		return fmt.Sprintf("<strong>%s</strong>", html.EscapeString(ch.ServerName))
+	})
+
+	return sb.String()
+}
+
+func writeHTMLSection(sb *strings.Builder, title string, changes []types.Change, line func(types.Change) string) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "<h2>%s (%d)</h2>\n<ul>\n", html.EscapeString(title), len(changes))
+	for _, ch := range changes {
+		fmt.Fprintf(sb, "  <li>%s</li>\n", line(ch))
+	}
+	sb.WriteString("</ul>\n")
+}
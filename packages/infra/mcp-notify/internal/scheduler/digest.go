@@ -3,35 +3,48 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/robfig/cron/v3"
 	"github.com/rs/zerolog/log"
 
 	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/monitoring"
 	"github.com/nirholas/mcp-notify/internal/notifier"
+	"github.com/nirholas/mcp-notify/internal/report"
+	"github.com/nirholas/mcp-notify/internal/subscription"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
 // Config holds scheduler configuration.
 type Config struct {
-	Database   db.Database
-	Dispatcher *notifier.Dispatcher
+	Database        db.Database
+	Dispatcher      *notifier.Dispatcher
+	SubscriptionMgr *subscription.Manager
+
+	// PollInterval is the configured registry poll interval, used to flag
+	// monitoring gaps that overlap a digest window.
+	PollInterval time.Duration
 }
 
 // DigestScheduler handles scheduled digest email delivery.
 type DigestScheduler struct {
-	db         db.Database
-	dispatcher *notifier.Dispatcher
-	cron       *cron.Cron
+	db              db.Database
+	dispatcher      *notifier.Dispatcher
+	subscriptionMgr *subscription.Manager
+	pollInterval    time.Duration
+	cron            *cron.Cron
 }
 
 // NewDigestScheduler creates a new digest scheduler.
 func NewDigestScheduler(cfg Config) *DigestScheduler {
 	return &DigestScheduler{
-		db:         cfg.Database,
-		dispatcher: cfg.Dispatcher,
-		cron:       cron.New(cron.WithSeconds()),
+		db:              cfg.Database,
+		dispatcher:      cfg.Dispatcher,
+		subscriptionMgr: cfg.SubscriptionMgr,
+		pollInterval:    cfg.PollInterval,
+		cron:            cron.New(cron.WithSeconds()),
 	}
 }
 
@@ -63,9 +76,31 @@ func (s *DigestScheduler) Run(ctx context.Context) error {
 		return err
 	}
 
-	// Schedule cleanup (daily at 3:00 AM UTC)
-	_, err = s.cron.AddFunc("0 0 3 * * *", func() {
-		s.cleanup(context.Background())
+	// Check every 5 minutes for subscriptions whose delivery window just
+	// reopened, and flush anything held while it was closed.
+	_, err = s.cron.AddFunc("0 */5 * * * *", func() {
+		s.flushHeldNotifications(context.Background())
+	})
+	if err != nil {
+		return err
+	}
+
+	// Check every minute for coalescing bursts whose CoalesceWindow has
+	// elapsed since their last update, and flush them as combined
+	// notifications. Checked more often than the held-notification flush
+	// since coalesce windows are typically much shorter than quiet hours.
+	_, err = s.cron.AddFunc("0 * * * * *", func() {
+		s.flushCoalescedUpdates(context.Background())
+	})
+	if err != nil {
+		return err
+	}
+
+	// Check every minute for subscriptions whose scheduled report cron
+	// expression is due, and deliver a changelog covering everything since
+	// the last one was sent.
+	_, err = s.cron.AddFunc("0 * * * * *", func() {
+		s.sendScheduledReports(context.Background())
 	})
 	if err != nil {
 		return err
@@ -103,6 +138,8 @@ func (s *DigestScheduler) sendDigests(ctx context.Context, frequency types.Diges
 		return
 	}
 
+	s.warnOnMonitoringGap(ctx, frequency, since, now)
+
 	// Get changes in the time range
 	changes, err := s.db.GetChangesSince(ctx, since, 1000)
 	if err != nil {
@@ -162,6 +199,175 @@ func (s *DigestScheduler) sendDigests(ctx context.Context, frequency types.Diges
 	}
 }
 
+// flushHeldNotifications delivers, as a single batch per subscription, any
+// notifications that were held because a delivery schedule's quiet hours or
+// off-days window was closed when the underlying change was detected.
+func (s *DigestScheduler) flushHeldNotifications(ctx context.Context) {
+	subscriptions, err := s.db.GetActiveSubscriptions(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get subscriptions for held notification flush")
+		return
+	}
+
+	now := time.Now()
+	for _, sub := range subscriptions {
+		if !sub.DeliverySchedule.Enabled || !sub.DeliverySchedule.InWindow(now) {
+			continue
+		}
+
+		held, err := s.db.GetHeldNotifications(ctx, sub.ID)
+		if err != nil {
+			log.Error().Err(err).Str("subscription", sub.ID.String()).Msg("Failed to get held notifications")
+			continue
+		}
+		if len(held) == 0 {
+			continue
+		}
+
+		var changes []types.Change
+		for _, h := range held {
+			change, err := s.db.GetChangeByID(ctx, h.ChangeID)
+			if err != nil {
+				log.Error().Err(err).Str("subscription", sub.ID.String()).Msg("Failed to load held change")
+				continue
+			}
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+
+		if len(changes) > 0 {
+			channels, err := s.db.GetChannelsForSubscription(ctx, sub.ID)
+			if err != nil {
+				log.Error().Err(err).Str("subscription", sub.ID.String()).Msg("Failed to get channels for held notification flush")
+				continue
+			}
+			for _, channel := range channels {
+				if !channel.Enabled {
+					continue
+				}
+				for _, change := range changes {
+					if err := s.dispatcher.Dispatch(ctx, &channel, &change); err != nil {
+						log.Error().
+							Err(err).
+							Str("subscription", sub.ID.String()).
+							Str("channel", string(channel.Type)).
+							Msg("Failed to dispatch held notification")
+					}
+				}
+			}
+			log.Info().Str("subscription", sub.ID.String()).Int("changes", len(changes)).Msg("Flushed held notifications")
+		}
+
+		if err := s.db.DeleteHeldNotifications(ctx, sub.ID); err != nil {
+			log.Error().Err(err).Str("subscription", sub.ID.String()).Msg("Failed to clear held notifications")
+		}
+	}
+}
+
+// flushCoalescedUpdates delivers, as a single combined notification per
+// server, any coalescing bursts whose CoalesceWindow has elapsed without a
+// further update since the last one recorded.
+func (s *DigestScheduler) flushCoalescedUpdates(ctx context.Context) {
+	updates, err := s.db.GetReadyCoalescedUpdates(ctx, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get ready coalesced updates")
+		return
+	}
+
+	for _, u := range updates {
+		if err := s.flushCoalescedUpdate(ctx, &u); err != nil {
+			log.Error().
+				Err(err).
+				Str("subscription", u.SubscriptionID.String()).
+				Str("server", u.ServerName).
+				Msg("Failed to flush coalesced update")
+			continue
+		}
+		if err := s.db.DeleteCoalescedUpdate(ctx, u.ID); err != nil {
+			log.Error().Err(err).Str("subscription", u.SubscriptionID.String()).Msg("Failed to clear coalesced update")
+		}
+	}
+}
+
+// flushCoalescedUpdate dispatches a single coalescing burst as one
+// notification whose version transition spans the whole burst (the first
+// change's PreviousVersion to the latest change's NewVersion), noting how
+// many updates it represents.
+func (s *DigestScheduler) flushCoalescedUpdate(ctx context.Context, u *types.PendingCoalescedUpdate) error {
+	first, err := s.db.GetChangeByID(ctx, u.FirstChangeID)
+	if err != nil {
+		return fmt.Errorf("failed to load first change: %w", err)
+	}
+	latest, err := s.db.GetChangeByID(ctx, u.LatestChangeID)
+	if err != nil {
+		return fmt.Errorf("failed to load latest change: %w", err)
+	}
+	if first == nil || latest == nil {
+		// The underlying changes were pruned before the burst was flushed.
+		return nil
+	}
+
+	combined := *latest
+	combined.PreviousVersion = first.PreviousVersion
+
+	if u.UpdateCount > 1 {
+		note := fmt.Sprintf("%d updates in the last %s", u.UpdateCount, u.CoalesceWindow.Round(time.Second))
+		var srv types.Server
+		if combined.Server != nil {
+			srv = *combined.Server
+		}
+		if srv.Description != "" {
+			srv.Description = note + " — " + srv.Description
+		} else {
+			srv.Description = note
+		}
+		combined.Server = &srv
+	}
+
+	channels, err := s.db.GetChannelsForSubscription(ctx, u.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to get channels: %w", err)
+	}
+	for _, channel := range channels {
+		if !channel.Enabled {
+			continue
+		}
+		if err := s.dispatcher.Dispatch(ctx, &channel, &combined); err != nil {
+			log.Error().
+				Err(err).
+				Str("subscription", u.SubscriptionID.String()).
+				Str("channel", string(channel.Type)).
+				Msg("Failed to dispatch coalesced update")
+		}
+	}
+
+	return nil
+}
+
+// warnOnMonitoringGap logs when the poller missed part of a digest window,
+// so an empty-looking digest ("no changes") can be told apart from a window
+// where changes may simply have gone undetected.
+func (s *DigestScheduler) warnOnMonitoringGap(ctx context.Context, frequency types.DigestFrequency, since, now time.Time) {
+	if s.pollInterval <= 0 {
+		return
+	}
+
+	history, err := s.db.GetPollHistorySince(ctx, since)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to load poll history for gap detection")
+		return
+	}
+
+	for _, gap := range monitoring.DetectGaps(history, s.pollInterval, s.pollInterval, now) {
+		log.Warn().
+			Str("frequency", string(frequency)).
+			Time("gap_from", gap.From).
+			Time("gap_to", gap.To).
+			Msg("Monitoring gap overlaps digest window, changes in this period may be incomplete")
+	}
+}
+
 // sendDigestEmail sends a digest email for a channel.
 func (s *DigestScheduler) sendDigestEmail(ctx context.Context, channel *types.Channel, changes []types.Change, frequency types.DigestFrequency) error {
 	// Email sending is handled by the email notifier through the dispatcher
@@ -182,17 +388,106 @@ func filterChangesForSubscription(changes []types.Change, filter types.Subscript
 	return changes
 }
 
-// cleanup performs scheduled cleanup tasks.
-func (s *DigestScheduler) cleanup(ctx context.Context) {
-	log.Info().Msg("Running scheduled cleanup")
+// sendScheduledReports delivers a changelog covering changes since the last
+// report to every subscription whose ScheduledReport cron expression is due,
+// separate from real-time per-change dispatch and from the fixed
+// hourly/daily/weekly digests.
+func (s *DigestScheduler) sendScheduledReports(ctx context.Context) {
+	subscriptions, err := s.db.GetActiveSubscriptions(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get subscriptions for scheduled reports")
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, sub := range subscriptions {
+		cfg := sub.ScheduledReport
+		if !cfg.Enabled || cfg.CronExpression == "" {
+			continue
+		}
+
+		schedule, err := cron.ParseStandard(cfg.CronExpression)
+		if err != nil {
+			log.Error().Err(err).Str("subscription", sub.ID.String()).Str("cron", cfg.CronExpression).
+				Msg("Subscription has an invalid scheduled report cron expression")
+			continue
+		}
+
+		since := cfg.LastSentAt
+		if since.IsZero() {
+			since = sub.UpdatedAt
+		}
+		if schedule.Next(since).After(now) {
+			continue
+		}
+
+		if err := s.sendScheduledReport(ctx, &sub, since, now); err != nil {
+			log.Error().Err(err).Str("subscription", sub.ID.String()).Msg("Failed to send scheduled report")
+		}
+	}
+}
+
+// sendScheduledReport builds and delivers a single subscription's scheduled
+// report for the [since, until) window, then records LastSentAt so the next
+// report picks up where this one left off.
+func (s *DigestScheduler) sendScheduledReport(ctx context.Context, sub *types.Subscription, since, until time.Time) error {
+	changes, err := s.db.GetChangesSince(ctx, since, 1000)
+	if err != nil {
+		return fmt.Errorf("failed to get changes for scheduled report: %w", err)
+	}
 
-	// Delete snapshots older than 30 days
-	cutoff := time.Now().Add(-30 * 24 * time.Hour)
-	if err := s.db.DeleteOldSnapshots(ctx, cutoff); err != nil {
-		log.Error().Err(err).Msg("Failed to delete old snapshots")
+	var matched []types.Change
+	for _, change := range changes {
+		if s.subscriptionMgr == nil || s.subscriptionMgr.MatchesFilters(&change, sub.Filters) {
+			matched = append(matched, change)
+		}
 	}
 
-	// Notification cleanup and rate limit reset handled by database TTLs
-	// PostgreSQL: handled via scheduled VACUUM
-	// Redis: handled via key TTLs
+	changelog := report.BuildChangelog(matched, since, until)
+
+	var body string
+	if cfg := sub.ScheduledReport; cfg.Format == "html" {
+		body = changelog.RenderHTML()
+	} else {
+		body = changelog.RenderMarkdown()
+	}
+
+	summary := types.Change{
+		ServerName: sub.Name,
+		ChangeType: types.ChangeTypeSummary,
+		Server: &types.Server{
+			Description: body,
+		},
+		DetectedAt: until,
+		Confirmed:  true,
+	}
+
+	channels, err := s.db.GetChannelsForSubscription(ctx, sub.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get channels for scheduled report: %w", err)
+	}
+	for _, channel := range channels {
+		if !channel.Enabled {
+			continue
+		}
+		if err := s.dispatcher.Dispatch(ctx, &channel, &summary); err != nil {
+			log.Error().
+				Err(err).
+				Str("subscription", sub.ID.String()).
+				Str("channel", string(channel.Type)).
+				Msg("Failed to dispatch scheduled report")
+		}
+	}
+
+	sub.ScheduledReport.LastSentAt = until
+	if err := s.db.UpdateSubscription(ctx, sub); err != nil {
+		return fmt.Errorf("failed to record scheduled report delivery: %w", err)
+	}
+
+	log.Info().
+		Str("subscription", sub.ID.String()).
+		Int("changes", changelog.TotalChanges()).
+		Msg("Sent scheduled report")
+
+	return nil
 }
@@ -0,0 +1,249 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/telemetry"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+const (
+	// defaultMaintenanceSchedule runs maintenance daily at 3:00 AM UTC,
+	// matching the cleanup window this scheduler replaces.
+	defaultMaintenanceSchedule    = "0 0 3 * * *"
+	defaultSnapshotRetention      = 30 * 24 * time.Hour
+	defaultNotificationRetention  = 90 * 24 * time.Hour
+	defaultChangeRetention        = 180 * 24 * time.Hour
+	defaultAuditRetention         = 365 * 24 * time.Hour
+	defaultCompactionFullWindow   = 24 * time.Hour
+	defaultCompactionHourlyWindow = 7 * 24 * time.Hour
+	maintenanceLockName           = "mcp-notify:maintenance"
+)
+
+// MaintenanceConfig holds maintenance scheduler configuration.
+type MaintenanceConfig struct {
+	Database db.Database
+
+	// Schedule is a 6-field (with seconds) cron expression controlling when
+	// maintenance runs. Defaults to daily at 3:00 AM UTC.
+	Schedule string
+
+	// SnapshotRetention and NotificationRetention bound how far back old
+	// snapshots/notifications are pruned; a non-positive value falls back
+	// to the package defaults.
+	SnapshotRetention     time.Duration
+	NotificationRetention time.Duration
+
+	// ChangeRetention and AuditRetention bound how far back detected changes
+	// and audit log entries are pruned; a non-positive value falls back to
+	// the package defaults.
+	ChangeRetention time.Duration
+	AuditRetention  time.Duration
+
+	// CompactionPolicy controls how snapshot history is downsampled before
+	// SnapshotRetention's final cutoff deletes it entirely. A zero-value
+	// FullWindow or HourlyWindow falls back to the package defaults (24h
+	// full resolution, hourly out to 7 days, daily beyond).
+	CompactionPolicy types.RetentionPolicy
+
+	// VacuumEnabled additionally runs VACUUM ANALYZE against the pruned
+	// tables after cleanup, to reclaim disk space immediately instead of
+	// waiting on autovacuum.
+	VacuumEnabled bool
+}
+
+// MaintenanceScheduler runs scheduled snapshot/notification pruning and,
+// optionally, VACUUM ANALYZE, guarded by a database-held lock so it never
+// overlaps the poller's writes to the same tables or another replica's
+// maintenance run.
+type MaintenanceScheduler struct {
+	db                    db.Database
+	cron                  *cron.Cron
+	schedule              string
+	snapshotRetention     time.Duration
+	notificationRetention time.Duration
+	changeRetention       time.Duration
+	auditRetention        time.Duration
+	compactionPolicy      types.RetentionPolicy
+	vacuumEnabled         bool
+
+	mu         sync.RWMutex
+	lastReport *types.MaintenanceReport
+}
+
+// NewMaintenanceScheduler creates a new maintenance scheduler.
+func NewMaintenanceScheduler(cfg MaintenanceConfig) *MaintenanceScheduler {
+	schedule := cfg.Schedule
+	if schedule == "" {
+		schedule = defaultMaintenanceSchedule
+	}
+
+	snapshotRetention := cfg.SnapshotRetention
+	if snapshotRetention <= 0 {
+		snapshotRetention = defaultSnapshotRetention
+	}
+
+	notificationRetention := cfg.NotificationRetention
+	if notificationRetention <= 0 {
+		notificationRetention = defaultNotificationRetention
+	}
+
+	changeRetention := cfg.ChangeRetention
+	if changeRetention <= 0 {
+		changeRetention = defaultChangeRetention
+	}
+
+	auditRetention := cfg.AuditRetention
+	if auditRetention <= 0 {
+		auditRetention = defaultAuditRetention
+	}
+
+	compactionPolicy := cfg.CompactionPolicy
+	if compactionPolicy.FullWindow <= 0 {
+		compactionPolicy.FullWindow = defaultCompactionFullWindow
+	}
+	if compactionPolicy.HourlyWindow <= 0 {
+		compactionPolicy.HourlyWindow = defaultCompactionHourlyWindow
+	}
+
+	return &MaintenanceScheduler{
+		db:                    cfg.Database,
+		cron:                  cron.New(cron.WithSeconds()),
+		schedule:              schedule,
+		snapshotRetention:     snapshotRetention,
+		notificationRetention: notificationRetention,
+		changeRetention:       changeRetention,
+		auditRetention:        auditRetention,
+		compactionPolicy:      compactionPolicy,
+		vacuumEnabled:         cfg.VacuumEnabled,
+	}
+}
+
+// Run starts the scheduler.
+func (s *MaintenanceScheduler) Run(ctx context.Context) error {
+	log.Info().Str("schedule", s.schedule).Msg("Starting maintenance scheduler")
+
+	if _, err := s.cron.AddFunc(s.schedule, func() {
+		s.runMaintenance(context.Background())
+	}); err != nil {
+		return err
+	}
+
+	s.cron.Start()
+
+	// Wait for context cancellation
+	<-ctx.Done()
+
+	log.Info().Msg("Stopping maintenance scheduler")
+	cronCtx := s.cron.Stop()
+	<-cronCtx.Done()
+
+	return ctx.Err()
+}
+
+// LastReport returns the outcome of the most recent maintenance run, or nil
+// if maintenance has not run yet.
+func (s *MaintenanceScheduler) LastReport() *types.MaintenanceReport {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastReport
+}
+
+// runMaintenance prunes old snapshots and notifications and, if enabled,
+// reclaims their disk space with VACUUM ANALYZE, all under a single
+// maintenance lock.
+func (s *MaintenanceScheduler) runMaintenance(ctx context.Context) {
+	start := time.Now()
+	report := &types.MaintenanceReport{RanAt: start.UTC()}
+
+	acquired, err := s.db.WithMaintenanceLock(ctx, maintenanceLockName, func(ctx context.Context) error {
+		return s.prune(ctx, report)
+	})
+	if err != nil {
+		report.Error = err.Error()
+		log.Error().Err(err).Msg("Maintenance run failed")
+	}
+	if !acquired {
+		report.Skipped = true
+		log.Info().Msg("Skipping maintenance run, lock already held")
+	}
+
+	report.DurationMS = time.Since(start).Milliseconds()
+
+	s.mu.Lock()
+	s.lastReport = report
+	s.mu.Unlock()
+
+	log.Info().
+		Bool("skipped", report.Skipped).
+		Int64("snapshots_deleted", report.SnapshotsDeleted).
+		Int64("snapshots_compacted", report.SnapshotsCompacted).
+		Int64("snapshots_delta_encoded", report.SnapshotsDeltaEncoded).
+		Int64("notifications_pruned", report.NotificationsPruned).
+		Int64("changes_deleted", report.ChangesDeleted).
+		Int64("audit_log_entries_pruned", report.AuditLogEntriesPruned).
+		Bool("vacuum_ran", report.VacuumRan).
+		Int64("reclaimed_bytes", report.ReclaimedBytes).
+		Int64("duration_ms", report.DurationMS).
+		Msg("Maintenance run complete")
+}
+
+// prune compacts and deletes old snapshots, prunes old notifications,
+// changes and audit log entries, and, if enabled, vacuums the tables they
+// live in, recording the results on report.
+func (s *MaintenanceScheduler) prune(ctx context.Context, report *types.MaintenanceReport) error {
+	now := time.Now()
+
+	compaction, err := s.db.CompactSnapshots(ctx, s.compactionPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to compact snapshots: %w", err)
+	}
+	report.SnapshotsCompacted = compaction.SnapshotsRemoved
+	report.SnapshotsDeltaEncoded = compaction.SnapshotsDeltaEncoded
+
+	deleted, err := s.db.DeleteOldSnapshots(ctx, now.Add(-s.snapshotRetention))
+	if err != nil {
+		return fmt.Errorf("failed to delete old snapshots: %w", err)
+	}
+	report.SnapshotsDeleted = deleted
+	telemetry.RecordRetentionPurge(ctx, "snapshots", deleted)
+
+	pruned, err := s.db.PruneOldNotifications(ctx, now.Add(-s.notificationRetention))
+	if err != nil {
+		return fmt.Errorf("failed to prune old notifications: %w", err)
+	}
+	report.NotificationsPruned = pruned
+	telemetry.RecordRetentionPurge(ctx, "notifications", pruned)
+
+	changesDeleted, err := s.db.PruneOldChanges(ctx, now.Add(-s.changeRetention))
+	if err != nil {
+		return fmt.Errorf("failed to prune old changes: %w", err)
+	}
+	report.ChangesDeleted = changesDeleted
+	telemetry.RecordRetentionPurge(ctx, "changes", changesDeleted)
+
+	auditPruned, err := s.db.PruneOldAuditLogEntries(ctx, now.Add(-s.auditRetention))
+	if err != nil {
+		return fmt.Errorf("failed to prune old audit log entries: %w", err)
+	}
+	report.AuditLogEntriesPruned = auditPruned
+	telemetry.RecordRetentionPurge(ctx, "audit_log", auditPruned)
+
+	if s.vacuumEnabled {
+		reclaimed, err := s.db.VacuumTables(ctx, []string{"snapshots", "notifications", "changes", "audit_log"})
+		if err != nil {
+			return fmt.Errorf("failed to vacuum tables: %w", err)
+		}
+		report.VacuumRan = true
+		report.ReclaimedBytes = reclaimed
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/organization"
+)
+
+const (
+	// defaultUsageWebhookSchedule sends usage webhooks daily at 4:00 AM UTC.
+	defaultUsageWebhookSchedule = "0 0 4 * * *"
+	usageWebhookLockName        = "mcp-notify:usage-webhook"
+)
+
+// UsageWebhookConfig holds usage webhook scheduler configuration.
+type UsageWebhookConfig struct {
+	Database     db.Database
+	Organization *organization.Manager
+
+	// Schedule is a 6-field (with seconds) cron expression controlling when
+	// usage webhooks are sent. Defaults to daily at 4:00 AM UTC.
+	Schedule string
+}
+
+// UsageWebhookScheduler periodically sends every organization with a
+// configured usage webhook its current usage, guarded by a database-held
+// lock so it never runs concurrently across replicas.
+type UsageWebhookScheduler struct {
+	db           db.Database
+	organization *organization.Manager
+	cron         *cron.Cron
+	schedule     string
+
+	mu       sync.RWMutex
+	lastRun  time.Time
+	lastSent int
+}
+
+// NewUsageWebhookScheduler creates a new usage webhook scheduler.
+func NewUsageWebhookScheduler(cfg UsageWebhookConfig) *UsageWebhookScheduler {
+	schedule := cfg.Schedule
+	if schedule == "" {
+		schedule = defaultUsageWebhookSchedule
+	}
+
+	return &UsageWebhookScheduler{
+		db:           cfg.Database,
+		organization: cfg.Organization,
+		cron:         cron.New(cron.WithSeconds()),
+		schedule:     schedule,
+	}
+}
+
+// Run starts the scheduler.
+func (s *UsageWebhookScheduler) Run(ctx context.Context) error {
+	log.Info().Str("schedule", s.schedule).Msg("Starting usage webhook scheduler")
+
+	if _, err := s.cron.AddFunc(s.schedule, func() {
+		s.sendUsageWebhooks(context.Background())
+	}); err != nil {
+		return err
+	}
+
+	s.cron.Start()
+
+	// Wait for context cancellation
+	<-ctx.Done()
+
+	log.Info().Msg("Stopping usage webhook scheduler")
+	cronCtx := s.cron.Stop()
+	<-cronCtx.Done()
+
+	return ctx.Err()
+}
+
+// sendUsageWebhooks sends every organization with a configured usage
+// webhook its current usage. One organization's failure is logged and
+// doesn't stop the others from being sent.
+func (s *UsageWebhookScheduler) sendUsageWebhooks(ctx context.Context) {
+	start := time.Now()
+	sent := 0
+
+	acquired, err := s.db.WithMaintenanceLock(ctx, usageWebhookLockName, func(ctx context.Context) error {
+		orgs, err := s.db.ListOrganizationsWithUsageWebhook(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := range orgs {
+			org := &orgs[i]
+			usage, err := s.organization.Usage(ctx, org.ID)
+			if err != nil {
+				log.Error().Err(err).Str("org_id", org.ID.String()).Msg("Failed to compute organization usage")
+				continue
+			}
+			if err := s.organization.SendUsageWebhook(ctx, org, usage); err != nil {
+				log.Error().Err(err).Str("org_id", org.ID.String()).Msg("Failed to send usage webhook")
+				continue
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Usage webhook run failed")
+	}
+	if !acquired {
+		log.Info().Msg("Skipping usage webhook run, lock already held")
+	}
+
+	s.mu.Lock()
+	s.lastRun = start.UTC()
+	s.lastSent = sent
+	s.mu.Unlock()
+
+	log.Info().Int("sent", sent).Int64("duration_ms", time.Since(start).Milliseconds()).Msg("Usage webhook run complete")
+}
@@ -0,0 +1,88 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+// resolveAWSSecretsManager resolves an "awssm:<secret-id>#<key>" reference
+// via the Secrets Manager GetSecretValue API, SigV4-signed with credentials
+// from the AWS SDK's default credential chain (same as the sns and
+// eventbridge senders). key is optional for a secret stored as a plain
+// string; required when it was stored as a JSON object.
+func (r *Resolver) resolveAWSSecretsManager(ctx context.Context, body string) (string, error) {
+	secretID, key := splitPathAndKey(body)
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	if awsCfg.Region == "" {
+		return "", fmt.Errorf("no AWS region configured, cannot resolve awssm secret reference")
+	}
+
+	creds, err := awsCfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve AWS credentials: %w", err)
+	}
+
+	payload, err := json.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return "", fmt.Errorf("failed to build secretsmanager request body: %w", err)
+	}
+	payloadHash := sha256.Sum256(payload)
+
+	endpoint := fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", awsCfg.Region)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to build secretsmanager request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	signer := v4.NewSigner()
+	if err := signer.SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), "secretsmanager", awsCfg.Region, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to sign secretsmanager request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach secretsmanager: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secretsmanager response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secretsmanager returned %s: %s", resp.Status, respBody)
+	}
+
+	var parsed struct {
+		SecretString string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse secretsmanager response: %w", err)
+	}
+
+	if key == "" {
+		return parsed.SecretString, nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(parsed.SecretString), &data); err != nil {
+		return "", fmt.Errorf("secret %q is not a JSON object, cannot extract key %q: %w", secretID, key, err)
+	}
+	return resolveKey(parsed.SecretString, data, key)
+}
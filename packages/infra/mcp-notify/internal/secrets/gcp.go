@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2/google"
+)
+
+// gcpSecretManagerScope is the OAuth scope required to call Secret Manager's
+// REST API.
+const gcpSecretManagerScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// resolveGCPSecretManager resolves a
+// "gcpsm:projects/<p>/secrets/<s>/versions/<v>" reference via the Secret
+// Manager REST API, authenticating with Application Default Credentials.
+// Unlike the vault and awssm forms this reference has no separate #key: a
+// GCP secret version is always a single opaque payload.
+func (r *Resolver) resolveGCPSecretManager(ctx context.Context, name string) (string, error) {
+	tokenSource, err := google.DefaultTokenSource(ctx, gcpSecretManagerScope)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve GCP application default credentials: %w", err)
+	}
+	token, err := tokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain GCP access token: %w", err)
+	}
+
+	url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s:access", name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build secret manager request: %w", err)
+	}
+	token.SetAuthHeader(req)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach secret manager: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret manager response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secret manager returned %s: %s", resp.Status, respBody)
+	}
+
+	var parsed struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse secret manager response: %w", err)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(parsed.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to base64-decode secret payload: %w", err)
+	}
+	return string(decoded), nil
+}
@@ -0,0 +1,64 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// resolveVault resolves a "vault:<path>#<key>" reference against the Vault
+// HTTP API at VAULT_ADDR, authenticating with VAULT_TOKEN. path is read
+// as-is against /v1/<path>, so a KV v2 mount needs its "data/" segment
+// included explicitly (e.g. "secret/data/mcp/smtp"), matching how `vault
+// kv get` reports the API path.
+func (r *Resolver) resolveVault(ctx context.Context, body string) (string, error) {
+	if r.vaultAddr == "" {
+		return "", fmt.Errorf("VAULT_ADDR is not set, cannot resolve vault secret reference")
+	}
+	if r.vaultToken == "" {
+		return "", fmt.Errorf("VAULT_TOKEN is not set, cannot resolve vault secret reference")
+	}
+
+	path, key := splitPathAndKey(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.vaultAddr+"/v1/"+path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", r.vaultToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body2, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned %s: %s", resp.Status, body2)
+	}
+
+	var parsed struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body2, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse vault response: %w", err)
+	}
+
+	// KV v2 nests the secret's own fields one level deeper, under
+	// data.data; KV v1 puts them directly under data.
+	data := parsed.Data
+	if nested, ok := parsed.Data["data"].(map[string]interface{}); ok {
+		data = nested
+	}
+
+	if key == "" {
+		return "", fmt.Errorf("vault reference %q is missing a #key; vault secrets are always key/value maps", path)
+	}
+	return resolveKey(string(body2), data, key)
+}
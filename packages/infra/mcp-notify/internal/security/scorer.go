@@ -0,0 +1,234 @@
+// Package security computes a supply-chain security score for a server's
+// declared packages by querying an OSV-compatible vulnerability database.
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// defaultOSVBaseURL is the public OSV.dev API used when Config.BaseURL is
+// left empty.
+const defaultOSVBaseURL = "https://api.osv.dev"
+
+// severityDeduction is how many points each vulnerability severity costs a
+// server's score. Unrecognized/empty severities are treated as "medium",
+// since OSV entries don't always carry a normalized severity.
+var severityDeduction = map[string]int{
+	"critical": 40,
+	"high":     25,
+	"medium":   10,
+	"low":      5,
+}
+
+// ecosystemByRegistryType maps this module's package registry_type values
+// to the OSV ecosystem names its API expects.
+var ecosystemByRegistryType = map[string]string{
+	"npm":   "npm",
+	"pypi":  "PyPI",
+	"nuget": "NuGet",
+	"oci":   "OSS-Fuzz", // best-effort; OSV has no dedicated OCI ecosystem
+	"go":    "Go",
+}
+
+// Config holds security scorer configuration.
+type Config struct {
+	// BaseURL is the OSV API base URL. Defaults to https://api.osv.dev.
+	BaseURL       string
+	Timeout       time.Duration
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Scorer computes a security score for a server from its declared packages.
+type Scorer struct {
+	baseURL       string
+	retryAttempts int
+	retryDelay    time.Duration
+	httpClient    *http.Client
+}
+
+// NewScorer creates a new security scorer.
+func NewScorer(cfg Config) *Scorer {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultOSVBaseURL
+	}
+
+	return &Scorer{
+		baseURL:       baseURL,
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+		httpClient:    &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// osvQuery is a single package lookup in an OSV querybatch request.
+type osvQuery struct {
+	Package osvPackage `json:"package"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type osvBatchRequest struct {
+	Queries []osvQuery `json:"queries"`
+}
+
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []osvVuln `json:"vulns"`
+	} `json:"results"`
+}
+
+type osvVuln struct {
+	ID       string `json:"id"`
+	Summary  string `json:"summary"`
+	Severity []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"`
+	} `json:"database_specific"`
+}
+
+// Score looks up known vulnerabilities for each of the server's declared
+// packages and returns a 0-100 score (100 = no known vulnerabilities) along
+// with the vulnerabilities that produced it. A package on a registry OSV
+// doesn't cover is skipped rather than failing the whole lookup.
+func (s *Scorer) Score(ctx context.Context, server types.Server) (int, []types.Vulnerability, error) {
+	queries := make([]osvQuery, 0, len(server.Packages))
+	queryPackages := make([]types.Package, 0, len(server.Packages))
+	for _, pkg := range server.Packages {
+		ecosystem, ok := ecosystemByRegistryType[pkg.RegistryType]
+		if !ok {
+			continue
+		}
+		queries = append(queries, osvQuery{Package: osvPackage{Name: pkg.Name, Ecosystem: ecosystem}})
+		queryPackages = append(queryPackages, pkg)
+	}
+
+	if len(queries) == 0 {
+		return 100, nil, nil
+	}
+
+	resp, err := s.queryBatch(ctx, queries)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to query OSV: %w", err)
+	}
+
+	score := 100
+	vulns := make([]types.Vulnerability, 0)
+	for i, result := range resp.Results {
+		if i >= len(queryPackages) {
+			break
+		}
+		for _, v := range result.Vulns {
+			severity := vulnSeverity(v)
+			score -= severityDeduction[severity]
+			vulns = append(vulns, types.Vulnerability{
+				ID:          v.ID,
+				PackageName: queryPackages[i].Name,
+				Summary:     v.Summary,
+				Severity:    severity,
+			})
+		}
+	}
+
+	if score < 0 {
+		score = 0
+	}
+
+	return score, vulns, nil
+}
+
+// vulnSeverity extracts a normalized severity from an OSV vulnerability
+// entry. Falls back to "medium" when OSV didn't classify it, so an unrated
+// vulnerability still costs the server something rather than nothing.
+func vulnSeverity(v osvVuln) string {
+	if v.DatabaseSpecific.Severity != "" {
+		return normalizeSeverity(v.DatabaseSpecific.Severity)
+	}
+	if len(v.Severity) > 0 {
+		return normalizeSeverity(v.Severity[0].Type)
+	}
+	return "medium"
+}
+
+func normalizeSeverity(raw string) string {
+	switch raw {
+	case "CRITICAL", "critical":
+		return "critical"
+	case "HIGH", "high":
+		return "high"
+	case "LOW", "low":
+		return "low"
+	default:
+		return "medium"
+	}
+}
+
+// queryBatch posts a batch of package queries to OSV, retrying transient
+// failures the same way the notifier senders do.
+func (s *Scorer) queryBatch(ctx context.Context, queries []osvQuery) (*osvBatchResponse, error) {
+	body, err := json.Marshal(osvBatchRequest{Queries: queries})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OSV request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= s.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying OSV query")
+		}
+
+		resp, err := s.doQuery(ctx, body)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+func (s *Scorer) doQuery(ctx context.Context, body []byte) (*osvBatchResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/v1/querybatch", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV returned status %d", resp.StatusCode)
+	}
+
+	var result osvBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,81 @@
+// Package semantic provides a lightweight, local, dependency-free stand-in
+// for an embedding model: a hashed bag-of-words vector, good enough to rank
+// natural-language queries against short server names and descriptions
+// without calling out to an external embedding API or shipping a real
+// model.
+package semantic
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Dimensions is the length of every vector Embed returns.
+const Dimensions = 64
+
+// Vector is an embedding of a piece of text.
+type Vector [Dimensions]float32
+
+// Embed computes a hashed bag-of-words vector for text: each token is
+// hashed into a dimension and accumulated with sign determined by a second
+// hash (the "hashing trick"), then the result is L2-normalized so cosine
+// similarity between two vectors reflects word overlap regardless of text
+// length.
+func Embed(text string) Vector {
+	var v Vector
+	for _, tok := range tokenize(text) {
+		dim, sign := hashToken(tok)
+		v[dim] += sign
+	}
+	return normalize(v)
+}
+
+// CosineSimilarity returns the cosine similarity between two vectors, in
+// [-1, 1]. Both are expected to already be normalized (as Embed's output
+// is), in which case this is just their dot product.
+func CosineSimilarity(a, b Vector) float64 {
+	var dot float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+	}
+	return dot
+}
+
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// hashToken maps a token to a dimension and a sign (+1/-1). Splitting the
+// hash into two independent bits this way keeps unrelated tokens from
+// canceling each other out as often as a single positive-only hash would.
+func hashToken(tok string) (int, float32) {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(tok))
+	sum := h.Sum32()
+
+	dim := int(sum % uint32(Dimensions))
+	sign := float32(1)
+	if sum&(1<<31) != 0 {
+		sign = -1
+	}
+	return dim, sign
+}
+
+func normalize(v Vector) Vector {
+	var sumSquares float64
+	for _, x := range v {
+		sumSquares += float64(x) * float64(x)
+	}
+	if sumSquares == 0 {
+		return v
+	}
+	norm := float32(math.Sqrt(sumSquares))
+	for i := range v {
+		v[i] /= norm
+	}
+	return v
+}
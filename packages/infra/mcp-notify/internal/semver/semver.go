@@ -0,0 +1,96 @@
+// Package semver provides just enough semantic-version parsing and
+// comparison for change classification and filtering, without pulling in
+// a full semver dependency the rest of the module doesn't otherwise need.
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed semantic version. Build metadata (a trailing
+// "+..." segment) is discarded since it plays no part in precedence.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+}
+
+// Parse extracts major.minor.patch and an optional pre-release tag from a
+// version string such as "v2.1.0" or "3.4.5-beta.1". Missing minor/patch
+// segments default to 0. ok is false if the leading major segment isn't
+// numeric, since that's not something this package can meaningfully
+// compare against another version.
+func Parse(version string) (Version, bool) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" {
+		return Version{}, false
+	}
+
+	if i := strings.IndexByte(version, '+'); i >= 0 {
+		version = version[:i]
+	}
+
+	var preRelease string
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		preRelease = version[i+1:]
+		version = version[:i]
+	}
+
+	parts := strings.SplitN(version, ".", 3)
+	nums := [3]int{}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			if i == 0 {
+				return Version{}, false
+			}
+			break
+		}
+		nums[i] = n
+	}
+
+	return Version{Major: nums[0], Minor: nums[1], Patch: nums[2], PreRelease: preRelease}, true
+}
+
+// Classify reports how significantly next moved past previous: "major",
+// "minor", "patch", or "none" if next is not newer. ok is false if either
+// version fails to parse, in which case bump is meaningless.
+func Classify(previous, next string) (bump string, ok bool) {
+	p, pOK := Parse(previous)
+	n, nOK := Parse(next)
+	if !pOK || !nOK {
+		return "", false
+	}
+
+	switch {
+	case n.Major > p.Major:
+		return "major", true
+	case n.Major == p.Major && n.Minor > p.Minor:
+		return "minor", true
+	case n.Major == p.Major && n.Minor == p.Minor && n.Patch > p.Patch:
+		return "patch", true
+	default:
+		return "none", true
+	}
+}
+
+// bumpRank orders bump significance so AtLeast can compare them.
+var bumpRank = map[string]int{
+	"none":  0,
+	"patch": 1,
+	"minor": 2,
+	"major": 3,
+}
+
+// AtLeast reports whether bump is at least as significant as min. An
+// unrecognized min imposes no restriction (returns true), since a typo'd
+// filter value shouldn't silently swallow every notification.
+func AtLeast(bump, min string) bool {
+	minRank, ok := bumpRank[min]
+	if !ok {
+		return true
+	}
+	return bumpRank[bump] >= minRank
+}
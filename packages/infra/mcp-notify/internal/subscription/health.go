@@ -0,0 +1,151 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// healthLookbackDays is how far back Health looks when computing filter
+// match rate and near-quota recommendations.
+const healthLookbackDays = 30
+
+// staleChannelAfter flags a channel as currently failing if its most recent
+// delivery attempt failed within this window.
+const staleChannelAfter = 3 * 24 * time.Hour
+
+// HealthReport is a subscription's delivery health score and actionable
+// recommendations, so a user can tell "is this subscription actually
+// working" without reading through raw notification history.
+type HealthReport struct {
+	SubscriptionID uuid.UUID `json:"subscription_id"`
+
+	// Score is 0-100, a weighted blend of delivery success rate (70%) and
+	// filter matches (30%). A subscription with no deliveries yet gets full
+	// marks on the delivery side; zero filter matches lowers the filter side
+	// to 60 of 100 whenever any recommendation was raised.
+	Score int `json:"score"`
+
+	// DeliverySuccessRate is successes / (successes + failures) across all
+	// channels, from their lifetime SuccessCount/FailureCount. -1 if no
+	// deliveries have been attempted yet.
+	DeliverySuccessRate float64 `json:"delivery_success_rate"`
+
+	// FilterMatchCount is how many changes in the lookback window matched
+	// this subscription's filters, regardless of whether delivery
+	// succeeded.
+	FilterMatchCount int `json:"filter_match_count"`
+
+	// LookbackDays is the window FilterMatchCount was computed over.
+	LookbackDays int `json:"lookback_days"`
+
+	ChannelStats    []ChannelStats `json:"channel_stats"`
+	Recommendations []string       `json:"recommendations,omitempty"`
+}
+
+// Health computes a subscription's delivery health score and recommendations.
+func (m *Manager) Health(ctx context.Context, id uuid.UUID) (*HealthReport, error) {
+	sub, err := m.db.GetSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if sub == nil {
+		return nil, fmt.Errorf("subscription not found")
+	}
+
+	report := &HealthReport{
+		SubscriptionID: sub.ID,
+		LookbackDays:   healthLookbackDays,
+	}
+
+	var successes, failures int
+	for _, ch := range sub.Channels {
+		report.ChannelStats = append(report.ChannelStats, ChannelStats{
+			ChannelID:    ch.ID,
+			Type:         ch.Type,
+			Enabled:      ch.Enabled,
+			SuccessCount: ch.SuccessCount,
+			FailureCount: ch.FailureCount,
+			LastSuccess:  ch.LastSuccess,
+			LastFailure:  ch.LastFailure,
+			LastError:    ch.LastError,
+		})
+		successes += ch.SuccessCount
+		failures += ch.FailureCount
+
+		if !ch.Enabled {
+			continue
+		}
+		if ch.FailureCount > 0 && ch.SuccessCount == 0 {
+			report.Recommendations = append(report.Recommendations,
+				fmt.Sprintf("your %s channel has never delivered successfully (%d failed attempt(s)): %s",
+					ch.Type, ch.FailureCount, ch.LastError))
+		} else if ch.LastFailure != nil && (ch.LastSuccess == nil || ch.LastFailure.After(*ch.LastSuccess)) &&
+			time.Since(*ch.LastFailure) < staleChannelAfter {
+			report.Recommendations = append(report.Recommendations,
+				fmt.Sprintf("your %s channel is currently failing: %s", ch.Type, ch.LastError))
+		}
+	}
+
+	if successes+failures == 0 {
+		report.DeliverySuccessRate = -1
+	} else {
+		report.DeliverySuccessRate = float64(successes) / float64(successes+failures)
+		if report.DeliverySuccessRate < 0.5 {
+			report.Recommendations = append(report.Recommendations,
+				fmt.Sprintf("delivery success rate is only %.0f%% across all channels", report.DeliverySuccessRate*100))
+		}
+	}
+
+	since := time.Now().Add(-healthLookbackDays * 24 * time.Hour)
+	changes, err := m.db.GetChangesSince(ctx, since, 10000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+	for _, change := range changes {
+		if m.MatchesFilters(&change, sub.Filters) {
+			report.FilterMatchCount++
+		}
+	}
+	if sub.Status == types.SubscriptionStatusActive && report.FilterMatchCount == 0 {
+		report.Recommendations = append(report.Recommendations,
+			fmt.Sprintf("filters matched 0 changes in the last %d days — consider broadening them", healthLookbackDays))
+	}
+
+	if sub.NotificationCount >= NotificationRateLimit*8/10 {
+		report.Recommendations = append(report.Recommendations,
+			fmt.Sprintf("subscription has sent %d/%d notifications this hour — approaching the rate limit",
+				sub.NotificationCount, NotificationRateLimit))
+	}
+
+	report.Score = scoreHealth(report)
+
+	return report, nil
+}
+
+// scoreHealth blends delivery success rate and filter match rate into a
+// single 0-100 score.
+func scoreHealth(r *HealthReport) int {
+	deliveryScore := 100.0
+	if r.DeliverySuccessRate >= 0 {
+		deliveryScore = r.DeliverySuccessRate * 100
+	}
+
+	filterScore := 100.0
+	if r.FilterMatchCount == 0 && len(r.Recommendations) > 0 {
+		filterScore = 60
+	}
+
+	score := int(deliveryScore*0.7 + filterScore*0.3 + 0.5)
+	if score > 100 {
+		score = 100
+	}
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
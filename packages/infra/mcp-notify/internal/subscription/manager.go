@@ -7,20 +7,38 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
 	"github.com/rs/zerolog/log"
 
 	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/notifier/template"
+	"github.com/nirholas/mcp-notify/internal/semver"
 	"github.com/nirholas/mcp-notify/pkg/types"
 )
 
+const (
+	// NotificationRateLimit is the maximum number of notifications a
+	// subscription may send within NotificationRateLimitWindow.
+	NotificationRateLimit = 1000
+
+	// NotificationRateLimitWindow is how often NotificationCount resets.
+	NotificationRateLimitWindow = time.Hour
+)
+
 // Manager handles subscription operations.
 type Manager struct {
 	db    db.Database
 	cache db.Cache
+
+	// regexCache holds compiled NamespaceRegex/KeywordRegex patterns so
+	// MatchesFilters doesn't recompile the same pattern on every change.
+	regexCache sync.Map
 }
 
 // NewManager creates a new subscription manager.
@@ -31,8 +49,88 @@ func NewManager(database db.Database, cache db.Cache) *Manager {
 	}
 }
 
+// compileRegex compiles pattern, caching the result so a subscription's
+// regex filter isn't recompiled on every change it's evaluated against.
+func (m *Manager) compileRegex(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := m.regexCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	m.regexCache.Store(pattern, re)
+	return re, nil
+}
+
+// ValidateFilters checks that any regex patterns on filters compile,
+// so a subscription is rejected at create/update time instead of silently
+// never matching later.
+func (m *Manager) ValidateFilters(filters types.SubscriptionFilter) error {
+	if filters.NamespaceRegex != "" {
+		if _, err := m.compileRegex(filters.NamespaceRegex); err != nil {
+			return fmt.Errorf("invalid namespace_regex: %w", err)
+		}
+	}
+	if filters.KeywordRegex != "" {
+		if _, err := m.compileRegex(filters.KeywordRegex); err != nil {
+			return fmt.Errorf("invalid keyword_regex: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateChannelTemplates checks that every channel's MessageTemplate, if
+// set, parses as a valid template, so a subscription is rejected at
+// create/update time instead of silently failing to notify later.
+func (m *Manager) ValidateChannelTemplates(channels []types.ChannelRequest) error {
+	for _, ch := range channels {
+		if ch.Config.MessageTemplate == "" {
+			continue
+		}
+		if err := template.Validate(ch.Config.MessageTemplate); err != nil {
+			return fmt.Errorf("invalid message_template for %s channel: %w", ch.Type, err)
+		}
+	}
+	return nil
+}
+
+// ValidateScheduledReport checks that an enabled scheduled report carries a
+// parseable standard 5-field cron expression, so a subscription is rejected
+// at creation/update time instead of silently never firing.
+func (m *Manager) ValidateScheduledReport(cfg types.ScheduledReportConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if _, err := cron.ParseStandard(cfg.CronExpression); err != nil {
+		return fmt.Errorf("invalid scheduled report cron_expression: %w", err)
+	}
+	switch cfg.Format {
+	case "", "markdown", "html":
+	default:
+		return fmt.Errorf("invalid scheduled report format %q: must be \"markdown\" or \"html\"", cfg.Format)
+	}
+	return nil
+}
+
 // Create creates a new subscription and returns it along with the API key.
-func (m *Manager) Create(ctx context.Context, req types.CreateSubscriptionRequest) (*types.Subscription, string, error) {
+// userID, when non-nil, attributes ownership to a logged-in user (see
+// internal/auth); orgID, when non-nil, attributes it to an organization
+// instead (see internal/organization). Both nil preserves the original
+// behavior of a subscription standing alone behind its own API key.
+func (m *Manager) Create(ctx context.Context, req types.CreateSubscriptionRequest, userID, orgID *uuid.UUID) (*types.Subscription, string, error) {
+	if err := m.ValidateFilters(req.Filters); err != nil {
+		return nil, "", err
+	}
+	if err := m.ValidateChannelTemplates(req.Channels); err != nil {
+		return nil, "", err
+	}
+	if err := m.ValidateScheduledReport(req.ScheduledReport); err != nil {
+		return nil, "", err
+	}
+
 	// Generate API key
 	apiKey, err := generateAPIKey()
 	if err != nil {
@@ -46,16 +144,28 @@ func (m *Manager) Create(ctx context.Context, req types.CreateSubscriptionReques
 	now := time.Now().UTC()
 
 	sub := &types.Subscription{
-		ID:          uuid.New(),
-		Name:        req.Name,
-		Description: req.Description,
-		Filters:     req.Filters,
-		Status:      types.SubscriptionStatusActive,
-		APIKey:      apiKeyHash,
-		APIKeyHint:  apiKeyHint,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		LastReset:   now,
+		ID:               uuid.New(),
+		Name:             req.Name,
+		Description:      req.Description,
+		Filters:          req.Filters,
+		Routes:           req.Routes,
+		DeliverySchedule: req.DeliverySchedule,
+		ScheduledReport:  req.ScheduledReport,
+		CoalesceWindow:   req.CoalesceWindow,
+		AllowedCIDRs:     req.AllowedCIDRs,
+		Status:           types.SubscriptionStatusActive,
+		APIKey:           apiKeyHash,
+		APIKeyHint:       apiKeyHint,
+		UserID:           userID,
+		OrgID:            orgID,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		LastReset:        now,
+	}
+
+	if req.WarmUpHours > 0 {
+		warmUpUntil := now.Add(time.Duration(req.WarmUpHours) * time.Hour)
+		sub.WarmUpUntil = &warmUpUntil
 	}
 
 	// Create subscription in database
@@ -110,8 +220,34 @@ func (m *Manager) Update(ctx context.Context, id uuid.UUID, req types.UpdateSubs
 		sub.Description = *req.Description
 	}
 	if req.Filters != nil {
+		if err := m.ValidateFilters(*req.Filters); err != nil {
+			return nil, err
+		}
 		sub.Filters = *req.Filters
 	}
+	if len(req.Channels) > 0 {
+		if err := m.ValidateChannelTemplates(req.Channels); err != nil {
+			return nil, err
+		}
+	}
+	if req.Routes != nil {
+		sub.Routes = req.Routes
+	}
+	if req.DeliverySchedule != nil {
+		sub.DeliverySchedule = *req.DeliverySchedule
+	}
+	if req.ScheduledReport != nil {
+		if err := m.ValidateScheduledReport(*req.ScheduledReport); err != nil {
+			return nil, err
+		}
+		sub.ScheduledReport = *req.ScheduledReport
+	}
+	if req.CoalesceWindow != nil {
+		sub.CoalesceWindow = *req.CoalesceWindow
+	}
+	if req.AllowedCIDRs != nil {
+		sub.AllowedCIDRs = req.AllowedCIDRs
+	}
 
 	sub.UpdatedAt = time.Now().UTC()
 
@@ -154,12 +290,22 @@ func (m *Manager) Update(ctx context.Context, id uuid.UUID, req types.UpdateSubs
 	return sub, nil
 }
 
-// Delete deletes a subscription.
+// Delete deletes a subscription and scrubs its channels' email addresses
+// from any surviving audit log entries, so a GDPR-style deletion request
+// doesn't leave them recoverable from audit history indefinitely.
 func (m *Manager) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := m.db.DeleteSubscription(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete subscription: %w", err)
 	}
 
+	if _, err := m.db.AnonymizeSubscriptionAuditLog(ctx, id); err != nil {
+		// The subscription itself is already gone; don't fail the deletion
+		// over a cleanup step, but this does need an operator's attention.
+		log.Error().Err(err).
+			Str("subscription_id", id.String()).
+			Msg("Failed to anonymize subscription's audit log entries")
+	}
+
 	log.Info().
 		Str("subscription_id", id.String()).
 		Msg("Deleted subscription")
@@ -215,6 +361,34 @@ func (m *Manager) Resume(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// EnableChannel re-enables a channel that was auto-disabled after too many
+// consecutive delivery failures (or manually disabled), resetting its
+// failure streak so it gets a fresh count against
+// NotificationsConfig.MaxConsecutiveFailures.
+func (m *Manager) EnableChannel(ctx context.Context, subscriptionID, channelID uuid.UUID) error {
+	channel, err := m.db.GetChannelByID(ctx, channelID)
+	if err != nil {
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+	if channel == nil || channel.SubscriptionID != subscriptionID {
+		return fmt.Errorf("channel not found")
+	}
+
+	channel.Enabled = true
+	channel.ConsecutiveFailures = 0
+
+	if err := m.db.UpdateChannel(ctx, channel); err != nil {
+		return fmt.Errorf("failed to update channel: %w", err)
+	}
+
+	log.Info().
+		Str("subscription_id", subscriptionID.String()).
+		Str("channel_id", channelID.String()).
+		Msg("Re-enabled channel")
+
+	return nil
+}
+
 // GetActiveSubscriptions returns all active subscriptions.
 func (m *Manager) GetActiveSubscriptions(ctx context.Context) ([]types.Subscription, error) {
 	return m.db.GetActiveSubscriptions(ctx)
@@ -237,6 +411,46 @@ func (m *Manager) UpdateLastNotified(ctx context.Context, id uuid.UUID) error {
 	return m.db.UpdateSubscription(ctx, sub)
 }
 
+// MarkWarmUpSummarySent records that a subscription's post-warm-up catch-up
+// summary has been delivered, so it is not sent again on later polls.
+func (m *Manager) MarkWarmUpSummarySent(ctx context.Context, id uuid.UUID) error {
+	sub, err := m.db.GetSubscriptionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub == nil {
+		return nil
+	}
+
+	sub.WarmUpSummarySent = true
+
+	return m.db.UpdateSubscription(ctx, sub)
+}
+
+// MuteServer adds serverName to a subscription's ExcludeServers filter, so
+// future changes to that server are suppressed even though it otherwise
+// matches the subscription. Used by the "Mute this server" notification
+// action. A no-op if the server is already muted.
+func (m *Manager) MuteServer(ctx context.Context, id uuid.UUID, serverName string) error {
+	sub, err := m.db.GetSubscriptionByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if sub == nil {
+		return fmt.Errorf("subscription not found")
+	}
+
+	for _, existing := range sub.Filters.ExcludeServers {
+		if existing == serverName {
+			return nil
+		}
+	}
+	sub.Filters.ExcludeServers = append(sub.Filters.ExcludeServers, serverName)
+	sub.UpdatedAt = time.Now().UTC()
+
+	return m.db.UpdateSubscription(ctx, sub)
+}
+
 // SendTestNotification sends a test notification to all channels of a subscription.
 func (m *Manager) SendTestNotification(ctx context.Context, id uuid.UUID) (map[string]string, error) {
 	sub, err := m.db.GetSubscriptionByID(ctx, id)
@@ -271,12 +485,8 @@ func (m *Manager) ValidateAPIKey(ctx context.Context, apiKey string) (*types.Sub
 // CheckRateLimit checks if the subscription has exceeded its rate limit.
 // Returns nil if within limits, or an error if rate limit exceeded.
 func (m *Manager) CheckRateLimit(ctx context.Context, sub *types.Subscription) error {
-	// Rate limit: 1000 notifications per hour per subscription
-	const notificationLimit = 1000
-	const resetWindow = time.Hour
-
 	// Check if we need to reset the counter
-	if time.Since(sub.LastReset) > resetWindow {
+	if time.Since(sub.LastReset) > NotificationRateLimitWindow {
 		// Reset the counter
 		sub.NotificationCount = 0
 		sub.LastReset = time.Now().UTC()
@@ -285,8 +495,8 @@ func (m *Manager) CheckRateLimit(ctx context.Context, sub *types.Subscription) e
 		}
 	}
 
-	if sub.NotificationCount >= notificationLimit {
-		resetTime := sub.LastReset.Add(resetWindow)
+	if sub.NotificationCount >= NotificationRateLimit {
+		resetTime := sub.LastReset.Add(NotificationRateLimitWindow)
 		return fmt.Errorf("rate limit exceeded: %d notifications sent, resets at %s",
 			sub.NotificationCount, resetTime.Format(time.RFC3339))
 	}
@@ -345,30 +555,30 @@ func (m *Manager) RotateAPIKey(ctx context.Context, id uuid.UUID) (string, error
 
 // SubscriptionStats holds statistics for a subscription.
 type SubscriptionStats struct {
-	SubscriptionID      uuid.UUID        `json:"subscription_id"`
-	Name                string           `json:"name"`
-	Status              string           `json:"status"`
-	TotalNotifications  int              `json:"total_notifications"`
-	SuccessfulDeliveries int             `json:"successful_deliveries"`
-	FailedDeliveries    int              `json:"failed_deliveries"`
-	ChannelCount        int              `json:"channel_count"`
-	ChannelStats        []ChannelStats   `json:"channel_stats"`
-	CreatedAt           time.Time        `json:"created_at"`
-	LastNotifiedAt      *time.Time       `json:"last_notified_at,omitempty"`
-	NotificationsToday  int              `json:"notifications_today"`
+	SubscriptionID        uuid.UUID      `json:"subscription_id"`
+	Name                  string         `json:"name"`
+	Status                string         `json:"status"`
+	TotalNotifications    int            `json:"total_notifications"`
+	SuccessfulDeliveries  int            `json:"successful_deliveries"`
+	FailedDeliveries      int            `json:"failed_deliveries"`
+	ChannelCount          int            `json:"channel_count"`
+	ChannelStats          []ChannelStats `json:"channel_stats"`
+	CreatedAt             time.Time      `json:"created_at"`
+	LastNotifiedAt        *time.Time     `json:"last_notified_at,omitempty"`
+	NotificationsToday    int            `json:"notifications_today"`
 	NotificationsThisHour int            `json:"notifications_this_hour"`
 }
 
 // ChannelStats holds statistics for a notification channel.
 type ChannelStats struct {
-	ChannelID     uuid.UUID           `json:"channel_id"`
-	Type          types.ChannelType   `json:"type"`
-	Enabled       bool                `json:"enabled"`
-	SuccessCount  int                 `json:"success_count"`
-	FailureCount  int                 `json:"failure_count"`
-	LastSuccess   *time.Time          `json:"last_success,omitempty"`
-	LastFailure   *time.Time          `json:"last_failure,omitempty"`
-	LastError     string              `json:"last_error,omitempty"`
+	ChannelID    uuid.UUID         `json:"channel_id"`
+	Type         types.ChannelType `json:"type"`
+	Enabled      bool              `json:"enabled"`
+	SuccessCount int               `json:"success_count"`
+	FailureCount int               `json:"failure_count"`
+	LastSuccess  *time.Time        `json:"last_success,omitempty"`
+	LastFailure  *time.Time        `json:"last_failure,omitempty"`
+	LastError    string            `json:"last_error,omitempty"`
 }
 
 // GetStats retrieves comprehensive statistics for a subscription.
@@ -429,17 +639,17 @@ func (m *Manager) GetStats(ctx context.Context, id uuid.UUID) (*SubscriptionStat
 	}
 
 	stats := &SubscriptionStats{
-		SubscriptionID:       sub.ID,
-		Name:                 sub.Name,
-		Status:               string(sub.Status),
-		TotalNotifications:   len(notifications),
-		SuccessfulDeliveries: successCount,
-		FailedDeliveries:     failCount,
-		ChannelCount:         len(channels),
-		ChannelStats:         channelStats,
-		CreatedAt:            sub.CreatedAt,
-		LastNotifiedAt:       sub.LastNotified,
-		NotificationsToday:   todayCount,
+		SubscriptionID:        sub.ID,
+		Name:                  sub.Name,
+		Status:                string(sub.Status),
+		TotalNotifications:    len(notifications),
+		SuccessfulDeliveries:  successCount,
+		FailedDeliveries:      failCount,
+		ChannelCount:          len(channels),
+		ChannelStats:          channelStats,
+		CreatedAt:             sub.CreatedAt,
+		LastNotifiedAt:        sub.LastNotified,
+		NotificationsToday:    todayCount,
 		NotificationsThisHour: hourCount,
 	}
 
@@ -471,14 +681,160 @@ func (m *Manager) List(ctx context.Context, limit, offset int) ([]types.Subscrip
 	return m.db.ListSubscriptions(ctx, limit, offset)
 }
 
+// findByDiscordChannelPageSize bounds each ListSubscriptions page fetched by
+// FindByDiscordChannel.
+const findByDiscordChannelPageSize = 100
+
+// FindByDiscordChannel returns every subscription with a Discord channel
+// bound to discordChannelID, e.g. one created by the "/mcp watch" bot
+// command in that channel. Used by "/mcp unwatch" to find what to remove.
+func (m *Manager) FindByDiscordChannel(ctx context.Context, discordChannelID string) ([]types.Subscription, error) {
+	var matches []types.Subscription
+
+	for offset := 0; ; offset += findByDiscordChannelPageSize {
+		subs, total, err := m.db.ListSubscriptions(ctx, findByDiscordChannelPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+
+		for _, sub := range subs {
+			for _, ch := range sub.Channels {
+				if ch.Type == types.ChannelDiscord && ch.Config.DiscordChannelID == discordChannelID {
+					matches = append(matches, sub)
+					break
+				}
+			}
+		}
+
+		if len(subs) == 0 || offset+len(subs) >= total {
+			break
+		}
+	}
+
+	return matches, nil
+}
+
+// findByTelegramChatPageSize bounds each ListSubscriptions page fetched by
+// FindByTelegramChat.
+const findByTelegramChatPageSize = 100
+
+// FindByTelegramChat returns every subscription with a Telegram channel
+// bound to chatID, e.g. one created by the "/subscribe" bot command in that
+// chat. Used by "/mute" to find what to mute.
+func (m *Manager) FindByTelegramChat(ctx context.Context, chatID string) ([]types.Subscription, error) {
+	var matches []types.Subscription
+
+	for offset := 0; ; offset += findByTelegramChatPageSize {
+		subs, total, err := m.db.ListSubscriptions(ctx, findByTelegramChatPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+
+		for _, sub := range subs {
+			for _, ch := range sub.Channels {
+				if ch.Type == types.ChannelTelegram && ch.Config.TelegramChatID == chatID {
+					matches = append(matches, sub)
+					break
+				}
+			}
+		}
+
+		if len(subs) == 0 || offset+len(subs) >= total {
+			break
+		}
+	}
+
+	return matches, nil
+}
+
+// disableEmailChannelPageSize bounds each ListSubscriptions page fetched by
+// DisableEmailChannel.
+const disableEmailChannelPageSize = 100
+
+// DisableEmailChannel disables every email channel bound to address, e.g.
+// in response to a hard bounce or spam complaint reported by the mail
+// provider's webhook. Returns how many channels were disabled.
+func (m *Manager) DisableEmailChannel(ctx context.Context, address string) (int, error) {
+	var disabled int
+
+	for offset := 0; ; offset += disableEmailChannelPageSize {
+		subs, total, err := m.db.ListSubscriptions(ctx, disableEmailChannelPageSize, offset)
+		if err != nil {
+			return disabled, fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+
+		for _, sub := range subs {
+			for _, ch := range sub.Channels {
+				if ch.Type != types.ChannelEmail || ch.Config.EmailAddress != address || !ch.Enabled {
+					continue
+				}
+				channel := ch
+				channel.Enabled = false
+				if err := m.db.UpdateChannel(ctx, &channel); err != nil {
+					return disabled, fmt.Errorf("failed to disable channel %s: %w", channel.ID, err)
+				}
+				disabled++
+			}
+		}
+
+		if len(subs) == 0 || offset+len(subs) >= total {
+			break
+		}
+	}
+
+	return disabled, nil
+}
+
 // MatchesFilters checks if a change matches a subscription's filters.
 func (m *Manager) MatchesFilters(change *types.Change, filters types.SubscriptionFilter) bool {
+	// Exclusions are checked first and always win, so a server muted via
+	// ExcludeServers/ExcludeNamespaces/ExcludeKeywords never matches even if
+	// it satisfies every inclusion filter below.
+	for _, s := range filters.ExcludeServers {
+		if s == change.ServerName {
+			return false
+		}
+	}
+
+	if filters.SuspiciousOnly && !change.Suspicious {
+		return false
+	}
+
+	if filters.ProvenanceAttestedOnly && (change.Server == nil || !change.Server.HasPublishedProvenance()) {
+		return false
+	}
+
+	for _, pattern := range filters.ExcludeNamespaces {
+		if matchNamespace(change.ServerName, pattern) {
+			return false
+		}
+	}
+
+	if len(filters.ExcludeKeywords) > 0 {
+		searchText := strings.ToLower(change.ServerName)
+		if change.Server != nil {
+			searchText += " " + strings.ToLower(change.Server.Description)
+		}
+		for _, kw := range filters.ExcludeKeywords {
+			if strings.Contains(searchText, strings.ToLower(kw)) {
+				return false
+			}
+		}
+	}
+
 	// If no filters are set, match everything
-	if len(filters.Servers) == 0 && 
-		len(filters.Namespaces) == 0 && 
-		len(filters.Keywords) == 0 && 
+	if len(filters.Servers) == 0 &&
+		len(filters.Namespaces) == 0 &&
+		len(filters.Keywords) == 0 &&
 		len(filters.ChangeTypes) == 0 &&
-		len(filters.PackageTypes) == 0 {
+		len(filters.Severities) == 0 &&
+		filters.MinVersionBump == "" &&
+		!filters.IgnorePreReleases &&
+		filters.NamespaceRegex == "" &&
+		filters.KeywordRegex == "" &&
+		len(filters.PackageTypes) == 0 &&
+		!filters.SuspiciousOnly &&
+		!filters.ProvenanceAttestedOnly {
 		return true
 	}
 
@@ -510,6 +866,34 @@ func (m *Manager) MatchesFilters(change *types.Change, filters types.Subscriptio
 		}
 	}
 
+	// Check severities
+	if len(filters.Severities) > 0 {
+		matched := false
+		for _, s := range filters.Severities {
+			if s == change.Severity {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	// Check minimum version bump
+	if filters.MinVersionBump != "" {
+		if bump, ok := semver.Classify(change.PreviousVersion, change.NewVersion); ok && !semver.AtLeast(bump, filters.MinVersionBump) {
+			return false
+		}
+	}
+
+	// Check pre-release exclusion
+	if filters.IgnorePreReleases {
+		if v, ok := semver.Parse(relevantVersion(change)); ok && v.PreRelease != "" {
+			return false
+		}
+	}
+
 	// Check namespaces (glob-style patterns)
 	if len(filters.Namespaces) > 0 {
 		matched := false
@@ -524,6 +908,13 @@ func (m *Manager) MatchesFilters(change *types.Change, filters types.Subscriptio
 		}
 	}
 
+	// Check namespace regex
+	if filters.NamespaceRegex != "" {
+		if re, err := m.compileRegex(filters.NamespaceRegex); err == nil && !re.MatchString(change.ServerName) {
+			return false
+		}
+	}
+
 	// Check keywords in name or description
 	if len(filters.Keywords) > 0 && change.Server != nil {
 		matched := false
@@ -539,6 +930,17 @@ func (m *Manager) MatchesFilters(change *types.Change, filters types.Subscriptio
 		}
 	}
 
+	// Check keyword regex
+	if filters.KeywordRegex != "" {
+		searchText := change.ServerName
+		if change.Server != nil {
+			searchText += " " + change.Server.Description
+		}
+		if re, err := m.compileRegex(filters.KeywordRegex); err == nil && !re.MatchString(searchText) {
+			return false
+		}
+	}
+
 	// Check package types
 	if len(filters.PackageTypes) > 0 && change.Server != nil {
 		matched := false
@@ -563,6 +965,16 @@ func (m *Manager) MatchesFilters(change *types.Change, filters types.Subscriptio
 
 // matchNamespace checks if a server name matches a namespace pattern.
 // Supports glob-style wildcards: * matches any sequence of characters.
+// relevantVersion returns the version string that best represents a change
+// for pre-release inspection: the new version for new/updated servers, or
+// the last known version for a removed one.
+func relevantVersion(change *types.Change) string {
+	if change.ChangeType == types.ChangeTypeRemoved {
+		return change.PreviousVersion
+	}
+	return change.NewVersion
+}
+
 func matchNamespace(serverName, pattern string) bool {
 	// Simple glob matching
 	if pattern == "*" {
@@ -579,8 +991,51 @@ func matchNamespace(serverName, pattern string) bool {
 	return serverName == pattern
 }
 
+// ResolveChannels returns the channels of sub that should receive change.
+// When sub has no routing rules, every channel is eligible (the pre-routing
+// behavior). Otherwise the first route whose NamespacePattern matches wins,
+// and only the channels at its ChannelIndexes are returned; a change that
+// matches no route is not delivered anywhere, so a catch-all "*" route
+// should be listed last if every change should go somewhere.
+func (m *Manager) ResolveChannels(sub *types.Subscription, change *types.Change) []types.Channel {
+	if len(sub.Routes) == 0 {
+		return sub.Channels
+	}
+
+	for _, route := range sub.Routes {
+		if !matchNamespace(change.ServerName, route.NamespacePattern) {
+			continue
+		}
+
+		channels := make([]types.Channel, 0, len(route.ChannelIndexes))
+		for _, idx := range route.ChannelIndexes {
+			if idx < 0 || idx >= len(sub.Channels) {
+				continue
+			}
+			channels = append(channels, sub.Channels[idx])
+		}
+		return channels
+	}
+
+	return nil
+}
+
 // Helper functions
 
+// GenerateAPIKey creates a new random subscription API key, in the same
+// "mcpw_"-prefixed format as a subscription's original API key, so
+// additional per-subscription keys (see Handlers.CreateSubscriptionAPIKey)
+// are indistinguishable from it at a glance.
+func GenerateAPIKey() (string, error) {
+	return generateAPIKey()
+}
+
+// HashAPIKey hashes an API key for storage/lookup, the same way a
+// subscription's original API key is hashed.
+func HashAPIKey(apiKey string) string {
+	return hashAPIKey(apiKey)
+}
+
 func generateAPIKey() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
@@ -0,0 +1,186 @@
+// Package summarizer condenses a server's field-level changes into a short
+// natural-language sentence ("server X migrated from npm to pypi and bumped
+// to 2.x") for digests and notifications, using an OpenAI-compatible chat
+// completions endpoint.
+package summarizer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// defaultModel is used when Config.Model is left empty.
+const defaultModel = "gpt-4o-mini"
+
+const systemPrompt = "You summarize changes to an MCP server registry entry in a single short " +
+	"sentence for a notification. Be factual and concise; mention only what actually changed. " +
+	"Do not add commentary, caveats, or markdown formatting."
+
+// Config holds summarizer client configuration.
+type Config struct {
+	// BaseURL is an OpenAI-compatible chat completions endpoint, e.g.
+	// https://api.openai.com/v1 or a local/self-hosted equivalent.
+	BaseURL       string
+	APIKey        string
+	Model         string
+	Timeout       time.Duration
+	RetryAttempts int
+	RetryDelay    time.Duration
+}
+
+// Client generates natural-language change summaries.
+type Client struct {
+	baseURL       string
+	apiKey        string
+	model         string
+	retryAttempts int
+	retryDelay    time.Duration
+	httpClient    *http.Client
+}
+
+// NewClient creates a new summarizer client.
+func NewClient(cfg Config) *Client {
+	model := cfg.Model
+	if model == "" {
+		model = defaultModel
+	}
+
+	return &Client{
+		baseURL:       strings.TrimSuffix(cfg.BaseURL, "/"),
+		apiKey:        cfg.APIKey,
+		model:         model,
+		retryAttempts: cfg.RetryAttempts,
+		retryDelay:    cfg.RetryDelay,
+		httpClient:    &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+	MaxTokens   int           `json:"max_tokens"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// Summarize returns a one-sentence natural-language summary of serverName's
+// field changes. Returns an error if fieldChanges is empty, since there is
+// nothing to summarize.
+func (c *Client) Summarize(ctx context.Context, serverName string, fieldChanges []types.FieldChange) (string, error) {
+	if len(fieldChanges) == 0 {
+		return "", fmt.Errorf("no field changes to summarize")
+	}
+
+	req := chatCompletionRequest{
+		Model: c.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: buildPrompt(serverName, fieldChanges)},
+		},
+		Temperature: 0.2,
+		MaxTokens:   80,
+	}
+
+	resp, err := c.complete(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate summary: %w", err)
+	}
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("summarizer returned no choices")
+	}
+
+	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
+}
+
+// buildPrompt renders fieldChanges as one line per field, so the model sees
+// a compact, unambiguous list rather than free-form JSON.
+func buildPrompt(serverName string, fieldChanges []types.FieldChange) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Server: %s\nChanges:\n", serverName)
+	for _, fc := range fieldChanges {
+		fmt.Fprintf(&sb, "- %s: %v -> %v\n", fc.Field, fc.OldValue, fc.NewValue)
+	}
+	return sb.String()
+}
+
+// complete posts a chat completion request, retrying transient failures the
+// same way the security scorer and provenance verifier do.
+func (c *Client) complete(ctx context.Context, body chatCompletionRequest) (*chatCompletionResponse, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.retryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.retryDelay * time.Duration(attempt)):
+			}
+			log.Debug().Int("attempt", attempt).Msg("Retrying summarizer request")
+		}
+
+		resp, err := c.doRequest(ctx, payload)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+func (c *Client) doRequest(ctx context.Context, payload []byte) (*chatCompletionResponse, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("summarizer returned status %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	var result chatCompletionResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
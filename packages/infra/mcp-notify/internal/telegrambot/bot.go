@@ -0,0 +1,173 @@
+// Package telegrambot implements Telegram's bot webhook, so a chat can
+// create and manage subscriptions with "/subscribe", "/mute", and "/recent"
+// commands instead of the admin API.
+package telegrambot
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/internal/subscription"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// recentChangesWindow and recentChangesLimit bound how far back and how much
+// "/recent" looks.
+const (
+	recentChangesWindow = 7 * 24 * time.Hour
+	recentChangesLimit  = 5
+)
+
+// Config holds Telegram bot configuration.
+type Config struct {
+	// SecretToken verifies that an inbound update really came from Telegram:
+	// it must match the secret_token configured via setWebhook, sent back
+	// verbatim in the X-Telegram-Bot-Api-Secret-Token header.
+	SecretToken string
+}
+
+// Bot handles Telegram bot updates for the "/start", "/subscribe", "/mute",
+// and "/recent" commands.
+type Bot struct {
+	secretToken     string
+	db              db.Database
+	subscriptionMgr *subscription.Manager
+}
+
+// NewBot creates a new Bot.
+func NewBot(cfg Config, database db.Database, subscriptionMgr *subscription.Manager) *Bot {
+	return &Bot{
+		secretToken:     cfg.SecretToken,
+		db:              database,
+		subscriptionMgr: subscriptionMgr,
+	}
+}
+
+// VerifySecretToken checks that an inbound update really came from Telegram:
+// headerValue must match the secret_token configured via setWebhook. Rejects
+// everything if no secret token is configured.
+func (b *Bot) VerifySecretToken(headerValue string) bool {
+	if b.secretToken == "" || headerValue == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(b.secretToken), []byte(headerValue)) == 1
+}
+
+// update is the subset of Telegram's Update object this bot acts on: a text
+// message in a chat.
+type update struct {
+	Message struct {
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+		Text string `json:"text"`
+	} `json:"message"`
+}
+
+// reply tells Telegram to call sendMessage as the webhook response, avoiding
+// a separate outbound call back to the Bot API.
+// See https://core.telegram.org/bots/api#making-requests-when-getting-updates.
+type reply struct {
+	Method string `json:"method"`
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// HandleUpdate dispatches an inbound update and returns the response body to
+// send back to Telegram, or nil if the update warrants no reply.
+func (b *Bot) HandleUpdate(ctx context.Context, body []byte) (any, error) {
+	var u update
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("invalid update payload: %w", err)
+	}
+
+	if u.Message.Text == "" || u.Message.Chat.ID == 0 {
+		return nil, nil
+	}
+	chatID := strconv.FormatInt(u.Message.Chat.ID, 10)
+
+	fields := strings.Fields(u.Message.Text)
+	command := strings.ToLower(strings.SplitN(fields[0], "@", 2)[0])
+	args := fields[1:]
+
+	switch command {
+	case "/start":
+		return b.reply(chatID, "Welcome! Use /subscribe <namespace> to watch a namespace, /mute <server> to silence one, and /recent to see recent changes."), nil
+	case "/subscribe":
+		if len(args) == 0 {
+			return b.reply(chatID, "Usage: /subscribe <namespace>"), nil
+		}
+		return b.handleSubscribe(ctx, chatID, args[0])
+	case "/mute":
+		if len(args) == 0 {
+			return b.reply(chatID, "Usage: /mute <server>"), nil
+		}
+		return b.handleMute(ctx, chatID, args[0])
+	case "/recent":
+		return b.handleRecent(ctx, chatID)
+	default:
+		return nil, nil
+	}
+}
+
+func (b *Bot) handleSubscribe(ctx context.Context, chatID, namespace string) (any, error) {
+	req := types.CreateSubscriptionRequest{
+		Name:    fmt.Sprintf("Telegram chat %s: %s", chatID, namespace),
+		Filters: types.SubscriptionFilter{Namespaces: []string{namespace}},
+		Channels: []types.ChannelRequest{{
+			Type:   types.ChannelTelegram,
+			Config: types.ChannelConfig{TelegramChatID: chatID},
+		}},
+	}
+
+	if _, _, err := b.subscriptionMgr.Create(ctx, req, nil, nil); err != nil {
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	return b.reply(chatID, fmt.Sprintf("Now watching %q in this chat.", namespace)), nil
+}
+
+func (b *Bot) handleMute(ctx context.Context, chatID, serverName string) (any, error) {
+	subs, err := b.subscriptionMgr.FindByTelegramChat(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return b.reply(chatID, "This chat isn't subscribed to anything."), nil
+	}
+
+	for _, s := range subs {
+		if err := b.subscriptionMgr.MuteServer(ctx, s.ID, serverName); err != nil {
+			return nil, fmt.Errorf("failed to mute server for subscription %s: %w", s.ID, err)
+		}
+	}
+
+	return b.reply(chatID, fmt.Sprintf("Muted %q for this chat.", serverName)), nil
+}
+
+func (b *Bot) handleRecent(ctx context.Context, chatID string) (any, error) {
+	changes, err := b.db.GetChangesSince(ctx, time.Now().Add(-recentChangesWindow), recentChangesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recent changes: %w", err)
+	}
+	if len(changes) == 0 {
+		return b.reply(chatID, "No recent changes in the last 7 days."), nil
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, c := range changes {
+		lines = append(lines, fmt.Sprintf("- %s %s", c.ServerName, c.ChangeType))
+	}
+
+	return b.reply(chatID, strings.Join(lines, "\n")), nil
+}
+
+func (b *Bot) reply(chatID, text string) reply {
+	return reply{Method: "sendMessage", ChatID: chatID, Text: text}
+}
@@ -17,6 +17,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -26,6 +27,20 @@ import (
 	"github.com/nirholas/mcp-notify/internal/config"
 )
 
+// Metric names, exported so tooling (e.g. the observability export command)
+// can reference the exact names this binary emits instead of hardcoding a
+// copy that can drift.
+const (
+	MetricPollsTotal               = "mcp_watch_polls_total"
+	MetricPollDurationSeconds      = "mcp_watch_poll_duration_seconds"
+	MetricChangesDetectedTotal     = "mcp_watch_changes_detected_total"
+	MetricNotificationsSentTotal   = "mcp_watch_notifications_sent_total"
+	MetricNotificationsFailedTotal = "mcp_watch_notifications_failed_total"
+	MetricSubscriptionsActive      = "mcp_watch_subscriptions_active"
+	MetricRegistryServersTotal     = "mcp_watch_registry_servers_total"
+	MetricRetentionRowsPurgedTotal = "mcp_watch_retention_rows_purged_total"
+)
+
 // getHostname returns the hostname or "unknown" if it can't be determined.
 func getHostname() string {
 	if h, err := os.Hostname(); err == nil {
@@ -36,13 +51,14 @@ func getHostname() string {
 
 var (
 	// Metrics
-	pollsTotal            metric.Int64Counter
-	pollDuration          metric.Float64Histogram
-	changesDetectedTotal  metric.Int64Counter
+	pollsTotal               metric.Int64Counter
+	pollDuration             metric.Float64Histogram
+	changesDetectedTotal     metric.Int64Counter
 	notificationsSentTotal   metric.Int64Counter
 	notificationsFailedTotal metric.Int64Counter
-	subscriptionsActive   metric.Int64Gauge
-	registryServersTotal  metric.Int64Gauge
+	subscriptionsActive      metric.Int64Gauge
+	registryServersTotal     metric.Int64Gauge
+	retentionRowsPurgedTotal metric.Int64Counter
 
 	// Tracer
 	tracer trace.Tracer
@@ -171,7 +187,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Counter: Total number of registry polls
 	pollsTotal, err = m.Int64Counter(
-		"mcp_watch_polls_total",
+		MetricPollsTotal,
 		metric.WithDescription("Total number of registry polls"),
 		metric.WithUnit("{poll}"),
 	)
@@ -181,7 +197,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Histogram: Poll duration in seconds
 	pollDuration, err = m.Float64Histogram(
-		"mcp_watch_poll_duration_seconds",
+		MetricPollDurationSeconds,
 		metric.WithDescription("Duration of registry polls in seconds"),
 		metric.WithUnit("s"),
 		metric.WithExplicitBucketBoundaries(0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30),
@@ -192,7 +208,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Counter: Total changes detected by type
 	changesDetectedTotal, err = m.Int64Counter(
-		"mcp_watch_changes_detected_total",
+		MetricChangesDetectedTotal,
 		metric.WithDescription("Total number of changes detected by type"),
 		metric.WithUnit("{change}"),
 	)
@@ -202,7 +218,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Counter: Total notifications sent by channel type
 	notificationsSentTotal, err = m.Int64Counter(
-		"mcp_watch_notifications_sent_total",
+		MetricNotificationsSentTotal,
 		metric.WithDescription("Total number of notifications sent by channel type"),
 		metric.WithUnit("{notification}"),
 	)
@@ -212,7 +228,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Counter: Total notifications failed by channel type
 	notificationsFailedTotal, err = m.Int64Counter(
-		"mcp_watch_notifications_failed_total",
+		MetricNotificationsFailedTotal,
 		metric.WithDescription("Total number of notifications failed by channel type"),
 		metric.WithUnit("{notification}"),
 	)
@@ -222,7 +238,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Gauge: Active subscriptions
 	subscriptionsActive, err = m.Int64Gauge(
-		"mcp_watch_subscriptions_active",
+		MetricSubscriptionsActive,
 		metric.WithDescription("Number of active subscriptions"),
 		metric.WithUnit("{subscription}"),
 	)
@@ -232,7 +248,7 @@ func initializeMetrics(m metric.Meter) error {
 
 	// Gauge: Total servers in registry
 	registryServersTotal, err = m.Int64Gauge(
-		"mcp_watch_registry_servers_total",
+		MetricRegistryServersTotal,
 		metric.WithDescription("Total number of servers in the registry"),
 		metric.WithUnit("{server}"),
 	)
@@ -240,6 +256,16 @@ func initializeMetrics(m metric.Meter) error {
 		return fmt.Errorf("failed to create registry_servers_total metric: %w", err)
 	}
 
+	// Counter: Total rows purged by the retention job, by table
+	retentionRowsPurgedTotal, err = m.Int64Counter(
+		MetricRetentionRowsPurgedTotal,
+		metric.WithDescription("Total number of rows purged by the retention/maintenance job, by table"),
+		metric.WithUnit("{row}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create retention_rows_purged_total metric: %w", err)
+	}
+
 	return nil
 }
 
@@ -364,6 +390,18 @@ func SetRegistryServers(ctx context.Context, count int64) {
 	registryServersTotal.Record(ctx, count)
 }
 
+// RecordRetentionPurge records rows purged from table by the retention
+// maintenance job. Counts of 0 are skipped rather than recorded as a no-op.
+func RecordRetentionPurge(ctx context.Context, table string, count int64) {
+	if retentionRowsPurgedTotal == nil || count <= 0 {
+		return
+	}
+
+	retentionRowsPurgedTotal.Add(ctx, count, metric.WithAttributes(
+		attribute.String("table", table),
+	))
+}
+
 // -----------------------------------------------------------------------------
 // Tracing Functions
 // -----------------------------------------------------------------------------
@@ -397,6 +435,49 @@ func SetSpanError(ctx context.Context, err error) {
 	}
 }
 
+// traceContextPropagator carries a span's context as a W3C traceparent
+// string so it can survive a round trip through storage (e.g. the
+// notification outbox), unlike a context.Context itself.
+var traceContextPropagator = propagation.TraceContext{}
+
+// InjectTraceContext serializes ctx's current span context into a W3C
+// traceparent string, or "" if ctx carries no recording span. Pair with
+// ExtractTraceContext to continue the same trace after a value has been
+// persisted and reloaded elsewhere (e.g. a notification outbox row).
+func InjectTraceContext(ctx context.Context) string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ""
+	}
+	carrier := propagation.MapCarrier{}
+	traceContextPropagator.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ExtractTraceContext returns a context carrying traceparent's span context
+// as the remote parent, so a span started from the returned context
+// continues that trace. Returns ctx unchanged if traceparent is empty or
+// invalid.
+func ExtractTraceContext(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{"traceparent": traceparent}
+	return traceContextPropagator.Extract(ctx, carrier)
+}
+
+// LinkFromTraceContext returns a trace.Link pointing at traceparent's span,
+// or false if traceparent is empty or invalid. Use to connect a new trace
+// back to one that already finished (e.g. a dispatch retried long after the
+// poll that detected its change), where continuing the original trace via
+// ExtractTraceContext no longer makes sense.
+func LinkFromTraceContext(traceparent string) (trace.Link, bool) {
+	sc := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), traceparent))
+	if !sc.IsValid() {
+		return trace.Link{}, false
+	}
+	return trace.Link{SpanContext: sc}, true
+}
+
 // -----------------------------------------------------------------------------
 // Legacy Prometheus Metrics (for direct registration if needed)
 // -----------------------------------------------------------------------------
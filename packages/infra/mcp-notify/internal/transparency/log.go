@@ -0,0 +1,191 @@
+// Package transparency maintains an append-only, hash-chained log of
+// detected changes so third parties can verify the notification service
+// isn't silently omitting or rewriting history.
+package transparency
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nirholas/mcp-notify/internal/db"
+	"github.com/nirholas/mcp-notify/pkg/types"
+)
+
+// genesisHash is the prev_hash of the first entry in the log, a
+// conventional all-zero value rather than an empty string so the hash
+// chain has a fixed-width anchor to verify against.
+var genesisHash = strings.Repeat("0", 64)
+
+// Log appends changes to the transparency log one at a time, chaining each
+// entry's hash to the previous one. Appends are serialized by mu so index
+// assignment and prev-hash lookup never race across concurrent pollers.
+type Log struct {
+	db     db.Database
+	signer ed25519.PrivateKey // nil if signing is not configured
+	mu     sync.Mutex
+}
+
+// NewLog creates a transparency log backed by database. signingKeySeed is a
+// hex-encoded 32-byte Ed25519 seed; if empty, entries are hash-chained but
+// not signed.
+func NewLog(database db.Database, signingKeySeed string) (*Log, error) {
+	l := &Log{db: database}
+
+	if signingKeySeed == "" {
+		return l, nil
+	}
+
+	seed, err := hex.DecodeString(signingKeySeed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transparency signing key seed: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("transparency signing key seed must be %d bytes, got %d", ed25519.SeedSize, len(seed))
+	}
+	l.signer = ed25519.NewKeyFromSeed(seed)
+
+	return l, nil
+}
+
+// PublicKey returns the base64-encoded Ed25519 public key entries are
+// signed with, or "" if signing is not configured.
+func (l *Log) PublicKey() string {
+	if l.signer == nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(l.signer.Public().(ed25519.PublicKey))
+}
+
+// Append hash-chains change onto the log and persists the resulting entry.
+func (l *Log) Append(ctx context.Context, change *types.Change) (*types.TransparencyEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	prevHash := genesisHash
+	var index int64
+
+	latest, err := l.db.GetLatestTransparencyEntry(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load latest transparency entry: %w", err)
+	}
+	if latest != nil {
+		index = latest.Index + 1
+		prevHash = latest.EntryHash
+	}
+
+	entry := &types.TransparencyEntry{
+		Index:     index,
+		ChangeID:  change.ID,
+		PrevHash:  prevHash,
+		EntryHash: computeEntryHash(prevHash, change),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if l.signer != nil {
+		sig := ed25519.Sign(l.signer, []byte(entry.EntryHash))
+		entry.Signature = base64.StdEncoding.EncodeToString(sig)
+	}
+
+	if err := l.db.SaveTransparencyEntry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to save transparency entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// InclusionProof reports whether the entry at an index is genuinely part of
+// the chain: its hash was derived correctly from the previous entry's hash
+// and the referenced change, and its signature (if any) verifies.
+type InclusionProof struct {
+	Entry       *types.TransparencyEntry `json:"entry"`
+	Valid       bool                     `json:"valid"`
+	Reason      string                   `json:"reason,omitempty"`
+	ChainLength int64                    `json:"chain_length"`
+	PublicKey   string                   `json:"public_key,omitempty"`
+}
+
+// GetProof retrieves the entry at index and verifies it against the change
+// it references, so a caller doesn't have to trust the stored hash blindly.
+func (l *Log) GetProof(ctx context.Context, index int64) (*InclusionProof, error) {
+	entry, err := l.db.GetTransparencyEntry(ctx, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transparency entry: %w", err)
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	chainLength, err := l.db.CountTransparencyEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count transparency entries: %w", err)
+	}
+
+	proof := &InclusionProof{
+		Entry:       entry,
+		ChainLength: chainLength,
+		PublicKey:   l.PublicKey(),
+	}
+
+	change, err := l.db.GetChangeByID(ctx, entry.ChangeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load referenced change: %w", err)
+	}
+	if change == nil {
+		proof.Reason = "referenced change no longer exists"
+		return proof, nil
+	}
+
+	expectedHash := computeEntryHash(entry.PrevHash, change)
+	if expectedHash != entry.EntryHash {
+		proof.Reason = "entry hash does not match the referenced change"
+		return proof, nil
+	}
+
+	if entry.Signature != "" {
+		sig, err := base64.StdEncoding.DecodeString(entry.Signature)
+		if err != nil || l.signer == nil || !ed25519.Verify(l.signer.Public().(ed25519.PublicKey), []byte(entry.EntryHash), sig) {
+			proof.Reason = "signature verification failed"
+			return proof, nil
+		}
+	}
+
+	if index > 0 {
+		prevEntry, err := l.db.GetTransparencyEntry(ctx, index-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load previous transparency entry: %w", err)
+		}
+		if prevEntry == nil || prevEntry.EntryHash != entry.PrevHash {
+			proof.Reason = "prev_hash does not match the preceding entry"
+			return proof, nil
+		}
+	} else if entry.PrevHash != genesisHash {
+		proof.Reason = "first entry does not chain from the genesis hash"
+		return proof, nil
+	}
+
+	proof.Valid = true
+	return proof, nil
+}
+
+// computeEntryHash commits prevHash to the fields of change that make it
+// uniquely identifiable, so any alteration of a past change is detectable
+// by recomputing the chain.
+func computeEntryHash(prevHash string, change *types.Change) string {
+	h := sha256.New()
+	h.Write([]byte(prevHash))
+	h.Write([]byte(change.ID.String()))
+	h.Write([]byte(change.ServerName))
+	h.Write([]byte(change.ChangeType))
+	h.Write([]byte(change.PreviousVersion))
+	h.Write([]byte(change.NewVersion))
+	h.Write([]byte(change.Severity))
+	h.Write([]byte(change.DetectedAt.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(h.Sum(nil))
+}
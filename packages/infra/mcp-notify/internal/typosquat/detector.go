@@ -0,0 +1,118 @@
+// Package typosquat detects server names that are suspiciously close to a
+// name a subscriber already watches, the pattern behind a typosquat or
+// homoglyph registration meant to be mistaken for a trusted server.
+package typosquat
+
+import "strings"
+
+// maxEditDistance is how close (in edits) a candidate name must be to a
+// watched name to be flagged, once both are long enough that a match isn't
+// just two short, naturally similar names.
+const maxEditDistance = 2
+
+// minNameLength is the shortest watched name this package will compare
+// against, below which almost any two names are within maxEditDistance and
+// flagging would be mostly noise.
+const minNameLength = 6
+
+// homoglyphs maps characters commonly substituted to visually impersonate
+// another (Cyrillic look-alikes, digit/letter swaps) to the Latin letter
+// they're meant to be mistaken for. Detect normalizes through this table
+// before comparing, so "gіthub" (Cyrillic і) still matches "github".
+var homoglyphs = map[rune]rune{
+	'а': 'a', '0': 'o', 'о': 'o', 'р': 'p', 'е': 'e',
+	'с': 'c', 'у': 'y', 'х': 'x', '1': 'l', 'і': 'i',
+	'ѕ': 's', 'ј': 'j', 'ԁ': 'd', 'ո': 'n',
+}
+
+// Match describes a candidate name flagged against one watched name.
+type Match struct {
+	WatchedName string
+	Distance    int
+	Homoglyph   bool
+}
+
+// Detect compares candidate against every watched name and returns the
+// closest match, if any is within maxEditDistance (computed on normalized
+// forms so homoglyph substitutions count as no distance at all). candidate
+// itself is never matched against an identical watched name, since that's
+// the real server, not a lookalike.
+func Detect(candidate string, watched []string) (Match, bool) {
+	normalizedCandidate := normalize(candidate)
+
+	var best Match
+	found := false
+
+	for _, name := range watched {
+		if name == candidate || len(name) < minNameLength {
+			continue
+		}
+
+		normalizedName := normalize(name)
+		if normalizedName == normalizedCandidate {
+			return Match{WatchedName: name, Distance: 0, Homoglyph: true}, true
+		}
+
+		distance := levenshtein(normalizedCandidate, normalizedName)
+		if distance > maxEditDistance {
+			continue
+		}
+		if !found || distance < best.Distance {
+			best = Match{WatchedName: name, Distance: distance}
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// normalize lowercases and substitutes known homoglyphs, so visually
+// confusable names compare equal or near-equal instead of merely similar.
+func normalize(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	for _, r := range strings.ToLower(name) {
+		if repl, ok := homoglyphs[r]; ok {
+			r = repl
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// levenshtein computes the classic single-character insert/delete/substitute
+// edit distance between two strings.
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(curr[j-1]+1, prev[j]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(br)]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
@@ -1,4 +1,8 @@
-// Package client provides a Go SDK for the MCP Notify API.
+// Package client provides a Go SDK for the MCP Notify API. It's built to
+// back tools that manage subscriptions declaratively (e.g. a Terraform
+// provider): requests retry on transient failures, and subscription reads
+// and writes carry an ETag so a caller can detect and reject a write based
+// on stale state instead of silently clobbering a concurrent change.
 package client
 
 import (
@@ -7,16 +11,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
+// defaultMaxRetries is how many times a retryable request is retried before
+// giving up, on top of the initial attempt.
+const defaultMaxRetries = 3
+
+// defaultRetryBackoff is the base delay before the first retry; each
+// subsequent retry doubles it.
+const defaultRetryBackoff = 250 * time.Millisecond
+
 // Client is the MCP Notify API client.
 type Client struct {
-	baseURL    string
-	apiKey     string
-	httpClient *http.Client
+	baseURL      string
+	apiKey       string
+	httpClient   *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
 }
 
 // Option configures the client.
@@ -36,6 +52,23 @@ func WithAPIKey(key string) Option {
 	}
 }
 
+// WithMaxRetries sets how many times a request is retried after a network
+// error or a 429/5xx response, on top of the initial attempt. 0 disables
+// retries.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		c.maxRetries = n
+	}
+}
+
+// WithRetryBackoff sets the base delay before the first retry; each
+// subsequent retry doubles it.
+func WithRetryBackoff(d time.Duration) Option {
+	return func(c *Client) {
+		c.retryBackoff = d
+	}
+}
+
 // New creates a new MCP Notify client.
 func New(baseURL string, opts ...Option) *Client {
 	c := &Client{
@@ -43,6 +76,8 @@ func New(baseURL string, opts ...Option) *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		maxRetries:   defaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -102,6 +137,13 @@ type Subscription struct {
 	Status      string             `json:"status"`
 	CreatedAt   time.Time          `json:"created_at"`
 	UpdatedAt   time.Time          `json:"updated_at"`
+
+	// ETag identifies this exact version of the subscription, as returned
+	// in the API response's ETag header (not part of the JSON body). Pass
+	// it back as ifMatch to UpdateSubscription or DeleteSubscription for
+	// optimistic concurrency: the write is rejected with a
+	// PreconditionFailed Error if the subscription changed since this read.
+	ETag string `json:"-"`
 }
 
 // SubscriptionFilter defines filters for a subscription.
@@ -166,6 +208,23 @@ type ListSubscriptionsResponse struct {
 	TotalCount    int            `json:"total_count"`
 }
 
+// Snapshot is a point-in-time capture of the registry that a DiffResult was
+// computed between.
+type Snapshot struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DiffResult is the result of comparing two registry snapshots.
+type DiffResult struct {
+	FromSnapshot   *Snapshot `json:"from_snapshot"`
+	ToSnapshot     *Snapshot `json:"to_snapshot"`
+	NewServers     []Change  `json:"new_servers"`
+	UpdatedServers []Change  `json:"updated_servers"`
+	RemovedServers []Change  `json:"removed_servers"`
+	TotalChanges   int       `json:"total_changes"`
+}
+
 // Stats contains registry statistics.
 type Stats struct {
 	TotalServers       int `json:"total_servers"`
@@ -185,6 +244,19 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Message)
 }
 
+// PreconditionFailed reports whether the request was rejected because an
+// If-Match ETag no longer matched the subscription's current state.
+func (e *Error) PreconditionFailed() bool {
+	return e.StatusCode == http.StatusPreconditionFailed
+}
+
+// retryable reports whether a request that failed with this error is worth
+// retrying: rate-limited or a server-side failure, as opposed to a client
+// error that will fail again on retry.
+func (e *Error) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
 // ListServers returns all servers in the registry.
 func (c *Client) ListServers(ctx context.Context) (*ListServersResponse, error) {
 	var resp ListServersResponse
@@ -204,6 +276,33 @@ func (c *Client) SearchServers(ctx context.Context, query string) (*ListServersR
 	return &resp, nil
 }
 
+// SemanticSearchResult is one ranked match from SemanticSearchServers.
+type SemanticSearchResult struct {
+	Server     Server  `json:"server"`
+	Similarity float64 `json:"similarity"`
+}
+
+// SemanticSearchResponse is the result of SemanticSearchServers.
+type SemanticSearchResponse struct {
+	Results []SemanticSearchResult `json:"results"`
+	Count   int                    `json:"count"`
+}
+
+// SemanticSearchServers ranks servers against a natural-language query,
+// rather than requiring an exact keyword match. limit <= 0 uses the
+// server's default.
+func (c *Client) SemanticSearchServers(ctx context.Context, query string, limit int) (*SemanticSearchResponse, error) {
+	params := url.Values{"q": {query}}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	var resp SemanticSearchResponse
+	if err := c.get(ctx, "/api/v1/servers/semantic-search", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetServer returns a specific server by name.
 func (c *Client) GetServer(ctx context.Context, name string) (*Server, error) {
 	var resp Server
@@ -255,12 +354,28 @@ func (c *Client) GetStats(ctx context.Context) (*Stats, error) {
 	return &resp, nil
 }
 
+// GetDiff computes the diff between the registry snapshots closest to the
+// given "from" and "to" timestamps.
+func (c *Client) GetDiff(ctx context.Context, from, to time.Time) (*DiffResult, error) {
+	params := url.Values{
+		"from": {from.Format(time.RFC3339)},
+		"to":   {to.Format(time.RFC3339)},
+	}
+	var resp DiffResult
+	if err := c.get(ctx, "/api/v1/diff", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreateSubscription creates a new subscription.
 func (c *Client) CreateSubscription(ctx context.Context, req *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
 	var resp CreateSubscriptionResponse
-	if err := c.post(ctx, "/api/v1/subscriptions", req, &resp); err != nil {
+	etag, err := c.request(ctx, http.MethodPost, "/api/v1/subscriptions", nil, req, &resp)
+	if err != nil {
 		return nil, err
 	}
+	resp.ETag = etag
 	return &resp, nil
 }
 
@@ -273,27 +388,49 @@ func (c *Client) ListSubscriptions(ctx context.Context) (*ListSubscriptionsRespo
 	return &resp, nil
 }
 
-// GetSubscription returns a specific subscription by ID.
+// GetSubscription returns a specific subscription by ID. The returned
+// Subscription's ETag can be passed as ifMatch to UpdateSubscription or
+// DeleteSubscription for optimistic concurrency.
 func (c *Client) GetSubscription(ctx context.Context, id string) (*Subscription, error) {
 	var resp Subscription
-	if err := c.get(ctx, "/api/v1/subscriptions/"+url.PathEscape(id), nil, &resp); err != nil {
+	etag, err := c.request(ctx, http.MethodGet, "/api/v1/subscriptions/"+url.PathEscape(id), nil, nil, &resp)
+	if err != nil {
 		return nil, err
 	}
+	resp.ETag = etag
 	return &resp, nil
 }
 
-// UpdateSubscription updates an existing subscription.
-func (c *Client) UpdateSubscription(ctx context.Context, id string, req *UpdateSubscriptionRequest) (*Subscription, error) {
+// UpdateSubscription updates an existing subscription. If ifMatch is
+// non-empty, the update is rejected with a PreconditionFailed Error if the
+// subscription's current ETag doesn't match it, so a caller (e.g. a
+// Terraform provider) can detect it's about to overwrite a change it
+// hasn't seen. Pass "" to skip the check.
+func (c *Client) UpdateSubscription(ctx context.Context, id string, req *UpdateSubscriptionRequest, ifMatch string) (*Subscription, error) {
 	var resp Subscription
-	if err := c.put(ctx, "/api/v1/subscriptions/"+url.PathEscape(id), req, &resp); err != nil {
+	etag, err := c.request(ctx, http.MethodPut, "/api/v1/subscriptions/"+url.PathEscape(id), ifMatchHeader(ifMatch), req, &resp)
+	if err != nil {
 		return nil, err
 	}
+	resp.ETag = etag
 	return &resp, nil
 }
 
-// DeleteSubscription deletes a subscription.
-func (c *Client) DeleteSubscription(ctx context.Context, id string) error {
-	return c.delete(ctx, "/api/v1/subscriptions/"+url.PathEscape(id))
+// DeleteSubscription deletes a subscription. If ifMatch is non-empty, the
+// delete is rejected with a PreconditionFailed Error if the subscription's
+// current ETag doesn't match it. Pass "" to skip the check.
+func (c *Client) DeleteSubscription(ctx context.Context, id string, ifMatch string) error {
+	_, err := c.request(ctx, http.MethodDelete, "/api/v1/subscriptions/"+url.PathEscape(id), ifMatchHeader(ifMatch), nil, nil)
+	return err
+}
+
+// ifMatchHeader builds the header set for an optimistic-concurrency write,
+// or nil if no precondition was requested.
+func ifMatchHeader(ifMatch string) map[string]string {
+	if ifMatch == "" {
+		return nil
+	}
+	return map[string]string{"If-Match": ifMatch}
 }
 
 // PauseSubscription pauses a subscription.
@@ -322,53 +459,90 @@ func (c *Client) TestSubscription(ctx context.Context, id string) error {
 // Helper methods
 
 func (c *Client) get(ctx context.Context, path string, params url.Values, result interface{}) error {
-	u := c.baseURL + path
 	if len(params) > 0 {
-		u += "?" + params.Encode()
-	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return err
+		path += "?" + params.Encode()
 	}
-	return c.do(req, result)
+	_, err := c.request(ctx, http.MethodGet, path, nil, nil, result)
+	return err
 }
 
 func (c *Client) post(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.doWithBody(ctx, http.MethodPost, path, body, result)
+	_, err := c.request(ctx, http.MethodPost, path, nil, body, result)
+	return err
 }
 
 func (c *Client) put(ctx context.Context, path string, body interface{}, result interface{}) error {
-	return c.doWithBody(ctx, http.MethodPut, path, body, result)
+	_, err := c.request(ctx, http.MethodPut, path, nil, body, result)
+	return err
 }
 
 func (c *Client) delete(ctx context.Context, path string) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseURL+path, nil)
-	if err != nil {
-		return err
-	}
-	return c.do(req, nil)
+	_, err := c.request(ctx, http.MethodDelete, path, nil, nil, nil)
+	return err
 }
 
-func (c *Client) doWithBody(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	var bodyReader io.Reader
+// request performs an HTTP call against the API, retrying on a network
+// error or a 429/5xx response up to c.maxRetries times with exponential
+// backoff, and returns the response's ETag (if any) alongside the decoded
+// result. The request body, if any, is re-marshaled on every attempt since
+// an http.Request's body can only be read once.
+func (c *Client) request(ctx context.Context, method, path string, headers map[string]string, body interface{}, result interface{}) (string, error) {
+	var bodyBytes []byte
 	if body != nil {
 		data, err := json.Marshal(body)
 		if err != nil {
-			return err
+			return "", err
 		}
-		bodyReader = bytes.NewReader(data)
+		bodyBytes = data
 	}
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
-	if err != nil {
-		return err
+
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+		if err != nil {
+			return "", err
+		}
+		if bodyBytes != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		etag, err := c.do(req, result)
+		if err == nil {
+			return etag, nil
+		}
+		if attempt >= c.maxRetries || !isRetryable(err) {
+			return "", err
+		}
+
+		backoff := time.Duration(float64(c.retryBackoff) * math.Pow(2, float64(attempt)))
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(backoff):
+		}
 	}
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+}
+
+// isRetryable reports whether a failed request is worth retrying. A
+// network-level failure (no *Error, since it never reached the server) is
+// treated as transient; a server response is only retried if it was
+// rate-limited or a server-side error, since a 4xx will just fail again.
+func isRetryable(err error) bool {
+	apiErr, ok := err.(*Error)
+	if !ok {
+		return true
 	}
-	return c.do(req, result)
+	return apiErr.retryable()
 }
 
-func (c *Client) do(req *http.Request, result interface{}) error {
+func (c *Client) do(req *http.Request, result interface{}) (string, error) {
 	if c.apiKey != "" {
 		req.Header.Set("X-API-Key", c.apiKey)
 	}
@@ -376,7 +550,7 @@ func (c *Client) do(req *http.Request, result interface{}) error {
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer resp.Body.Close()
 
@@ -386,13 +560,17 @@ func (c *Client) do(req *http.Request, result interface{}) error {
 			Error string `json:"error"`
 		}
 		if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
-			return &Error{StatusCode: resp.StatusCode, Message: errResp.Error}
+			return "", &Error{StatusCode: resp.StatusCode, Message: errResp.Error}
 		}
-		return &Error{StatusCode: resp.StatusCode, Message: string(body)}
+		return "", &Error{StatusCode: resp.StatusCode, Message: string(body)}
 	}
 
+	etag := resp.Header.Get("ETag")
+
 	if result != nil && resp.StatusCode != http.StatusNoContent {
-		return json.NewDecoder(resp.Body).Decode(result)
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return "", err
+		}
 	}
-	return nil
+	return etag, nil
 }
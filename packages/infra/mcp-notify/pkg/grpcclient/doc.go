@@ -0,0 +1,9 @@
+// Package grpcclient will hold the generated client stubs for
+// ChangesService and SubscriptionsService (see
+// proto/mcpnotify/v1/service.proto) once `make proto` is run with protoc
+// and protoc-gen-go-grpc installed. No gRPC server is wired up in
+// cmd/mcp-notify yet either; both land together in a follow-up once the
+// generated code above is checked in, so this package intentionally stays
+// empty rather than hand-authoring stubs that would drift from the .proto
+// the moment it's regenerated.
+package grpcclient
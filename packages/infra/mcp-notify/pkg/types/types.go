@@ -3,6 +3,8 @@ package types
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +17,28 @@ const (
 	ChangeTypeNew     ChangeType = "new"
 	ChangeTypeUpdated ChangeType = "updated"
 	ChangeTypeRemoved ChangeType = "removed"
+
+	// ChangeTypeSummary marks a synthetic change used to deliver a single
+	// aggregate notification (e.g. a subscription's warm-up catch-up) rather
+	// than reporting one registry change.
+	ChangeTypeSummary ChangeType = "summary"
+
+	// ChangeTypeTyposquatSuspected marks a synthetic change raised when a
+	// newly registered server's name is suspiciously close to one a
+	// subscriber is already watching (see internal/typosquat), rather than
+	// reporting a change to the watched server itself.
+	ChangeTypeTyposquatSuspected ChangeType = "typosquat_suspected"
+)
+
+// Severity represents how impactful a change is, so subscribers can filter
+// or triage on urgency instead of treating every change type equally.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityMinor    Severity = "minor"
+	SeverityMajor    Severity = "major"
+	SeverityCritical Severity = "critical"
 )
 
 // DigestFrequency represents how often digest emails are sent.
@@ -31,13 +55,37 @@ const (
 type ChannelType string
 
 const (
-	ChannelDiscord  ChannelType = "discord"
-	ChannelSlack    ChannelType = "slack"
-	ChannelEmail    ChannelType = "email"
-	ChannelWebhook  ChannelType = "webhook"
-	ChannelRSS      ChannelType = "rss"
-	ChannelTelegram ChannelType = "telegram"
-	ChannelTeams    ChannelType = "teams"
+	ChannelDiscord      ChannelType = "discord"
+	ChannelSlack        ChannelType = "slack"
+	ChannelEmail        ChannelType = "email"
+	ChannelWebhook      ChannelType = "webhook"
+	ChannelRSS          ChannelType = "rss"
+	ChannelTelegram     ChannelType = "telegram"
+	ChannelTeams        ChannelType = "teams"
+	ChannelAlertmanager ChannelType = "alertmanager"
+	ChannelMattermost   ChannelType = "mattermost"
+	ChannelRocketChat   ChannelType = "rocketchat"
+	ChannelPush         ChannelType = "push"
+	ChannelApprise      ChannelType = "apprise"
+	ChannelSMS          ChannelType = "sms"
+	ChannelSNS          ChannelType = "sns"
+	ChannelEventBridge  ChannelType = "eventbridge"
+	ChannelGoogleChat   ChannelType = "googlechat"
+	ChannelZulip        ChannelType = "zulip"
+)
+
+// SamplingMode controls how aggressively a channel thins a high-volume
+// stream of changes before delivery.
+type SamplingMode string
+
+const (
+	// SamplingNone delivers every change that reaches the channel.
+	SamplingNone SamplingMode = "none"
+	// SamplingEveryN delivers one out of every N changes (1-in-N).
+	SamplingEveryN SamplingMode = "every_n"
+	// SamplingTopKHourly delivers only the K highest-relevance changes
+	// seen in a rolling one-hour window.
+	SamplingTopKHourly SamplingMode = "top_k_hourly"
 )
 
 // SubscriptionStatus represents the status of a subscription.
@@ -55,14 +103,79 @@ const (
 
 // Server represents an MCP server from the registry.
 type Server struct {
-	Name           string          `json:"name"`
-	Description    string          `json:"description"`
-	Repository     *Repository     `json:"repository,omitempty"`
-	VersionDetail  *VersionDetail  `json:"version_detail,omitempty"`
-	Packages       []Package       `json:"packages,omitempty"`
-	Remotes        []Remote        `json:"remotes,omitempty"`
-	CreatedAt      time.Time       `json:"created_at"`
-	UpdatedAt      time.Time       `json:"updated_at"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Repository    *Repository    `json:"repository,omitempty"`
+	VersionDetail *VersionDetail `json:"version_detail,omitempty"`
+	Packages      []Package      `json:"packages,omitempty"`
+	Remotes       []Remote       `json:"remotes,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+
+	// SecurityScore is a 0-100 supply-chain security score computed from
+	// known vulnerabilities affecting the server's declared packages (see
+	// internal/security). Nil means the server hasn't been scored, either
+	// because scoring is disabled or the score lookup hasn't run yet.
+	SecurityScore *int `json:"security_score,omitempty"`
+
+	// Vulnerabilities lists the known advisories that produced
+	// SecurityScore. Empty (not nil) once scored with no findings.
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+
+	// Provenance reports whether a Sigstore/SLSA provenance attestation has
+	// been published for the server's declared packages (see
+	// internal/provenance). Nil means the check hasn't run, either because
+	// it's disabled or because none of the server's packages are on a
+	// supported registry.
+	Provenance []ProvenanceStatus `json:"provenance,omitempty"`
+
+	// Tags are the categories derived from the server's name, description,
+	// and declared packages (see internal/category). Populated by the
+	// poller before a snapshot is saved; empty means no rule matched.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Vulnerability describes a single known advisory affecting one of a
+// server's declared packages, as reported by an OSV-compatible database.
+type Vulnerability struct {
+	ID          string `json:"id"`           // e.g. "GHSA-xxxx-xxxx-xxxx" or "CVE-2024-12345"
+	PackageName string `json:"package_name"` // the declared package it affects
+	Summary     string `json:"summary,omitempty"`
+	Severity    string `json:"severity,omitempty"` // low, medium, high, critical
+}
+
+// ProvenanceStatus records whether a single declared package has a
+// SLSA provenance or Sigstore attestation entry published for it on its
+// registry (see internal/provenance). This is NOT cryptographic
+// verification: nothing here fetches the attestation's Sigstore bundle,
+// checks Rekor transparency-log inclusion, validates a Fulcio certificate
+// chain, or confirms the attestation's subject digest matches the package
+// artifact. AttestationPublished only reflects that the registry's own
+// attestation-listing endpoint returned an entry with a matching
+// predicateType. A package on a registry this check doesn't cover is
+// simply omitted rather than reported unpublished.
+type ProvenanceStatus struct {
+	PackageName          string `json:"package_name"`
+	AttestationPublished bool   `json:"attestation_published"`
+	Reason               string `json:"reason,omitempty"` // e.g. "SLSA provenance attestation found" or "no attestation published"
+}
+
+// HasPublishedProvenance reports whether every package this server declares
+// provenance results for has a published attestation entry. A server with
+// no provenance results at all (the check disabled, or no packages on a
+// supported registry) counts as false, since there's nothing published to
+// point to. This does not imply the attestations were cryptographically
+// verified — see ProvenanceStatus.
+func (s Server) HasPublishedProvenance() bool {
+	if len(s.Provenance) == 0 {
+		return false
+	}
+	for _, p := range s.Provenance {
+		if !p.AttestationPublished {
+			return false
+		}
+	}
+	return true
 }
 
 // Repository represents the source repository of a server.
@@ -104,25 +217,147 @@ type ServerListResponse struct {
 
 // Snapshot represents a point-in-time snapshot of the registry.
 type Snapshot struct {
-	ID        uuid.UUID         `json:"id" db:"id"`
-	Timestamp time.Time         `json:"timestamp" db:"timestamp"`
-	Servers   map[string]Server `json:"servers" db:"-"` // name -> server
-	ServerCount int             `json:"server_count" db:"server_count"`
-	Hash      string            `json:"hash" db:"hash"` // Content hash for quick comparison
+	ID          uuid.UUID         `json:"id" db:"id"`
+	Timestamp   time.Time         `json:"timestamp" db:"timestamp"`
+	Servers     map[string]Server `json:"servers" db:"-"` // name -> server
+	ServerCount int               `json:"server_count" db:"server_count"`
+	Hash        string            `json:"hash" db:"hash"` // Content hash for quick comparison
 }
 
 // Change represents a single detected change in the registry.
 type Change struct {
-	ID              uuid.UUID       `json:"id" db:"id"`
-	SnapshotID      uuid.UUID       `json:"snapshot_id" db:"snapshot_id"`
-	ServerName      string          `json:"server_name" db:"server_name"`
-	ChangeType      ChangeType      `json:"change_type" db:"change_type"`
-	PreviousVersion string          `json:"previous_version,omitempty" db:"previous_version"`
-	NewVersion      string          `json:"new_version,omitempty" db:"new_version"`
-	FieldChanges    []FieldChange   `json:"field_changes,omitempty" db:"-"`
-	Server          *Server         `json:"server,omitempty" db:"-"`
-	PreviousServer  *Server         `json:"previous_server,omitempty" db:"-"`
-	DetectedAt      time.Time       `json:"detected_at" db:"detected_at"`
+	ID              uuid.UUID     `json:"id" db:"id"`
+	SnapshotID      uuid.UUID     `json:"snapshot_id" db:"snapshot_id"`
+	ServerName      string        `json:"server_name" db:"server_name"`
+	ChangeType      ChangeType    `json:"change_type" db:"change_type"`
+	PreviousVersion string        `json:"previous_version,omitempty" db:"previous_version"`
+	NewVersion      string        `json:"new_version,omitempty" db:"new_version"`
+	FieldChanges    []FieldChange `json:"field_changes,omitempty" db:"-"`
+	Server          *Server       `json:"server,omitempty" db:"-"`
+	PreviousServer  *Server       `json:"previous_server,omitempty" db:"-"`
+	Severity        Severity      `json:"severity" db:"severity"`
+	DetectedAt      time.Time     `json:"detected_at" db:"detected_at"`
+
+	// Confirmed indicates the change survived verification replay (a
+	// re-fetch of the server after RegistryConfig.VerificationDelay to rule
+	// out a transient listing glitch). Changes are confirmed by default
+	// when verification is disabled.
+	Confirmed bool `json:"confirmed" db:"confirmed"`
+
+	// Suspicious flags a change whose shape matches a heuristic associated
+	// with account takeover or supply-chain abuse (repository ownership
+	// transfer, a package swapped out under the same server name, an
+	// implausible version jump, or spam injected into the description).
+	// SuspiciousReasons explains which heuristics fired; empty when
+	// Suspicious is false.
+	Suspicious        bool     `json:"suspicious,omitempty" db:"suspicious"`
+	SuspiciousReasons []string `json:"suspicious_reasons,omitempty" db:"-"`
+
+	// AISummary is an optional short natural-language description of
+	// FieldChanges (e.g. "migrated from npm to pypi and bumped to 2.x"),
+	// generated by the configured summarizer (see internal/summarizer).
+	// Empty when summarization is disabled or failed for this change.
+	AISummary string `json:"ai_summary,omitempty" db:"-"`
+
+	// Backfilled indicates the change was produced by a startup backfill
+	// (diffing the registry against the latest stored snapshot after a
+	// restart) rather than a regular poll, so it may cover a wider window
+	// than the poll interval and its timing shouldn't be trusted precisely.
+	Backfilled bool `json:"backfilled,omitempty" db:"backfilled"`
+
+	// TraceContext is the W3C traceparent of the poll span that detected
+	// this change (see internal/poller's tracing helpers), carried through
+	// the notification outbox so a dispatch that happens on a later flush
+	// cycle can still continue the same trace and link back to it. Not
+	// persisted: it's only meaningful for the in-flight dispatch, not
+	// permanent audit data.
+	TraceContext string `json:"trace_context,omitempty" db:"-"`
+}
+
+// PollRecord tracks a single poll attempt so gaps in monitoring coverage
+// (the poller was down, or every poll in a window failed) can be surfaced
+// to subscribers instead of silently looking like "nothing changed".
+type PollRecord struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	StartedAt   time.Time `json:"started_at" db:"started_at"`
+	CompletedAt time.Time `json:"completed_at" db:"completed_at"`
+	Success     bool      `json:"success" db:"success"`
+	Error       string    `json:"error,omitempty" db:"error"`
+	ServerCount int       `json:"server_count" db:"server_count"`
+}
+
+// ServerEmbedding is the cached semantic-search vector for a server (see
+// internal/semantic), keyed by name. ContentHash lets a cached embedding be
+// recognized as stale once the server's name or description changes.
+type ServerEmbedding struct {
+	ServerName  string    `json:"server_name" db:"server_name"`
+	ContentHash string    `json:"content_hash" db:"content_hash"`
+	Embedding   []float32 `json:"embedding" db:"embedding"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// MonitoringGap describes a window in which polling was not happening (or
+// was failing), computed from consecutive PollRecords further apart than
+// the expected poll interval allows.
+type MonitoringGap struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// MaintenanceReport summarizes the outcome of a single scheduled maintenance
+// run, so operators can confirm cleanup is actually reclaiming space without
+// shelling into the database.
+type MaintenanceReport struct {
+	RanAt time.Time `json:"ran_at"`
+
+	// Skipped is true when another instance already held the maintenance
+	// lock, so this run did nothing.
+	Skipped bool `json:"skipped,omitempty"`
+
+	SnapshotsDeleted      int64  `json:"snapshots_deleted"`
+	SnapshotsCompacted    int64  `json:"snapshots_compacted"`
+	SnapshotsDeltaEncoded int64  `json:"snapshots_delta_encoded"`
+	NotificationsPruned   int64  `json:"notifications_pruned"`
+	ChangesDeleted        int64  `json:"changes_deleted"`
+	AuditLogEntriesPruned int64  `json:"audit_log_entries_pruned"`
+	VacuumRan             bool   `json:"vacuum_ran"`
+	ReclaimedBytes        int64  `json:"reclaimed_bytes,omitempty"`
+	DurationMS            int64  `json:"duration_ms"`
+	Error                 string `json:"error,omitempty"`
+}
+
+// RetentionPolicy controls how snapshot compaction downsamples poll
+// history: every poll is kept at full resolution within FullWindow, one
+// snapshot per hour is kept within HourlyWindow, and one snapshot per day
+// is kept beyond that (until DeleteOldSnapshots' own retention cutoff
+// removes it entirely).
+type RetentionPolicy struct {
+	FullWindow   time.Duration `json:"full_window"`
+	HourlyWindow time.Duration `json:"hourly_window"`
+}
+
+// CompactionReport summarizes the outcome of a snapshot compaction run.
+type CompactionReport struct {
+	// SnapshotsRemoved counts snapshots deleted because another snapshot in
+	// the same hour/day bucket was kept as that bucket's representative.
+	SnapshotsRemoved int64 `json:"snapshots_removed"`
+	// SnapshotsDeltaEncoded counts kept snapshots whose servers_data was
+	// replaced with a delta against the previous kept snapshot.
+	SnapshotsDeltaEncoded int64 `json:"snapshots_delta_encoded"`
+}
+
+// TransparencyEntry is one row of the append-only, hash-chained log of
+// detected changes. EntryHash commits to PrevHash plus the underlying
+// change, so altering or dropping a past entry breaks the chain for every
+// entry after it. Signature is base64-encoded Ed25519 over EntryHash and is
+// empty when the deployment has no signing key configured.
+type TransparencyEntry struct {
+	Index     int64     `json:"index" db:"index"`
+	ChangeID  uuid.UUID `json:"change_id" db:"change_id"`
+	PrevHash  string    `json:"prev_hash" db:"prev_hash"`
+	EntryHash string    `json:"entry_hash" db:"entry_hash"`
+	Signature string    `json:"signature,omitempty" db:"signature"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
 
 // FieldChange represents a change to a specific field.
@@ -134,12 +369,12 @@ type FieldChange struct {
 
 // DiffResult represents the result of comparing two snapshots.
 type DiffResult struct {
-	FromSnapshot  *Snapshot  `json:"from_snapshot"`
-	ToSnapshot    *Snapshot  `json:"to_snapshot"`
-	NewServers    []Change   `json:"new_servers"`
+	FromSnapshot   *Snapshot `json:"from_snapshot"`
+	ToSnapshot     *Snapshot `json:"to_snapshot"`
+	NewServers     []Change  `json:"new_servers"`
 	UpdatedServers []Change  `json:"updated_servers"`
 	RemovedServers []Change  `json:"removed_servers"`
-	TotalChanges  int        `json:"total_changes"`
+	TotalChanges   int       `json:"total_changes"`
 }
 
 // -----------------------------------------------------------------------------
@@ -153,53 +388,306 @@ type Subscription struct {
 	Description string             `json:"description,omitempty" db:"description"`
 	Filters     SubscriptionFilter `json:"filters" db:"-"`
 	Channels    []Channel          `json:"channels" db:"-"`
-	Status      SubscriptionStatus `json:"status" db:"status"`
-	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
-	LastNotified *time.Time        `json:"last_notified,omitempty" db:"last_notified"`
-	
+
+	// Routes optionally splits a subscription's channels into a per-namespace
+	// routing map (e.g. io.github.myorg.* -> the Slack channel, everything
+	// else -> the email channel) instead of notifying every channel for
+	// every matched change. Evaluated in order; the first matching route
+	// wins. Empty Routes preserves the default behavior of notifying every
+	// enabled channel.
+	Routes []SubscriptionRoute `json:"routes,omitempty" db:"-"`
+
+	// DeliverySchedule restricts real-time dispatch to a delivery window.
+	// Changes matched outside the window are held and flushed as a single
+	// batch once the window reopens, instead of being dropped or notified
+	// immediately. A zero-value schedule (Enabled false) preserves the
+	// default behavior of notifying immediately.
+	DeliverySchedule DeliverySchedule `json:"delivery_schedule,omitempty" db:"-"`
+
+	// ScheduledReport, when enabled, delivers a changelog-style summary of
+	// matched changes to this subscription's channels on a cron schedule,
+	// independent of DeliverySchedule and of real-time per-change dispatch.
+	// A zero-value config (Enabled false) preserves the default behavior of
+	// never sending scheduled reports.
+	ScheduledReport ScheduledReportConfig `json:"scheduled_report,omitempty" db:"-"`
+
+	Status       SubscriptionStatus `json:"status" db:"status"`
+	CreatedAt    time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at" db:"updated_at"`
+	LastNotified *time.Time         `json:"last_notified,omitempty" db:"last_notified"`
+
+	// WarmUpUntil suppresses normal per-change dispatch until this time, to
+	// spare a newly created broad subscription an immediate flood. Once it
+	// elapses, a single catch-up summary covering everything matched since
+	// CreatedAt is delivered and normal delivery resumes. Nil means warm-up
+	// was never requested.
+	WarmUpUntil *time.Time `json:"warm_up_until,omitempty" db:"warm_up_until"`
+
+	// WarmUpSummarySent records whether the post-warm-up catch-up summary
+	// has already been delivered, so it is sent exactly once.
+	WarmUpSummarySent bool `json:"warm_up_summary_sent,omitempty" db:"warm_up_summary_sent"`
+
+	// CoalesceWindow, when non-zero, debounces "updated" changes to the same
+	// server: instead of dispatching each one immediately, they accumulate
+	// into a single combined notification delivered once this many
+	// nanoseconds have passed without another update to that server. A zero
+	// value preserves the default behavior of dispatching every update
+	// immediately. "New" and "removed" changes are never coalesced.
+	CoalesceWindow time.Duration `json:"coalesce_window,omitempty" db:"coalesce_window"`
+
 	// Authentication (for API access)
-	APIKey      string             `json:"-" db:"api_key"` // Hashed
-	APIKeyHint  string             `json:"api_key_hint,omitempty" db:"api_key_hint"` // Last 4 chars
-	
+	APIKey     string `json:"-" db:"api_key"`                           // Hashed
+	APIKeyHint string `json:"api_key_hint,omitempty" db:"api_key_hint"` // Last 4 chars
+
+	// AllowedCIDRs, when non-empty, restricts which client IPs may
+	// authenticate as this subscription — its own key or any of its scoped
+	// subscription API keys — so a leaked key can't be used from anywhere.
+	// Empty means unrestricted, the original behavior.
+	AllowedCIDRs []string `json:"allowed_cidrs,omitempty" db:"-"`
+
+	// UserID owns this subscription, once created (or claimed) by a logged-in
+	// user (see internal/auth). Nil for subscriptions managed purely by their
+	// per-subscription API key, which remains fully supported.
+	UserID *uuid.UUID `json:"user_id,omitempty" db:"user_id"`
+
+	// OrgID owns this subscription on behalf of a team, once created under an
+	// Organization (see internal/organization). Mutually exclusive with
+	// UserID in practice: an org-owned subscription belongs to the
+	// organization, not to whichever member happened to create it.
+	OrgID *uuid.UUID `json:"org_id,omitempty" db:"org_id"`
+
 	// Rate limiting
-	NotificationCount int          `json:"notification_count" db:"notification_count"`
-	LastReset        time.Time     `json:"last_reset" db:"last_reset"`
+	NotificationCount int       `json:"notification_count" db:"notification_count"`
+	LastReset         time.Time `json:"last_reset" db:"last_reset"`
 }
 
 // SubscriptionFilter defines what changes a subscription matches.
 type SubscriptionFilter struct {
 	// Namespace patterns (glob-style, e.g., "io.github.*")
 	Namespaces []string `json:"namespaces,omitempty"`
-	
+
+	// NamespaceRegex matches the server name against a regular expression,
+	// for patterns glob-style Namespaces can't express. Applied in addition
+	// to Namespaces, not instead of it.
+	NamespaceRegex string `json:"namespace_regex,omitempty"`
+
 	// Keywords to match in server name or description
 	Keywords []string `json:"keywords,omitempty"`
-	
+
+	// KeywordRegex matches the server name and description against a
+	// regular expression, applied in addition to Keywords.
+	KeywordRegex string `json:"keyword_regex,omitempty"`
+
 	// Specific server names to track
 	Servers []string `json:"servers,omitempty"`
-	
+
+	// ExcludeServers mutes specific server names even if they otherwise
+	// match every other filter.
+	ExcludeServers []string `json:"exclude_servers,omitempty"`
+
+	// ExcludeNamespaces mutes server names matching a glob-style pattern
+	// even if they otherwise match every other filter.
+	ExcludeNamespaces []string `json:"exclude_namespaces,omitempty"`
+
+	// ExcludeKeywords mutes changes whose server name or description
+	// contains any of these keywords, even if they otherwise match every
+	// other filter.
+	ExcludeKeywords []string `json:"exclude_keywords,omitempty"`
+
 	// Change types to notify about
 	ChangeTypes []ChangeType `json:"change_types,omitempty"`
-	
+
+	// Minimum severities to notify about
+	Severities []Severity `json:"severities,omitempty"`
+
+	// MinVersionBump requires an update's version bump to be at least this
+	// significant ("patch", "minor", or "major") to match. Ignored for
+	// changes whose versions don't parse as semver.
+	MinVersionBump string `json:"min_version_bump,omitempty"`
+
+	// IgnorePreReleases excludes changes whose relevant version carries a
+	// pre-release tag (e.g. "2.0.0-beta.1").
+	IgnorePreReleases bool `json:"ignore_pre_releases,omitempty"`
+
 	// Package registry types to filter (npm, pypi, etc.)
 	PackageTypes []string `json:"package_types,omitempty"`
+
+	// SuspiciousOnly restricts matches to changes flagged by anomaly
+	// detection (see Change.Suspicious), for subscriptions dedicated to
+	// surfacing possible account takeovers or supply-chain hijacks.
+	SuspiciousOnly bool `json:"suspicious_only,omitempty"`
+
+	// ProvenanceAttestedOnly restricts matches to changes whose declared
+	// packages all have a published Sigstore/SLSA provenance attestation
+	// (see Server.Provenance), for subscriptions that only want to hear
+	// about updates with attestations to look into. This is not a
+	// cryptographic-verification guarantee — see ProvenanceStatus.
+	ProvenanceAttestedOnly bool `json:"provenance_attested_only,omitempty"`
+}
+
+// SubscriptionRoute maps a namespace pattern to a subset of a subscription's
+// channels (by their position in Subscription.Channels), so one subscription
+// can fan a firehose of changes out to different channels per namespace
+// instead of every channel receiving every change.
+type SubscriptionRoute struct {
+	// NamespacePattern is a glob-style pattern (see matchNamespace) matched
+	// against the changed server's name, e.g. "io.github.myorg.*". "*"
+	// matches everything and is typically used as a catch-all last route.
+	NamespacePattern string `json:"namespace_pattern"`
+
+	// ChannelIndexes are positions into Subscription.Channels that should
+	// receive changes matching NamespacePattern.
+	ChannelIndexes []int `json:"channel_indexes"`
+}
+
+// DeliverySchedule restricts when a subscription receives real-time
+// notifications. Time is evaluated in Timezone (an IANA name; UTC if
+// empty). Days, if non-empty, restricts delivery to those days of week
+// (0=Sunday..6=Saturday). QuietHoursStart/QuietHoursEnd, if both set, are
+// "HH:MM" times marking a daily window during which delivery is held; the
+// window wraps midnight if start is after end (e.g. "22:00" to "06:00").
+type DeliverySchedule struct {
+	Enabled         bool   `json:"enabled"`
+	Timezone        string `json:"timezone,omitempty"`
+	Days            []int  `json:"days,omitempty"`
+	QuietHoursStart string `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string `json:"quiet_hours_end,omitempty"`
+}
+
+// InWindow reports whether t falls within the delivery window. A disabled
+// or unparseable schedule imposes no restriction, so a misconfigured
+// schedule fails open rather than silently swallowing every notification.
+func (s DeliverySchedule) InWindow(t time.Time) bool {
+	if !s.Enabled {
+		return true
+	}
+
+	loc := time.UTC
+	if s.Timezone != "" {
+		if l, err := time.LoadLocation(s.Timezone); err == nil {
+			loc = l
+		}
+	}
+	local := t.In(loc)
+
+	if len(s.Days) > 0 {
+		allowed := false
+		for _, d := range s.Days {
+			if int(local.Weekday()) == d {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	if s.QuietHoursStart == "" || s.QuietHoursEnd == "" {
+		return true
+	}
+
+	start, startOK := parseClockTime(s.QuietHoursStart)
+	end, endOK := parseClockTime(s.QuietHoursEnd)
+	if !startOK || !endOK {
+		return true
+	}
+
+	now := local.Hour()*60 + local.Minute()
+	if start <= end {
+		return now < start || now >= end
+	}
+	// Window wraps midnight (e.g. 22:00 to 06:00).
+	return now >= end && now < start
+}
+
+// parseClockTime parses an "HH:MM" string into minutes since midnight.
+func parseClockTime(s string) (int, bool) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return 0, false
+	}
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, false
+	}
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
+
+// ScheduledReportConfig configures periodic changelog-style report delivery
+// for a subscription, on an arbitrary cron schedule rather than the fixed
+// hourly/daily/weekly digest frequencies.
+type ScheduledReportConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// CronExpression is a standard 5-field cron expression (minute hour
+	// day-of-month month day-of-week), evaluated in UTC.
+	CronExpression string `json:"cron_expression,omitempty"`
+
+	// Format selects the rendering used for the report body: "markdown"
+	// (the default) or "html".
+	Format string `json:"format,omitempty"`
+
+	// LastSentAt records when a report was last delivered, so the next
+	// report only covers changes since then. Zero means no report has been
+	// sent yet, and the first report covers changes since the schedule was
+	// enabled.
+	LastSentAt time.Time `json:"last_sent_at,omitempty"`
+}
+
+// HeldNotification is a change whose dispatch was deferred because it
+// arrived outside its subscription's delivery window. The digest scheduler
+// flushes these as a single batch once the window reopens.
+type HeldNotification struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	SubscriptionID uuid.UUID `json:"subscription_id" db:"subscription_id"`
+	ChangeID       uuid.UUID `json:"change_id" db:"change_id"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// PendingCoalescedUpdate accumulates rapid successive "updated" changes to a
+// single server within a subscription's CoalesceWindow, so the digest
+// scheduler can flush them as one combined notification instead of one per
+// change. FirstChangeID/LatestChangeID bound the burst: the flushed
+// notification's PreviousVersion comes from the first change and its
+// NewVersion from the latest.
+type PendingCoalescedUpdate struct {
+	ID             uuid.UUID     `json:"id" db:"id"`
+	SubscriptionID uuid.UUID     `json:"subscription_id" db:"subscription_id"`
+	ServerName     string        `json:"server_name" db:"server_name"`
+	FirstChangeID  uuid.UUID     `json:"first_change_id" db:"first_change_id"`
+	LatestChangeID uuid.UUID     `json:"latest_change_id" db:"latest_change_id"`
+	UpdateCount    int           `json:"update_count" db:"update_count"`
+	CoalesceWindow time.Duration `json:"coalesce_window" db:"coalesce_window"`
+	FirstSeenAt    time.Time     `json:"first_seen_at" db:"first_seen_at"`
+	LastSeenAt     time.Time     `json:"last_seen_at" db:"last_seen_at"`
 }
 
 // Channel represents a notification delivery channel.
 type Channel struct {
-	ID             uuid.UUID       `json:"id" db:"id"`
-	SubscriptionID uuid.UUID       `json:"subscription_id" db:"subscription_id"`
-	Type           ChannelType     `json:"type" db:"type"`
-	Config         ChannelConfig   `json:"config" db:"-"`
-	Enabled        bool            `json:"enabled" db:"enabled"`
-	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
-	
+	ID             uuid.UUID     `json:"id" db:"id"`
+	SubscriptionID uuid.UUID     `json:"subscription_id" db:"subscription_id"`
+	Type           ChannelType   `json:"type" db:"type"`
+	Config         ChannelConfig `json:"config" db:"-"`
+	Enabled        bool          `json:"enabled" db:"enabled"`
+	CreatedAt      time.Time     `json:"created_at" db:"created_at"`
+
 	// Delivery stats
-	SuccessCount   int             `json:"success_count" db:"success_count"`
-	FailureCount   int             `json:"failure_count" db:"failure_count"`
-	LastSuccess    *time.Time      `json:"last_success,omitempty" db:"last_success"`
-	LastFailure    *time.Time      `json:"last_failure,omitempty" db:"last_failure"`
-	LastError      string          `json:"last_error,omitempty" db:"last_error"`
+	SuccessCount int        `json:"success_count" db:"success_count"`
+	FailureCount int        `json:"failure_count" db:"failure_count"`
+	LastSuccess  *time.Time `json:"last_success,omitempty" db:"last_success"`
+	LastFailure  *time.Time `json:"last_failure,omitempty" db:"last_failure"`
+	LastError    string     `json:"last_error,omitempty" db:"last_error"`
+
+	// ConsecutiveFailures counts unbroken delivery failures since the last
+	// success, reset to zero on every success. Unlike FailureCount's
+	// all-time total, this is what NotificationsConfig.MaxConsecutiveFailures
+	// compares against to auto-disable a channel that has gone bad.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty" db:"consecutive_failures"`
 }
 
 // ChannelConfig holds channel-specific configuration.
@@ -208,27 +696,104 @@ type ChannelConfig struct {
 	DiscordWebhookURL string `json:"webhook_url,omitempty"`
 	DiscordUsername   string `json:"username,omitempty"`
 	DiscordAvatarURL  string `json:"avatar_url,omitempty"`
-	
+
+	// DiscordChannelID delivers via the Discord bot REST API instead of a
+	// webhook, for subscriptions created through bot slash commands (e.g.
+	// "/mcp watch"), which bind to the invoking channel. Ignored if
+	// DiscordWebhookURL is also set.
+	DiscordChannelID string `json:"discord_channel_id,omitempty"`
+
 	// Slack
-	SlackWebhookURL   string `json:"slack_webhook_url,omitempty"`
-	SlackChannel      string `json:"slack_channel,omitempty"`
-	
+	SlackWebhookURL string `json:"slack_webhook_url,omitempty"`
+	SlackChannel    string `json:"slack_channel,omitempty"`
+
 	// Email
-	EmailAddress      string          `json:"email,omitempty"`
-	EmailDigest       DigestFrequency `json:"digest,omitempty"`
-	
+	EmailAddress string          `json:"email,omitempty"`
+	EmailDigest  DigestFrequency `json:"digest,omitempty"`
+
 	// Generic Webhook
-	WebhookURL        string            `json:"url,omitempty"`
-	WebhookMethod     string            `json:"method,omitempty"` // POST, PUT
-	WebhookHeaders    map[string]string `json:"headers,omitempty"`
-	WebhookSecret     string            `json:"secret,omitempty"` // For HMAC signing
-	
+	WebhookURL         string            `json:"url,omitempty"`
+	WebhookMethod      string            `json:"method,omitempty"` // POST, PUT
+	WebhookHeaders     map[string]string `json:"headers,omitempty"`
+	WebhookSecret      string            `json:"secret,omitempty"`                                                                          // For HMAC signing
+	WebhookContentType string            `json:"content_type,omitempty" validate:"omitempty,oneof=application/json application/x-protobuf"` // Defaults to application/json
+	WebhookFormat      string            `json:"format,omitempty" validate:"omitempty,oneof=cloudevents"`                                   // Defaults to the plain WebhookPayload; "cloudevents" wraps it in a CloudEvents 1.0 envelope
+
+	// WebhookPayloadVersion selects the JSON schema of the delivered
+	// payload (see internal/notifier/webhook's WebhookPayload and
+	// WebhookPayloadV2). Defaults to "v1", which is frozen indefinitely;
+	// "v2" adds severity, structured field diffs and a registry id.
+	// Ignored when WebhookFormat is "cloudevents" or MessageTemplate is set.
+	WebhookPayloadVersion string `json:"payload_version,omitempty" validate:"omitempty,oneof=v1 v2"`
+
 	// Telegram
-	TelegramChatID    string `json:"telegram_chat_id,omitempty"`
-	TelegramBotToken  string `json:"telegram_bot_token,omitempty"`
-	
+	TelegramChatID   string `json:"telegram_chat_id,omitempty"`
+	TelegramBotToken string `json:"telegram_bot_token,omitempty"`
+
 	// Microsoft Teams
-	TeamsWebhookURL   string `json:"teams_webhook_url,omitempty"`
+	TeamsWebhookURL string `json:"teams_webhook_url,omitempty"`
+
+	// Alertmanager
+	AlertmanagerURL string `json:"alertmanager_url,omitempty"`
+
+	// AWS SNS
+	SNSTopicARN string `json:"sns_topic_arn,omitempty"`
+
+	// AWS EventBridge
+	EventBridgeBusName string `json:"eventbridge_bus_name,omitempty"`
+	EventBridgeSource  string `json:"eventbridge_source,omitempty"` // Defaults to "mcp-notify"
+
+	// Mattermost
+	MattermostWebhookURL string `json:"mattermost_webhook_url,omitempty"`
+	MattermostChannel    string `json:"mattermost_channel,omitempty"`
+
+	// Rocket.Chat
+	RocketChatWebhookURL string `json:"rocketchat_webhook_url,omitempty"`
+	RocketChatChannel    string `json:"rocketchat_channel,omitempty"`
+
+	// Google Chat
+	GoogleChatWebhookURL string `json:"googlechat_webhook_url,omitempty"`
+
+	// Zulip. Site/bot credentials are configured globally; only the
+	// destination stream/topic are per-channel.
+	ZulipStream string `json:"zulip_stream,omitempty"`
+	ZulipTopic  string `json:"zulip_topic,omitempty"` // Defaults to "MCP Registry"
+
+	// Push (ntfy.sh or Gotify). PushProvider selects which of the two APIs
+	// PushURL/PushToken are interpreted as.
+	PushProvider string `json:"push_provider,omitempty" validate:"omitempty,oneof=ntfy gotify"`
+	PushURL      string `json:"push_url,omitempty"`   // ntfy: full topic URL, e.g. https://ntfy.sh/my-topic; gotify: server base URL
+	PushToken    string `json:"push_token,omitempty"` // ntfy: optional access token for protected topics; gotify: required application token
+
+	// Apprise (self-hosted apprise-api server)
+	AppriseURL string `json:"apprise_url,omitempty"` // Base URL of the Apprise API server
+	AppriseKey string `json:"apprise_key,omitempty"` // Config key identifying which Apprise URLs to notify
+	AppriseTag string `json:"apprise_tag,omitempty"` // Optional tag to restrict delivery to a subset of Apprise's configured URLs
+
+	// SMS
+	SMSPhoneNumber string `json:"sms_phone_number,omitempty" validate:"omitempty,e164"` // Destination number, e.g. +15551234567
+
+	// Sampling (capacity-aware delivery for firehose subscriptions).
+	// The full, unsampled stream is always available via the API/RSS feed
+	// regardless of a channel's sampling configuration.
+	SamplingMode SamplingMode `json:"sampling_mode,omitempty" validate:"omitempty,oneof=none every_n top_k_hourly"`
+	SamplingRate int          `json:"sampling_rate,omitempty"`  // N for every_n mode
+	SamplingTopK int          `json:"sampling_top_k,omitempty"` // K per hour for top_k_hourly mode
+
+	// Locale selects which bundled language a channel's non-templated
+	// notification content (email, Slack, Discord) is rendered in, along
+	// with its date format. Empty defaults to English. Ignored when
+	// MessageTemplate is set, since a custom template controls its own
+	// wording.
+	Locale string `json:"locale,omitempty" validate:"omitempty,oneof=en es de ja zh"`
+
+	// MessageTemplate, when set, is a Go text/template string rendered
+	// against internal/notifier/template.Data and used in place of the
+	// channel's built-in message layout. Supported by discord (message
+	// content), slack (message text), and webhook (raw request body).
+	// Ignored by channel types that have no notion of a single rendered
+	// message body (e.g. email, which uses its own HTML/text templates).
+	MessageTemplate string `json:"message_template,omitempty"`
 }
 
 // MarshalJSON implements custom JSON marshaling for ChannelConfig.
@@ -236,7 +801,7 @@ func (c ChannelConfig) MarshalJSON() ([]byte, error) {
 	// Redact sensitive fields
 	type Alias ChannelConfig
 	alias := Alias(c)
-	
+
 	// Mask webhook URLs and secrets
 	if alias.DiscordWebhookURL != "" {
 		alias.DiscordWebhookURL = maskURL(alias.DiscordWebhookURL)
@@ -256,7 +821,31 @@ func (c ChannelConfig) MarshalJSON() ([]byte, error) {
 	if alias.TeamsWebhookURL != "" {
 		alias.TeamsWebhookURL = maskURL(alias.TeamsWebhookURL)
 	}
-	
+	if alias.AlertmanagerURL != "" {
+		alias.AlertmanagerURL = maskURL(alias.AlertmanagerURL)
+	}
+	if alias.MattermostWebhookURL != "" {
+		alias.MattermostWebhookURL = maskURL(alias.MattermostWebhookURL)
+	}
+	if alias.RocketChatWebhookURL != "" {
+		alias.RocketChatWebhookURL = maskURL(alias.RocketChatWebhookURL)
+	}
+	if alias.GoogleChatWebhookURL != "" {
+		alias.GoogleChatWebhookURL = maskURL(alias.GoogleChatWebhookURL)
+	}
+	if alias.PushURL != "" {
+		alias.PushURL = maskURL(alias.PushURL)
+	}
+	if alias.PushToken != "" {
+		alias.PushToken = "***"
+	}
+	if alias.AppriseURL != "" {
+		alias.AppriseURL = maskURL(alias.AppriseURL)
+	}
+	if alias.AppriseKey != "" {
+		alias.AppriseKey = "***"
+	}
+
 	return json.Marshal(alias)
 }
 
@@ -267,32 +856,425 @@ func maskURL(url string) string {
 	return url[:15] + "..." + url[len(url)-5:]
 }
 
+// Subscription API key scopes. ScopeAdmin satisfies every scope check,
+// mirroring the full access a subscription's original API key
+// (Subscription.APIKey) has always had.
+const (
+	ScopeReadChanges        = "read:changes"
+	ScopeWriteSubscriptions = "write:subscriptions"
+	ScopeAdmin              = "admin"
+)
+
+// SubscriptionAPIKey is an additional, named credential for a subscription,
+// scoped to a subset of what the subscription's original API key can do and
+// independently revocable. A subscription can hold several of these, so a
+// team can hand out separate keys per integration instead of sharing one
+// fully-privileged key.
+type SubscriptionAPIKey struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	SubscriptionID uuid.UUID `json:"subscription_id" db:"subscription_id"`
+	Name           string    `json:"name" db:"name"`
+
+	KeyHash string `json:"-" db:"key_hash"`
+	KeyHint string `json:"key_hint" db:"key_hint"` // last 4 characters
+
+	// Scopes limits what the key can do (see the Scope* constants). Empty
+	// means unrestricted, mirroring the subscription's original API key.
+	Scopes []string `json:"scopes,omitempty" db:"-"`
+
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+}
+
+// HasScope reports whether the key grants scope: it is unrestricted (Scopes
+// empty), it carries scope directly, or it carries ScopeAdmin.
+func (k SubscriptionAPIKey) HasScope(scope string) bool {
+	if len(k.Scopes) == 0 {
+		return true
+	}
+	for _, s := range k.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Active reports whether the key can still be used to authenticate: not
+// revoked and, if it carries an expiry, not past it.
+func (k SubscriptionAPIKey) Active() bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	if k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// CreateSubscriptionAPIKeyRequest is the request body for minting a new
+// subscription API key.
+type CreateSubscriptionAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required"`
+	Scopes    []string   `json:"scopes,omitempty" validate:"omitempty,dive,oneof=read:changes write:subscriptions admin"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// SubscriptionAPIKeyResponse wraps a SubscriptionAPIKey with the key value
+// itself, returned once on creation and never again.
+type SubscriptionAPIKeyResponse struct {
+	SubscriptionAPIKey
+	Key string `json:"key"`
+}
+
+// -----------------------------------------------------------------------------
+// User Account Types
+// -----------------------------------------------------------------------------
+
+// User is an account created by logging in through an OIDC provider (see
+// internal/auth). Subscriptions created while logged in are owned by a User
+// instead of standing alone behind their own per-subscription API key.
+type User struct {
+	ID    uuid.UUID `json:"id" db:"id"`
+	Email string    `json:"email" db:"email"`
+
+	// Provider and Subject together identify the account at the OIDC
+	// provider (the "iss" and "sub" claims of its ID tokens) and are unique
+	// as a pair, so the same email at two different providers is two
+	// distinct users.
+	Provider string `json:"provider" db:"provider"`
+	Subject  string `json:"-" db:"subject"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// PersonalAccessToken is a scoped, revocable credential a User can mint from
+// their browser session to authenticate API requests without a browser,
+// replacing the older pattern of one API key per subscription for users who
+// have logged in.
+type PersonalAccessToken struct {
+	ID     uuid.UUID `json:"id" db:"id"`
+	UserID uuid.UUID `json:"user_id" db:"user_id"`
+	Name   string    `json:"name" db:"name"`
+
+	TokenHash string `json:"-" db:"token_hash"`
+	TokenHint string `json:"token_hint" db:"token_hint"` // last 4 characters
+
+	// Scopes limits what the token can do (e.g. "subscriptions:read",
+	// "subscriptions:write"). Empty means unrestricted, mirroring the full
+	// access a subscription's own API key already has.
+	Scopes []string `json:"scopes,omitempty" db:"-"`
+
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+}
+
+// HasScope reports whether the token grants scope, or is unrestricted
+// (Scopes empty).
+func (t PersonalAccessToken) HasScope(scope string) bool {
+	if len(t.Scopes) == 0 {
+		return true
+	}
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Active reports whether the token can still be used to authenticate: not
+// revoked and, if it carries an expiry, not past it.
+func (t PersonalAccessToken) Active() bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+	if t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// CreatePersonalAccessTokenRequest is the request body for minting a new
+// personal access token.
+type CreatePersonalAccessTokenRequest struct {
+	Name      string     `json:"name" validate:"required"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// PersonalAccessTokenResponse is returned once, when a personal access token
+// is created, since Token is the only time the raw value is available (only
+// its hash is stored thereafter).
+type PersonalAccessTokenResponse struct {
+	PersonalAccessToken
+	Token string `json:"token"`
+}
+
+// -----------------------------------------------------------------------------
+// Organization Types
+// -----------------------------------------------------------------------------
+
+// OrgRole is a member's permission level within an Organization.
+type OrgRole string
+
+const (
+	// OrgRoleOwner can manage members (including other owners), quotas, and
+	// delete the organization.
+	OrgRoleOwner OrgRole = "owner"
+
+	// OrgRoleAdmin can manage members below owner and the organization's
+	// subscriptions, but cannot delete the organization or remove an owner.
+	OrgRoleAdmin OrgRole = "admin"
+
+	// OrgRoleViewer can see the organization's subscriptions and their
+	// notification history, but cannot create, modify, or delete anything.
+	OrgRoleViewer OrgRole = "viewer"
+)
+
+// orgRoleRank orders roles from least to most privileged, so one role can be
+// compared against another with Meets.
+var orgRoleRank = map[OrgRole]int{
+	OrgRoleViewer: 0,
+	OrgRoleAdmin:  1,
+	OrgRoleOwner:  2,
+}
+
+// Meets reports whether role grants at least the privilege of required.
+func (r OrgRole) Meets(required OrgRole) bool {
+	return orgRoleRank[r] >= orgRoleRank[required]
+}
+
+// QuotaTier selects an organization's default usage limits. See
+// internal/organization's DefaultQuotaTiers for what each tier grants.
+type QuotaTier string
+
+const (
+	QuotaTierFree       QuotaTier = "free"
+	QuotaTierPro        QuotaTier = "pro"
+	QuotaTierEnterprise QuotaTier = "enterprise"
+)
+
+// Organization owns a set of subscriptions on behalf of a team, instead of
+// each subscription standing alone behind its own API key or a single
+// User's account.
+type Organization struct {
+	ID   uuid.UUID `json:"id" db:"id"`
+	Name string    `json:"name" db:"name"`
+
+	// Slug is a unique, URL-safe identifier, e.g. "acme-corp".
+	Slug string `json:"slug" db:"slug"`
+
+	// Tier selects this organization's billing plan. Set once at creation
+	// from internal/organization's DefaultQuotaTiers; the three quota
+	// fields below can then be overridden independently of it.
+	Tier QuotaTier `json:"tier" db:"tier"`
+
+	// MaxSubscriptions caps how many subscriptions the organization may
+	// own. Zero means unlimited.
+	MaxSubscriptions int `json:"max_subscriptions" db:"max_subscriptions"`
+
+	// MaxNotificationsPerMonth caps how many notifications the
+	// organization's subscriptions may send in a calendar month. Zero means
+	// unlimited.
+	MaxNotificationsPerMonth int `json:"max_notifications_per_month" db:"max_notifications_per_month"`
+
+	// MaxChannelsPerSubscription caps how many channels any one of the
+	// organization's subscriptions may have. Zero means unlimited.
+	MaxChannelsPerSubscription int `json:"max_channels_per_subscription" db:"max_channels_per_subscription"`
+
+	// UsageWebhookURL, when set, receives a signed OrganizationUsage payload
+	// on the schedule configured in OrganizationsConfig, for metering into
+	// an external billing system.
+	UsageWebhookURL string `json:"usage_webhook_url,omitempty" db:"usage_webhook_url"`
+	// UsageWebhookSecret HMAC-signs the usage webhook body the same way a
+	// notification channel's webhook secret does (see internal/notifier/webhook).
+	// Never returned in API responses once set.
+	UsageWebhookSecret string `json:"-" db:"usage_webhook_secret"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// OrganizationMember links a User to an Organization with a role.
+type OrganizationMember struct {
+	OrgID     uuid.UUID `json:"org_id" db:"org_id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Role      OrgRole   `json:"role" db:"role"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// CreateOrganizationRequest is the request body for creating an
+// organization. The creator becomes its first member, with OrgRoleOwner.
+type CreateOrganizationRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=255"`
+	Slug string `json:"slug" validate:"required,min=1,max=64,alphanum"`
+
+	// Tier selects the organization's initial quota tier. Defaults to
+	// QuotaTierFree if omitted.
+	Tier QuotaTier `json:"tier,omitempty" validate:"omitempty,oneof=free pro enterprise"`
+}
+
+// UpdateOrganizationRequest is the request body for updating an
+// organization.
+type UpdateOrganizationRequest struct {
+	Name             *string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	MaxSubscriptions *int    `json:"max_subscriptions,omitempty" validate:"omitempty,min=0"`
+
+	// Tier, when provided, resets MaxSubscriptions, MaxNotificationsPerMonth
+	// and MaxChannelsPerSubscription to that tier's defaults; set those
+	// fields afterward (in the same or a later request) to override them.
+	Tier                       *QuotaTier `json:"tier,omitempty" validate:"omitempty,oneof=free pro enterprise"`
+	MaxNotificationsPerMonth   *int       `json:"max_notifications_per_month,omitempty" validate:"omitempty,min=0"`
+	MaxChannelsPerSubscription *int       `json:"max_channels_per_subscription,omitempty" validate:"omitempty,min=0"`
+	UsageWebhookURL            *string    `json:"usage_webhook_url,omitempty" validate:"omitempty,url"`
+	UsageWebhookSecret         *string    `json:"usage_webhook_secret,omitempty"`
+}
+
+// AddOrganizationMemberRequest is the request body for adding a member to
+// an organization.
+type AddOrganizationMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+	Role   OrgRole   `json:"role" validate:"required,oneof=owner admin viewer"`
+}
+
+// UsageCount is a used/max pair for a single quota, e.g. subscriptions or
+// notifications this month. Max is 0 if that quota is unlimited.
+type UsageCount struct {
+	Used int `json:"used"`
+	Max  int `json:"max"`
+}
+
+// OrganizationUsage is an organization's current standing against its quota
+// tier, returned by the usage export endpoint and delivered to
+// Organization.UsageWebhookURL for metering into an external billing system.
+type OrganizationUsage struct {
+	OrgID uuid.UUID `json:"org_id"`
+	Tier  QuotaTier `json:"tier"`
+
+	// PeriodStart is the start of the calendar month NotificationsThisMonth
+	// was computed over.
+	PeriodStart time.Time `json:"period_start"`
+
+	Subscriptions          UsageCount `json:"subscriptions"`
+	NotificationsThisMonth UsageCount `json:"notifications_this_month"`
+}
+
+// -----------------------------------------------------------------------------
+// Audit Log Types
+// -----------------------------------------------------------------------------
+
+// AuditLogEntry records a single mutating operation, so "who did what to
+// what, and what changed" can be reconstructed after the fact.
+type AuditLogEntry struct {
+	ID uuid.UUID `json:"id" db:"id"`
+
+	// Action identifies the operation, e.g. "subscription.create",
+	// "subscription.pause", "organization.delete".
+	Action       string    `json:"action" db:"action"`
+	ResourceType string    `json:"resource_type" db:"resource_type"`
+	ResourceID   uuid.UUID `json:"resource_id" db:"resource_id"`
+
+	// ActorType is "user", "subscription" (acting on itself with its
+	// original API key), "subscription_api_key" (one of its additional,
+	// scoped keys), or "system" (for operations with no human initiator,
+	// e.g. scheduled maintenance).
+	ActorType string `json:"actor_type" db:"actor_type"`
+	ActorID   string `json:"actor_id,omitempty" db:"actor_id"`
+	IP        string `json:"ip,omitempty" db:"ip"`
+
+	// Before and After are snapshots of the resource immediately before and
+	// after the operation. Before is omitted for a create, After for a
+	// delete.
+	Before json.RawMessage `json:"before,omitempty" db:"-"`
+	After  json.RawMessage `json:"after,omitempty" db:"-"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// AuditLogFilter narrows a ListAuditLogEntries query. Zero-value fields are
+// unfiltered.
+type AuditLogFilter struct {
+	ResourceType string
+	ResourceID   *uuid.UUID
+	Action       string
+	ActorID      string
+	Since        *time.Time
+	Until        *time.Time
+	Limit        int
+}
+
 // -----------------------------------------------------------------------------
 // Notification Types
 // -----------------------------------------------------------------------------
 
 // Notification represents a notification to be sent.
 type Notification struct {
-	ID             uuid.UUID      `json:"id" db:"id"`
-	SubscriptionID uuid.UUID      `json:"subscription_id" db:"subscription_id"`
-	ChannelID      uuid.UUID      `json:"channel_id" db:"channel_id"`
-	ChangeID       uuid.UUID      `json:"change_id" db:"change_id"`
-	Status         string         `json:"status" db:"status"` // pending, sent, failed
-	Attempts       int            `json:"attempts" db:"attempts"`
-	NextRetry      *time.Time     `json:"next_retry,omitempty" db:"next_retry"`
-	SentAt         *time.Time     `json:"sent_at,omitempty" db:"sent_at"`
-	Error          string         `json:"error,omitempty" db:"error"`
-	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+	ID             uuid.UUID  `json:"id" db:"id"`
+	SubscriptionID uuid.UUID  `json:"subscription_id" db:"subscription_id"`
+	ChannelID      uuid.UUID  `json:"channel_id" db:"channel_id"`
+	ChangeID       uuid.UUID  `json:"change_id" db:"change_id"`
+	Status         string     `json:"status" db:"status"` // pending, sent, failed
+	Attempts       int        `json:"attempts" db:"attempts"`
+	NextRetry      *time.Time `json:"next_retry,omitempty" db:"next_retry"`
+	SentAt         *time.Time `json:"sent_at,omitempty" db:"sent_at"`
+	Error          string     `json:"error,omitempty" db:"error"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+
+	// ServerName and ChangeType are denormalized from the underlying change so
+	// notification history can be searched without joining back to changes.
+	ServerName string     `json:"server_name,omitempty" db:"server_name"`
+	ChangeType ChangeType `json:"change_type,omitempty" db:"change_type"`
+
+	// PayloadSnippet is a short rendering of what was actually sent (e.g.
+	// "[updated] some-server - now supports resources"), so search results
+	// can show what a notification said without re-rendering it per channel.
+	PayloadSnippet string `json:"payload_snippet,omitempty" db:"payload_snippet"`
+}
+
+// OutboxEvent is a Change queued for at-least-once delivery to an external
+// event bus (Kafka/NATS). Rows are inserted in the same poll cycle that
+// saves the underlying Change and are only deleted once the configured
+// publisher confirms the send, so a crash between insert and publish just
+// leaves the row for the next flush instead of silently dropping the event.
+type OutboxEvent struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	ChangeID    uuid.UUID `json:"change_id" db:"change_id"`
+	Topic       string    `json:"topic" db:"topic"`
+	Payload     []byte    `json:"payload" db:"payload"`
+	Attempts    int       `json:"attempts" db:"attempts"`
+	NextAttempt time.Time `json:"next_attempt" db:"next_attempt"`
+	Error       string    `json:"error,omitempty" db:"error"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+// NotificationOutboxEntry is a batch of dispatch-worthy changes from a
+// single poll (or backfill) queued for at-least-once delivery to
+// subscriptions. A row is inserted in the same poll cycle that saves the
+// underlying Changes and is only deleted once dispatch completes without
+// error, so a crash between the two never silently drops a notification.
+type NotificationOutboxEntry struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Payload     []byte    `json:"payload" db:"payload"`
+	Attempts    int       `json:"attempts" db:"attempts"`
+	NextAttempt time.Time `json:"next_attempt" db:"next_attempt"`
+	Error       string    `json:"error,omitempty" db:"error"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 }
 
 // NotificationPayload is the data sent to notification channels.
 type NotificationPayload struct {
-	EventType   string    `json:"event_type"` // server.new, server.updated, server.removed
-	Timestamp   time.Time `json:"timestamp"`
-	Server      *Server   `json:"server"`
+	EventType   string        `json:"event_type"` // server.new, server.updated, server.removed
+	Timestamp   time.Time     `json:"timestamp"`
+	Server      *Server       `json:"server"`
 	Changes     []FieldChange `json:"changes,omitempty"`
-	RegistryURL string    `json:"registry_url"`
-	WatchURL    string    `json:"watch_url"` // Link back to this service
+	RegistryURL string        `json:"registry_url"`
+	WatchURL    string        `json:"watch_url"` // Link back to this service
 }
 
 // -----------------------------------------------------------------------------
@@ -301,15 +1283,40 @@ type NotificationPayload struct {
 
 // CreateSubscriptionRequest is the request body for creating a subscription.
 type CreateSubscriptionRequest struct {
-	Name        string             `json:"name" validate:"required,min=1,max=255"`
-	Description string             `json:"description,omitempty" validate:"max=1000"`
-	Filters     SubscriptionFilter `json:"filters" validate:"required"`
-	Channels    []ChannelRequest   `json:"channels" validate:"required,min=1,max=10,dive"`
+	Name        string              `json:"name" validate:"required,min=1,max=255"`
+	Description string              `json:"description,omitempty" validate:"max=1000"`
+	Filters     SubscriptionFilter  `json:"filters" validate:"required"`
+	Channels    []ChannelRequest    `json:"channels" validate:"required,min=1,max=10,dive"`
+	Routes      []SubscriptionRoute `json:"routes,omitempty"`
+
+	// DeliverySchedule, when enabled, restricts real-time delivery to a
+	// window and holds matched changes for a batched flush otherwise.
+	DeliverySchedule DeliverySchedule `json:"delivery_schedule,omitempty"`
+
+	// ScheduledReport, when enabled, delivers a changelog-style summary to
+	// this subscription's channels on a cron schedule, independent of
+	// real-time per-change delivery.
+	ScheduledReport ScheduledReportConfig `json:"scheduled_report,omitempty"`
+
+	// CoalesceWindow, when set, merges "updated" changes to the same server
+	// arriving within this window of each other into a single notification
+	// instead of dispatching one per change.
+	CoalesceWindow time.Duration `json:"coalesce_window,omitempty" validate:"omitempty,min=0"`
+
+	// WarmUpHours, when set, suppresses immediate notifications for this
+	// many hours after creation. Once it elapses, a single summary of
+	// everything matched since creation is delivered before the
+	// subscription switches to normal per-change delivery.
+	WarmUpHours int `json:"warm_up_hours,omitempty" validate:"omitempty,min=1,max=720"`
+
+	// AllowedCIDRs, when set, restricts which client IPs may authenticate
+	// as this subscription. Empty allows any IP.
+	AllowedCIDRs []string `json:"allowed_cidrs,omitempty" validate:"omitempty,max=50,dive,cidr"`
 }
 
 // ChannelRequest is the request body for a notification channel.
 type ChannelRequest struct {
-	Type   ChannelType   `json:"type" validate:"required,oneof=discord slack email webhook telegram teams"`
+	Type   ChannelType   `json:"type" validate:"required,oneof=discord slack email webhook telegram teams alertmanager mattermost rocketchat push apprise sms"`
 	Config ChannelConfig `json:"config" validate:"required"`
 }
 
@@ -319,6 +1326,23 @@ type UpdateSubscriptionRequest struct {
 	Description *string             `json:"description,omitempty" validate:"omitempty,max=1000"`
 	Filters     *SubscriptionFilter `json:"filters,omitempty"`
 	Channels    []ChannelRequest    `json:"channels,omitempty" validate:"omitempty,max=10,dive"`
+	Routes      []SubscriptionRoute `json:"routes,omitempty"`
+
+	// DeliverySchedule, when provided, replaces the subscription's current
+	// delivery window.
+	DeliverySchedule *DeliverySchedule `json:"delivery_schedule,omitempty"`
+
+	// ScheduledReport, when provided, replaces the subscription's current
+	// scheduled report configuration.
+	ScheduledReport *ScheduledReportConfig `json:"scheduled_report,omitempty"`
+
+	// CoalesceWindow, when provided, replaces the subscription's current
+	// update-coalescing window.
+	CoalesceWindow *time.Duration `json:"coalesce_window,omitempty" validate:"omitempty,min=0"`
+
+	// AllowedCIDRs, when provided, replaces the subscription's current IP
+	// allowlist. An empty (non-nil) slice removes the restriction.
+	AllowedCIDRs []string `json:"allowed_cidrs,omitempty" validate:"omitempty,max=50,dive,cidr"`
 }
 
 // SubscriptionResponse is the API response for a subscription.
@@ -327,6 +1351,107 @@ type SubscriptionResponse struct {
 	APIKey string `json:"api_key,omitempty"` // Only on creation
 }
 
+// SubscriptionBundle is the wire format for bulk subscription import/export
+// (see /api/v1/subscriptions/export and /import). It carries the same
+// fields as CreateSubscriptionRequest rather than Subscription itself,
+// since API keys are never persisted in plaintext and import always mints a
+// fresh one for each subscription it creates. It marshals to either JSON or
+// YAML, so a bundle can be checked into version control and diffed.
+type SubscriptionBundle struct {
+	// Version identifies the bundle format, so a future incompatible change
+	// can be detected on import instead of silently misinterpreted.
+	Version int `json:"version" yaml:"version"`
+
+	Subscriptions []CreateSubscriptionRequest `json:"subscriptions" yaml:"subscriptions"`
+}
+
+// SubscriptionBundleVersion is the current SubscriptionBundle.Version.
+const SubscriptionBundleVersion = 1
+
+// Watchlist is a lightweight, API-key-scoped list of server names a caller
+// wants quick status on. Unlike a Subscription it has no filters, channels,
+// or delivery behavior — just membership, so it's cheap to create and check
+// without setting up a full subscription.
+type Watchlist struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	APIKeyHash  string    `json:"-" db:"api_key_hash"`
+	ServerNames []string  `json:"server_names" db:"server_names"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateWatchlistRequest is the request body for POST /api/v1/watchlist.
+type CreateWatchlistRequest struct {
+	ServerNames []string `json:"server_names" validate:"required,min=1,max=200,dive,required"`
+}
+
+// UpdateWatchlistRequest is the request body for PUT /api/v1/watchlist,
+// replacing the full set of watched server names.
+type UpdateWatchlistRequest struct {
+	ServerNames []string `json:"server_names" validate:"required,min=1,max=200,dive,required"`
+}
+
+// WatchlistResponse is the API response for a watchlist.
+type WatchlistResponse struct {
+	Watchlist
+	APIKey string `json:"api_key,omitempty"` // Only on creation
+}
+
+// WatchlistServerStatus is the current status of one server on a watchlist.
+type WatchlistServerStatus struct {
+	Name          string `json:"name"`
+	Found         bool   `json:"found"`
+	LatestVersion string `json:"latest_version,omitempty"`
+}
+
+// WatchlistStatusResponse is the response for GET /api/v1/watchlist: the
+// watchlist itself plus each watched server's current registry status.
+type WatchlistStatusResponse struct {
+	Watchlist Watchlist               `json:"watchlist"`
+	Servers   []WatchlistServerStatus `json:"servers"`
+}
+
+// ImportSubscriptionsResult reports the outcome of importing a
+// SubscriptionBundle: how many subscriptions were created, and the newly
+// minted API key for each one (in the same order as the bundle), so the
+// caller can distribute them the same way a single CreateSubscription
+// response does.
+type ImportSubscriptionsResult struct {
+	Imported      int                       `json:"imported"`
+	Subscriptions []SubscriptionResponse    `json:"subscriptions"`
+	Errors        []ImportSubscriptionError `json:"errors,omitempty"`
+}
+
+// ImportSubscriptionError records why one entry in an import bundle failed,
+// identified by its position so the caller can find it in the file they
+// submitted.
+type ImportSubscriptionError struct {
+	Index int    `json:"index"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error"`
+}
+
+// SubscriptionPlanAction describes one action apply took (or, in dry-run
+// mode, would take) to converge live subscriptions to a desired
+// SubscriptionBundle. Subscriptions are matched by Name, since a bundle
+// entry has no ID of its own.
+type SubscriptionPlanAction struct {
+	Action string     `json:"action"` // "create", "update", "delete", or "unchanged"
+	Name   string     `json:"name"`
+	ID     *uuid.UUID `json:"id,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// ApplySubscriptionsResult reports what a call to /subscriptions/apply did
+// (or, with dry_run=true, would do) to converge live subscriptions to a
+// desired SubscriptionBundle: create subscriptions present in the bundle
+// but not live, update ones present in both that differ, and delete ones
+// live but not in the bundle.
+type ApplySubscriptionsResult struct {
+	DryRun  bool                     `json:"dry_run"`
+	Actions []SubscriptionPlanAction `json:"actions"`
+}
+
 // ChangesResponse is the API response for listing changes.
 type ChangesResponse struct {
 	Changes    []Change `json:"changes"`
@@ -351,11 +1476,62 @@ type HealthResponse struct {
 
 // StatsResponse contains service statistics.
 type StatsResponse struct {
-	TotalSubscriptions int       `json:"total_subscriptions"`
-	ActiveSubscriptions int      `json:"active_subscriptions"`
-	TotalChanges       int       `json:"total_changes"`
-	ChangesLast24h     int       `json:"changes_last_24h"`
-	TotalNotifications int       `json:"total_notifications"`
-	LastPollTime       time.Time `json:"last_poll_time"`
-	ServerCount        int       `json:"server_count"`
+	TotalSubscriptions  int       `json:"total_subscriptions"`
+	ActiveSubscriptions int       `json:"active_subscriptions"`
+	TotalChanges        int       `json:"total_changes"`
+	ChangesLast24h      int       `json:"changes_last_24h"`
+	TotalNotifications  int       `json:"total_notifications"`
+	LastPollTime        time.Time `json:"last_poll_time"`
+	ServerCount         int       `json:"server_count"`
+
+	// Degraded is true when this response was served from the poller's
+	// in-memory snapshot instead of the database, because the database was
+	// unreachable. See internal/poller's degraded-mode buffering.
+	Degraded bool `json:"degraded,omitempty"`
+}
+
+// SubscriptionAnalytics is a subscription's delivery activity over a window,
+// computed by aggregate SQL so it stays cheap regardless of how much
+// notification history the subscription has accumulated.
+type SubscriptionAnalytics struct {
+	SubscriptionID uuid.UUID `json:"subscription_id"`
+	Since          time.Time `json:"since"`
+	BucketSizeMS   int64     `json:"bucket_size_ms"`
+
+	Buckets      []AnalyticsBucket  `json:"buckets"`
+	ChannelStats []ChannelAnalytics `json:"channel_stats"`
+	NoisyServers []NoisyServer      `json:"noisy_servers"`
+}
+
+// AnalyticsBucket is delivery counts for a single time bucket within an
+// analytics window.
+type AnalyticsBucket struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Sent        int       `json:"sent"`
+	Failed      int       `json:"failed"`
+	Pending     int       `json:"pending"`
+}
+
+// ChannelAnalytics is delivery success rate and latency for a single channel
+// over an analytics window.
+type ChannelAnalytics struct {
+	ChannelID   uuid.UUID   `json:"channel_id"`
+	ChannelType ChannelType `json:"channel_type"`
+
+	Sent        int     `json:"sent"`
+	Failed      int     `json:"failed"`
+	SuccessRate float64 `json:"success_rate"`
+
+	// MedianLatencyMS is the median time between a notification being
+	// created and successfully sent. -1 if no notification on this channel
+	// has been sent yet.
+	MedianLatencyMS int64 `json:"median_latency_ms"`
+}
+
+// NoisyServer is a server that generated the most notifications for a
+// subscription over an analytics window, so a user can see at a glance what
+// to narrow their filters around.
+type NoisyServer struct {
+	ServerName        string `json:"server_name"`
+	NotificationCount int    `json:"notification_count"`
 }
@@ -33,7 +33,7 @@ func TestPollerDetectsNewServers(t *testing.T) {
 	})
 
 	// Create diff engine
-	engine := diff.NewEngine()
+	engine := diff.NewEngine("https://registry.example.com")
 
 	// Get initial snapshot
 	ctx := context.Background()
@@ -73,7 +73,7 @@ func TestPollerDetectsUpdatedServers(t *testing.T) {
 	})
 
 	// Create diff engine
-	engine := diff.NewEngine()
+	engine := diff.NewEngine("https://registry.example.com")
 
 	// Get initial snapshot
 	ctx := context.Background()
@@ -119,7 +119,7 @@ func TestPollerDetectsRemovedServers(t *testing.T) {
 	})
 
 	// Create diff engine
-	engine := diff.NewEngine()
+	engine := diff.NewEngine("https://registry.example.com")
 
 	// Get initial snapshot
 	ctx := context.Background()
@@ -242,7 +242,7 @@ func TestPollerRunsPollingLoop(t *testing.T) {
 }
 
 func TestPollerQuickHashComparison(t *testing.T) {
-	engine := diff.NewEngine()
+	engine := diff.NewEngine("https://registry.example.com")
 
 	// Create two identical snapshots
 	snapshot1 := engine.CreateSnapshot(fixtures.TestServers)